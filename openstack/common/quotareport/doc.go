@@ -0,0 +1,32 @@
+/*
+Package quotareport gathers the quota usage and limits of a project from the
+Compute, Block Storage, Networking and Load Balancer services, and merges them
+into a single report, for instance to feed a capacity dashboard.
+
+The Compute, Block Storage and Networking services report their own usage.
+The Load Balancer service only reports limits, so its usage is computed by
+counting the resources of the project.
+
+Example to Get a Quota Report
+
+	report, err := quotareport.Get(quotareport.Clients{
+		Compute:      computeClient,
+		BlockStorage: blockStorageClient,
+		Network:      networkClient,
+		LoadBalancer: loadBalancerClient,
+	}, "23d5d3f79dfa4f73b72b8b0b0063ec55")
+	if err != nil {
+		panic(err)
+	}
+
+	for _, usage := range report.Usages {
+		fmt.Println(usage)
+	}
+
+Example to List the Resources Close to Their Limit
+
+	for _, usage := range report.AboveRatio(0.8) {
+		fmt.Printf("%s/%s: %d of %d used\n", usage.Service, usage.Resource, usage.InUse, usage.Limit)
+	}
+*/
+package quotareport
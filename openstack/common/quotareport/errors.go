@@ -0,0 +1,24 @@
+package quotareport
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ErrServiceFailed is returned by Get when the quotas or the resources of a
+// service could not be retrieved.
+type ErrServiceFailed struct {
+	gophercloud.BaseError
+	Service Service
+	Err     error
+}
+
+func (e ErrServiceFailed) Error() string {
+	return fmt.Sprintf("Unable to retrieve the %s quota usage: %s", e.Service, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e ErrServiceFailed) Unwrap() error {
+	return e.Err
+}
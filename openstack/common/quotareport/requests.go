@@ -0,0 +1,250 @@
+package quotareport
+
+import (
+	"github.com/gophercloud/gophercloud"
+	blockstoragequotasets "github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/quotasets"
+	computequotasets "github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/quotasets"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/l7policies"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/monitors"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/pools"
+	loadbalancerquotas "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/quotas"
+	networkquotas "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/quotas"
+)
+
+// Clients are the service clients used to build a Report. The services whose
+// client is nil are left out of the Report.
+type Clients struct {
+	Compute      *gophercloud.ServiceClient
+	BlockStorage *gophercloud.ServiceClient
+	Network      *gophercloud.ServiceClient
+	LoadBalancer *gophercloud.ServiceClient
+}
+
+// Get builds the quota Report of a project. It requires the admin role, or
+// the project to be the one the clients are scoped to.
+func Get(clients Clients, projectID string) (*Report, error) {
+	if projectID == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "projectID"
+		return nil, err
+	}
+
+	report := &Report{ProjectID: projectID}
+
+	collectors := []struct {
+		service Service
+		client  *gophercloud.ServiceClient
+		collect func(*gophercloud.ServiceClient, string) ([]Usage, error)
+	}{
+		{ServiceCompute, clients.Compute, computeUsages},
+		{ServiceBlockStorage, clients.BlockStorage, blockStorageUsages},
+		{ServiceNetwork, clients.Network, networkUsages},
+		{ServiceLoadBalancer, clients.LoadBalancer, loadBalancerUsages},
+	}
+	for _, c := range collectors {
+		if c.client == nil {
+			continue
+		}
+		usages, err := c.collect(c.client, projectID)
+		if err != nil {
+			return nil, ErrServiceFailed{Service: c.service, Err: err}
+		}
+		report.Usages = append(report.Usages, usages...)
+	}
+
+	return report, nil
+}
+
+func computeUsages(client *gophercloud.ServiceClient, projectID string) ([]Usage, error) {
+	q, err := computequotasets.GetDetail(client, projectID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	// The network related quotas of Compute are proxied to Networking and
+	// are therefore left out.
+	details := []struct {
+		resource string
+		detail   computequotasets.QuotaDetail
+	}{
+		{"instances", q.Instances},
+		{"cores", q.Cores},
+		{"ram", q.RAM},
+		{"key_pairs", q.KeyPairs},
+		{"server_groups", q.ServerGroups},
+		{"server_group_members", q.ServerGroupMembers},
+		{"metadata_items", q.MetadataItems},
+		{"injected_files", q.InjectedFiles},
+		{"injected_file_content_bytes", q.InjectedFileContentBytes},
+		{"injected_file_path_bytes", q.InjectedFilePathBytes},
+	}
+
+	usages := make([]Usage, len(details))
+	for i, d := range details {
+		usages[i] = Usage{
+			Service:  ServiceCompute,
+			Resource: d.resource,
+			InUse:    d.detail.InUse,
+			Reserved: d.detail.Reserved,
+			Limit:    d.detail.Limit,
+		}
+	}
+	return usages, nil
+}
+
+func blockStorageUsages(client *gophercloud.ServiceClient, projectID string) ([]Usage, error) {
+	q, err := blockstoragequotasets.GetUsage(client, projectID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	// per_volume_gigabytes limits the size of a single volume and has no
+	// usage, it is therefore left out.
+	details := []struct {
+		resource string
+		usage    blockstoragequotasets.QuotaUsage
+	}{
+		{"volumes", q.Volumes},
+		{"gigabytes", q.Gigabytes},
+		{"snapshots", q.Snapshots},
+		{"backups", q.Backups},
+		{"backup_gigabytes", q.BackupGigabytes},
+		{"groups", q.Groups},
+	}
+
+	usages := make([]Usage, len(details))
+	for i, d := range details {
+		usages[i] = Usage{
+			Service:  ServiceBlockStorage,
+			Resource: d.resource,
+			InUse:    d.usage.InUse,
+			Reserved: d.usage.Reserved,
+			Limit:    d.usage.Limit,
+		}
+	}
+	return usages, nil
+}
+
+func networkUsages(client *gophercloud.ServiceClient, projectID string) ([]Usage, error) {
+	q, err := networkquotas.GetDetail(client, projectID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	details := []struct {
+		resource string
+		detail   networkquotas.QuotaDetail
+	}{
+		{"network", q.Network},
+		{"subnet", q.Subnet},
+		{"subnetpool", q.SubnetPool},
+		{"port", q.Port},
+		{"router", q.Router},
+		{"floatingip", q.FloatingIP},
+		{"security_group", q.SecurityGroup},
+		{"security_group_rule", q.SecurityGroupRule},
+		{"rbac_policy", q.RBACPolicy},
+		{"trunk", q.Trunk},
+	}
+
+	usages := make([]Usage, len(details))
+	for i, d := range details {
+		usages[i] = Usage{
+			Service:  ServiceNetwork,
+			Resource: d.resource,
+			InUse:    d.detail.Used,
+			Reserved: d.detail.Reserved,
+			Limit:    d.detail.Limit,
+		}
+	}
+	return usages, nil
+}
+
+func loadBalancerUsages(client *gophercloud.ServiceClient, projectID string) ([]Usage, error) {
+	q, err := loadbalancerquotas.Get(client, projectID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	allPages, err := loadbalancers.List(client, loadbalancers.ListOpts{ProjectID: projectID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	allLoadBalancers, err := loadbalancers.ExtractLoadBalancers(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	allPages, err = listeners.List(client, listeners.ListOpts{ProjectID: projectID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	allListeners, err := listeners.ExtractListeners(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	allPages, err = pools.List(client, pools.ListOpts{ProjectID: projectID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	allPools, err := pools.ExtractPools(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	allPages, err = monitors.List(client, monitors.ListOpts{ProjectID: projectID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	allMonitors, err := monitors.ExtractMonitors(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	allPages, err = l7policies.List(client, l7policies.ListOpts{ProjectID: projectID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	allPolicies, err := l7policies.ExtractL7Policies(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	// Members and rules are counted from their parent, which lists their IDs,
+	// to avoid listing them pool by pool and policy by policy.
+	var members, rules int
+	for _, pool := range allPools {
+		members += len(pool.Members)
+	}
+	for _, policy := range allPolicies {
+		rules += len(policy.Rules)
+	}
+
+	details := []struct {
+		resource string
+		inUse    int
+		limit    int
+	}{
+		{"loadbalancer", len(allLoadBalancers), q.Loadbalancer},
+		{"listener", len(allListeners), q.Listener},
+		{"pool", len(allPools), q.Pool},
+		{"member", members, q.Member},
+		{"healthmonitor", len(allMonitors), q.Healthmonitor},
+		{"l7policy", len(allPolicies), q.L7Policy},
+		{"l7rule", rules, q.L7Rule},
+	}
+
+	usages := make([]Usage, len(details))
+	for i, d := range details {
+		usages[i] = Usage{
+			Service:  ServiceLoadBalancer,
+			Resource: d.resource,
+			InUse:    d.inUse,
+			Limit:    d.limit,
+		}
+	}
+	return usages, nil
+}
@@ -0,0 +1,119 @@
+package quotareport
+
+import "fmt"
+
+// Service identifies the service a quota belongs to.
+type Service string
+
+const (
+	ServiceCompute      Service = "compute"
+	ServiceBlockStorage Service = "block-storage"
+	ServiceNetwork      Service = "network"
+	ServiceLoadBalancer Service = "load-balancer"
+)
+
+// Usage is the usage and limit of a resource of a service.
+type Usage struct {
+	// Service is the service the resource belongs to.
+	Service Service
+
+	// Resource is the name of the resource, as used by the quota API of the
+	// service.
+	Resource string
+
+	// InUse is the current number of provisioned resources.
+	InUse int
+
+	// Reserved is the number of resources claimed against the quota but not
+	// yet provisioned. It is always 0 for the Load Balancer service.
+	Reserved int
+
+	// Limit is the maximum number of resources. A "-1" value means no limit.
+	Limit int
+}
+
+// Unlimited returns true if the resource has no limit.
+func (u Usage) Unlimited() bool {
+	return u.Limit < 0
+}
+
+// Available returns the number of resources that can still be provisioned,
+// or -1 if the resource has no limit.
+func (u Usage) Available() int {
+	if u.Unlimited() {
+		return -1
+	}
+	if available := u.Limit - u.InUse - u.Reserved; available > 0 {
+		return available
+	}
+	return 0
+}
+
+// Ratio returns the share of the limit which is used or reserved. It returns
+// 0 if the resource has no limit, and 1 if the limit is 0 and some resources
+// are in use.
+func (u Usage) Ratio() float64 {
+	used := u.InUse + u.Reserved
+	switch {
+	case u.Unlimited():
+		return 0
+	case u.Limit == 0 && used == 0:
+		return 0
+	case u.Limit == 0:
+		return 1
+	}
+	return float64(used) / float64(u.Limit)
+}
+
+// String returns a human readable representation of the Usage.
+func (u Usage) String() string {
+	limit := "unlimited"
+	if !u.Unlimited() {
+		limit = fmt.Sprintf("%d", u.Limit)
+	}
+	return fmt.Sprintf("%s/%s: %d in use, %d reserved, limit %s", u.Service, u.Resource, u.InUse, u.Reserved, limit)
+}
+
+// Report is the quota usage of a project across services.
+type Report struct {
+	// ProjectID is the ID of the project.
+	ProjectID string
+
+	// Usages are the usages of the resources, grouped by service in the
+	// order of Compute, Block Storage, Networking and Load Balancer.
+	Usages []Usage
+}
+
+// Find returns the usage of a resource of a service, and whether it was
+// found in the report.
+func (r Report) Find(service Service, resource string) (Usage, bool) {
+	for _, u := range r.Usages {
+		if u.Service == service && u.Resource == resource {
+			return u, true
+		}
+	}
+	return Usage{}, false
+}
+
+// ByService returns the usages of the resources of a service.
+func (r Report) ByService(service Service) []Usage {
+	var usages []Usage
+	for _, u := range r.Usages {
+		if u.Service == service {
+			usages = append(usages, u)
+		}
+	}
+	return usages
+}
+
+// AboveRatio returns the usages whose Ratio is greater than or equal to
+// ratio.
+func (r Report) AboveRatio(ratio float64) []Usage {
+	var usages []Usage
+	for _, u := range r.Usages {
+		if !u.Unlimited() && u.Ratio() >= ratio {
+			usages = append(usages, u)
+		}
+	}
+	return usages
+}
@@ -0,0 +1,2 @@
+// quotareport unit tests
+package testing
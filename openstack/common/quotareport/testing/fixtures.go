@@ -0,0 +1,277 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/common/quotareport"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ProjectID is the ID of the project of the report.
+const ProjectID = "0a73845280574ad389c292f6a74afa76"
+
+// ComputeQuotaDetailOutput is a sample response to a Compute quota set
+// GetDetail call.
+const ComputeQuotaDetailOutput = `
+{
+    "quota_set": {
+        "id": "0a73845280574ad389c292f6a74afa76",
+        "cores": {"in_use": 12, "reserved": 2, "limit": 20},
+        "instances": {"in_use": 6, "reserved": 0, "limit": 10},
+        "ram": {"in_use": 24576, "reserved": 0, "limit": 51200},
+        "key_pairs": {"in_use": 1, "reserved": 0, "limit": 100},
+        "server_groups": {"in_use": 2, "reserved": 0, "limit": 10},
+        "server_group_members": {"in_use": 4, "reserved": 0, "limit": 10},
+        "metadata_items": {"in_use": 0, "reserved": 0, "limit": 128},
+        "injected_files": {"in_use": 0, "reserved": 0, "limit": 5},
+        "injected_file_content_bytes": {"in_use": 0, "reserved": 0, "limit": 10240},
+        "injected_file_path_bytes": {"in_use": 0, "reserved": 0, "limit": 255},
+        "fixed_ips": {"in_use": 0, "reserved": 0, "limit": -1},
+        "floating_ips": {"in_use": 0, "reserved": 0, "limit": 10},
+        "security_groups": {"in_use": 0, "reserved": 0, "limit": 10},
+        "security_group_rules": {"in_use": 0, "reserved": 0, "limit": 20}
+    }
+}
+`
+
+// BlockStorageQuotaUsageOutput is a sample response to a Block Storage quota
+// set GetUsage call.
+const BlockStorageQuotaUsageOutput = `
+{
+    "quota_set": {
+        "id": "0a73845280574ad389c292f6a74afa76",
+        "volumes": {"in_use": 8, "allocated": 0, "reserved": 1, "limit": 10},
+        "snapshots": {"in_use": 3, "allocated": 0, "reserved": 0, "limit": 10},
+        "gigabytes": {"in_use": 500, "allocated": 0, "reserved": 0, "limit": 1000},
+        "per_volume_gigabytes": {"in_use": 0, "allocated": 0, "reserved": 0, "limit": -1},
+        "backups": {"in_use": 0, "allocated": 0, "reserved": 0, "limit": 10},
+        "backup_gigabytes": {"in_use": 0, "allocated": 0, "reserved": 0, "limit": 1000},
+        "groups": {"in_use": 0, "allocated": 0, "reserved": 0, "limit": 10}
+    }
+}
+`
+
+// NetworkQuotaDetailOutput is a sample response to a Networking quota
+// GetDetail call.
+const NetworkQuotaDetailOutput = `
+{
+    "quota": {
+        "network": {"used": 2, "reserved": 0, "limit": 10},
+        "subnet": {"used": 2, "reserved": 0, "limit": 10},
+        "subnetpool": {"used": 0, "reserved": 0, "limit": -1},
+        "port": {"used": 18, "reserved": 1, "limit": 50},
+        "router": {"used": 1, "reserved": 0, "limit": 10},
+        "floatingip": {"used": 5, "reserved": 0, "limit": 5},
+        "security_group": {"used": 3, "reserved": 0, "limit": 10},
+        "security_group_rule": {"used": 20, "reserved": 0, "limit": 100},
+        "rbac_policy": {"used": 0, "reserved": 0, "limit": 10},
+        "trunk": {"used": 0, "reserved": 0, "limit": -1}
+    }
+}
+`
+
+// LoadBalancerQuotaOutput is a sample response to a Load Balancer quota Get
+// call.
+const LoadBalancerQuotaOutput = `
+{
+    "quota": {
+        "loadbalancer": 5,
+        "listener": -1,
+        "member": 50,
+        "pool": 10,
+        "healthmonitor": 10,
+        "l7policy": -1,
+        "l7rule": -1
+    }
+}
+`
+
+// LoadBalancerListOutput is a sample response to a load balancer List call.
+const LoadBalancerListOutput = `
+{
+    "loadbalancers": [
+        {"id": "36e08a3e-a78f-4b40-a229-1e7e23eee1ab", "project_id": "0a73845280574ad389c292f6a74afa76"}
+    ]
+}
+`
+
+// ListenerListOutput is a sample response to a listener List call.
+const ListenerListOutput = `
+{
+    "listeners": [
+        {"id": "39de4d56-d663-46e5-85a1-5b9d5fa17829", "project_id": "0a73845280574ad389c292f6a74afa76"},
+        {"id": "a6ea3d8b-8cb9-4c48-8a44-0e4bc0d1d8a7", "project_id": "0a73845280574ad389c292f6a74afa76"}
+    ]
+}
+`
+
+// PoolListOutput is a sample response to a pool List call.
+const PoolListOutput = `
+{
+    "pools": [
+        {
+            "id": "72741b06-df4d-4715-b142-276b6bce75ab",
+            "project_id": "0a73845280574ad389c292f6a74afa76",
+            "members": [
+                {"id": "2a280670-c202-4b0b-a562-34077415aabf"},
+                {"id": "fad389a3-9a4a-4762-a365-8c7038508b5d"}
+            ]
+        },
+        {
+            "id": "c3741b06-df4d-4715-b142-276b6bce75ab",
+            "project_id": "0a73845280574ad389c292f6a74afa76",
+            "members": [
+                {"id": "7d19ad6c-d549-453e-a5cd-05382c6be96a"}
+            ]
+        }
+    ]
+}
+`
+
+// MonitorListOutput is a sample response to a health monitor List call.
+const MonitorListOutput = `
+{
+    "healthmonitors": [
+        {"id": "466c8345-28d8-4f84-a246-e04380b0461d", "project_id": "0a73845280574ad389c292f6a74afa76"}
+    ]
+}
+`
+
+// L7PolicyListOutput is a sample response to a L7 policy List call.
+const L7PolicyListOutput = `
+{
+    "l7policies": [
+        {
+            "id": "8a1412f0-4c32-4257-8b07-af4770b604fd",
+            "project_id": "0a73845280574ad389c292f6a74afa76",
+            "rules": [
+                {"id": "16621dbb-a736-4888-a57a-3ecd53df784c"},
+                {"id": "27621dbb-a736-4888-a57a-3ecd53df784c"}
+            ]
+        }
+    ]
+}
+`
+
+// ExpectedComputeUsages are the usages expected from ComputeQuotaDetailOutput.
+var ExpectedComputeUsages = []quotareport.Usage{
+	{Service: quotareport.ServiceCompute, Resource: "instances", InUse: 6, Limit: 10},
+	{Service: quotareport.ServiceCompute, Resource: "cores", InUse: 12, Reserved: 2, Limit: 20},
+	{Service: quotareport.ServiceCompute, Resource: "ram", InUse: 24576, Limit: 51200},
+	{Service: quotareport.ServiceCompute, Resource: "key_pairs", InUse: 1, Limit: 100},
+	{Service: quotareport.ServiceCompute, Resource: "server_groups", InUse: 2, Limit: 10},
+	{Service: quotareport.ServiceCompute, Resource: "server_group_members", InUse: 4, Limit: 10},
+	{Service: quotareport.ServiceCompute, Resource: "metadata_items", Limit: 128},
+	{Service: quotareport.ServiceCompute, Resource: "injected_files", Limit: 5},
+	{Service: quotareport.ServiceCompute, Resource: "injected_file_content_bytes", Limit: 10240},
+	{Service: quotareport.ServiceCompute, Resource: "injected_file_path_bytes", Limit: 255},
+}
+
+// ExpectedBlockStorageUsages are the usages expected from
+// BlockStorageQuotaUsageOutput.
+var ExpectedBlockStorageUsages = []quotareport.Usage{
+	{Service: quotareport.ServiceBlockStorage, Resource: "volumes", InUse: 8, Reserved: 1, Limit: 10},
+	{Service: quotareport.ServiceBlockStorage, Resource: "gigabytes", InUse: 500, Limit: 1000},
+	{Service: quotareport.ServiceBlockStorage, Resource: "snapshots", InUse: 3, Limit: 10},
+	{Service: quotareport.ServiceBlockStorage, Resource: "backups", Limit: 10},
+	{Service: quotareport.ServiceBlockStorage, Resource: "backup_gigabytes", Limit: 1000},
+	{Service: quotareport.ServiceBlockStorage, Resource: "groups", Limit: 10},
+}
+
+// ExpectedNetworkUsages are the usages expected from NetworkQuotaDetailOutput.
+var ExpectedNetworkUsages = []quotareport.Usage{
+	{Service: quotareport.ServiceNetwork, Resource: "network", InUse: 2, Limit: 10},
+	{Service: quotareport.ServiceNetwork, Resource: "subnet", InUse: 2, Limit: 10},
+	{Service: quotareport.ServiceNetwork, Resource: "subnetpool", Limit: -1},
+	{Service: quotareport.ServiceNetwork, Resource: "port", InUse: 18, Reserved: 1, Limit: 50},
+	{Service: quotareport.ServiceNetwork, Resource: "router", InUse: 1, Limit: 10},
+	{Service: quotareport.ServiceNetwork, Resource: "floatingip", InUse: 5, Limit: 5},
+	{Service: quotareport.ServiceNetwork, Resource: "security_group", InUse: 3, Limit: 10},
+	{Service: quotareport.ServiceNetwork, Resource: "security_group_rule", InUse: 20, Limit: 100},
+	{Service: quotareport.ServiceNetwork, Resource: "rbac_policy", Limit: 10},
+	{Service: quotareport.ServiceNetwork, Resource: "trunk", Limit: -1},
+}
+
+// ExpectedLoadBalancerUsages are the usages expected from the Load Balancer
+// outputs.
+var ExpectedLoadBalancerUsages = []quotareport.Usage{
+	{Service: quotareport.ServiceLoadBalancer, Resource: "loadbalancer", InUse: 1, Limit: 5},
+	{Service: quotareport.ServiceLoadBalancer, Resource: "listener", InUse: 2, Limit: -1},
+	{Service: quotareport.ServiceLoadBalancer, Resource: "pool", InUse: 2, Limit: 10},
+	{Service: quotareport.ServiceLoadBalancer, Resource: "member", InUse: 3, Limit: 50},
+	{Service: quotareport.ServiceLoadBalancer, Resource: "healthmonitor", InUse: 1, Limit: 10},
+	{Service: quotareport.ServiceLoadBalancer, Resource: "l7policy", InUse: 1, Limit: -1},
+	{Service: quotareport.ServiceLoadBalancer, Resource: "l7rule", InUse: 2, Limit: -1},
+}
+
+func handleGet(t *testing.T, path string, output string) {
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, output)
+	})
+}
+
+// HandleComputeSuccessfully configures the test server to respond to a
+// Compute quota set GetDetail request.
+func HandleComputeSuccessfully(t *testing.T) {
+	handleGet(t, "/os-quota-sets/"+ProjectID+"/detail", ComputeQuotaDetailOutput)
+}
+
+// HandleBlockStorageSuccessfully configures the test server to respond to a
+// Block Storage quota set GetUsage request.
+func HandleBlockStorageSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-quota-sets/"+ProjectID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"usage": "true"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, BlockStorageQuotaUsageOutput)
+	})
+}
+
+// HandleNetworkSuccessfully configures the test server to respond to a
+// Networking quota GetDetail request.
+func HandleNetworkSuccessfully(t *testing.T) {
+	handleGet(t, "/v2.0/quotas/"+ProjectID+"/details.json", NetworkQuotaDetailOutput)
+}
+
+// HandleNetworkFailure configures the test server to fail a Networking quota
+// GetDetail request.
+func HandleNetworkFailure(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/quotas/"+ProjectID+"/details.json", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.WriteHeader(http.StatusForbidden)
+	})
+}
+
+// HandleLoadBalancerSuccessfully configures the test server to respond to the
+// Load Balancer quota Get and resource List requests.
+func HandleLoadBalancerSuccessfully(t *testing.T) {
+	handleGet(t, "/quotas/"+ProjectID, LoadBalancerQuotaOutput)
+
+	lists := map[string]string{
+		"/lbaas/loadbalancers":  LoadBalancerListOutput,
+		"/lbaas/listeners":      ListenerListOutput,
+		"/lbaas/pools":          PoolListOutput,
+		"/lbaas/healthmonitors": MonitorListOutput,
+		"/lbaas/l7policies":     L7PolicyListOutput,
+	}
+	for path, output := range lists {
+		output := output
+		th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			th.TestFormValues(t, r, map[string]string{"project_id": ProjectID})
+
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprint(w, output)
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package testing
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/common/quotareport"
+	fake "github.com/gophercloud/gophercloud/openstack/networking/v2/common"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleComputeSuccessfully(t)
+	HandleBlockStorageSuccessfully(t)
+	HandleNetworkSuccessfully(t)
+	HandleLoadBalancerSuccessfully(t)
+
+	report, err := quotareport.Get(quotareport.Clients{
+		Compute:      client.ServiceClient(),
+		BlockStorage: client.ServiceClient(),
+		Network:      fake.ServiceClient(),
+		LoadBalancer: client.ServiceClient(),
+	}, ProjectID)
+	th.AssertNoErr(t, err)
+
+	var expected []quotareport.Usage
+	expected = append(expected, ExpectedComputeUsages...)
+	expected = append(expected, ExpectedBlockStorageUsages...)
+	expected = append(expected, ExpectedNetworkUsages...)
+	expected = append(expected, ExpectedLoadBalancerUsages...)
+
+	th.AssertEquals(t, ProjectID, report.ProjectID)
+	th.AssertDeepEquals(t, expected, report.Usages)
+	th.AssertDeepEquals(t, ExpectedNetworkUsages, report.ByService(quotareport.ServiceNetwork))
+
+	usage, ok := report.Find(quotareport.ServiceCompute, "cores")
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, 6, usage.Available())
+
+	_, ok = report.Find(quotareport.ServiceCompute, "floating_ips")
+	th.AssertEquals(t, false, ok)
+
+	above := report.AboveRatio(0.8)
+	th.AssertEquals(t, 2, len(above))
+	th.AssertEquals(t, "volumes", above[0].Resource)
+	th.AssertEquals(t, "floatingip", above[1].Resource)
+}
+
+func TestGetSkipsNilClients(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleNetworkSuccessfully(t)
+
+	report, err := quotareport.Get(quotareport.Clients{
+		Network: fake.ServiceClient(),
+	}, ProjectID)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, ExpectedNetworkUsages, report.Usages)
+}
+
+func TestGetServiceFailure(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleComputeSuccessfully(t)
+	HandleNetworkFailure(t)
+
+	_, err := quotareport.Get(quotareport.Clients{
+		Compute: client.ServiceClient(),
+		Network: fake.ServiceClient(),
+	}, ProjectID)
+
+	var serviceErr quotareport.ErrServiceFailed
+	th.AssertEquals(t, true, errors.As(err, &serviceErr))
+	th.AssertEquals(t, quotareport.ServiceNetwork, serviceErr.Service)
+
+	var forbidden gophercloud.ErrDefault403
+	th.AssertEquals(t, true, errors.As(err, &forbidden))
+}
+
+func TestGetMissingProjectID(t *testing.T) {
+	_, err := quotareport.Get(quotareport.Clients{}, "")
+
+	var missing gophercloud.ErrMissingInput
+	th.AssertEquals(t, true, errors.As(err, &missing))
+}
+
+func TestUsage(t *testing.T) {
+	limited := quotareport.Usage{InUse: 7, Reserved: 1, Limit: 10}
+	th.AssertEquals(t, false, limited.Unlimited())
+	th.AssertEquals(t, 2, limited.Available())
+	th.AssertEquals(t, 0.8, limited.Ratio())
+
+	over := quotareport.Usage{InUse: 12, Limit: 10}
+	th.AssertEquals(t, 0, over.Available())
+
+	unlimited := quotareport.Usage{InUse: 7, Limit: -1}
+	th.AssertEquals(t, true, unlimited.Unlimited())
+	th.AssertEquals(t, -1, unlimited.Available())
+	th.AssertEquals(t, 0.0, unlimited.Ratio())
+
+	zero := quotareport.Usage{Limit: 0}
+	th.AssertEquals(t, 0.0, zero.Ratio())
+	zero.InUse = 1
+	th.AssertEquals(t, 1.0, zero.Ratio())
+
+	usage := quotareport.Usage{Service: quotareport.ServiceCompute, Resource: "cores", InUse: 12, Reserved: 2, Limit: 20}
+	th.AssertEquals(t, "compute/cores: 12 in use, 2 reserved, limit 20", usage.String())
+	unlimited.Service = quotareport.ServiceNetwork
+	unlimited.Resource = "trunk"
+	th.AssertEquals(t, "network/trunk: 7 in use, 0 reserved, limit unlimited", unlimited.String())
+}
@@ -0,0 +1,23 @@
+/*
+Package instanceusageauditlog provides the ability to retrieve the status of
+the instance usage audit task of the compute services. This is an admin-only
+API.
+
+Example to Get the Audit Log of the Current Period
+
+	auditLog, err := instanceusageauditlog.Get(computeClient).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%s: %d/%d hosts done\n", auditLog.OverallStatus, auditLog.NumHostsDone, auditLog.NumHosts)
+
+Example to Get the Audit Log of the Period Before a Time
+
+	before := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	auditLog, err := instanceusageauditlog.GetBefore(computeClient, before).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package instanceusageauditlog
@@ -0,0 +1,22 @@
+package instanceusageauditlog
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// Get retrieves the audit log of the current audit period.
+func Get(client *gophercloud.ServiceClient) (r GetResult) {
+	resp, err := client.Get(getURL(client), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetBefore retrieves the audit log of the last audit period which ended
+// before the given time.
+func GetBefore(client *gophercloud.ServiceClient, before time.Time) (r GetResult) {
+	resp, err := client.Get(getBeforeURL(client, before.UTC().Format(timeFormat)), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,113 @@
+package instanceusageauditlog
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// timeFormat is the format of the times used by the API.
+const timeFormat = "2006-01-02 15:04:05"
+
+// HostLog is the audit log of a single compute host.
+type HostLog struct {
+	// Errors is the number of errors encountered by the audit task.
+	Errors int `json:"errors"`
+
+	// Instances is the number of instances audited.
+	Instances int `json:"instances"`
+
+	// Message is the message of the audit task.
+	Message string `json:"message"`
+
+	// State is the state of the audit task, either "DONE" or "RUNNING".
+	State string `json:"state"`
+}
+
+// AuditLog is the audit log of the instance usage audit task for an audit
+// period.
+type AuditLog struct {
+	// HostsNotRun are the hosts on which the audit task did not run.
+	HostsNotRun []string `json:"hosts_not_run"`
+
+	// Log is the audit log of every host, keyed by host name.
+	Log map[string]HostLog `json:"log"`
+
+	// NumHosts is the number of hosts.
+	NumHosts int `json:"num_hosts"`
+
+	// NumHostsDone is the number of hosts on which the audit task is done.
+	NumHostsDone int `json:"num_hosts_done"`
+
+	// NumHostsNotRun is the number of hosts on which the audit task did not
+	// run.
+	NumHostsNotRun int `json:"num_hosts_not_run"`
+
+	// NumHostsRunning is the number of hosts on which the audit task is
+	// running.
+	NumHostsRunning int `json:"num_hosts_running"`
+
+	// OverallStatus summarizes the status of the audit task.
+	OverallStatus string `json:"overall_status"`
+
+	// PeriodBeginning is the beginning of the audit period.
+	PeriodBeginning time.Time `json:"-"`
+
+	// PeriodEnding is the end of the audit period.
+	PeriodEnding time.Time `json:"-"`
+
+	// TotalErrors is the number of errors encountered on all the hosts.
+	TotalErrors int `json:"total_errors"`
+
+	// TotalInstances is the number of instances audited on all the hosts.
+	TotalInstances int `json:"total_instances"`
+}
+
+// UnmarshalJSON converts our JSON API response into our audit log struct.
+func (r *AuditLog) UnmarshalJSON(b []byte) error {
+	type tmp AuditLog
+	var s struct {
+		tmp
+		PeriodBeginning string `json:"period_beginning"`
+		PeriodEnding    string `json:"period_ending"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = AuditLog(s.tmp)
+
+	if s.PeriodBeginning != "" {
+		if r.PeriodBeginning, err = time.Parse(timeFormat, s.PeriodBeginning); err != nil {
+			return err
+		}
+	}
+	if s.PeriodEnding != "" {
+		if r.PeriodEnding, err = time.Parse(timeFormat, s.PeriodEnding); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetResult is the response from a Get or GetBefore operation. Call its
+// Extract method to interpret it as an AuditLog.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult as an AuditLog. The API wraps the audit log
+// of Get and GetBefore in differently named keys, both are handled.
+func (r GetResult) Extract() (*AuditLog, error) {
+	var s struct {
+		AuditLogs *AuditLog `json:"instance_usage_audit_logs"`
+		AuditLog  *AuditLog `json:"instance_usage_audit_log"`
+	}
+	err := r.ExtractInto(&s)
+	if s.AuditLog != nil {
+		return s.AuditLog, err
+	}
+	return s.AuditLogs, err
+}
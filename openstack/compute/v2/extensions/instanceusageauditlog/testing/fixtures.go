@@ -0,0 +1,100 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/instanceusageauditlog"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// auditLogBody is the audit log returned by the sample responses.
+const auditLogBody = `
+{
+  "hosts_not_run": ["samplehost3"],
+  "log": {
+    "samplehost0": {
+      "errors": 1,
+      "instances": 1,
+      "message": "Instance usage audit ran for host samplehost0, 1 instances in 0.01 seconds.",
+      "state": "DONE"
+    },
+    "samplehost1": {
+      "errors": 1,
+      "instances": 2,
+      "message": "Instance usage audit ran for host samplehost1, 2 instances in 0.01 seconds.",
+      "state": "DONE"
+    }
+  },
+  "num_hosts": 4,
+  "num_hosts_done": 2,
+  "num_hosts_not_run": 1,
+  "num_hosts_running": 1,
+  "overall_status": "2 of 4 hosts done. 2 errors.",
+  "period_beginning": "2012-06-01 00:00:00",
+  "period_ending": "2012-07-01 00:00:00",
+  "total_errors": 2,
+  "total_instances": 3
+}
+`
+
+// GetOutput is a sample response to a Get request.
+var GetOutput = fmt.Sprintf(`{"instance_usage_audit_logs": %s}`, auditLogBody)
+
+// GetBeforeOutput is a sample response to a GetBefore request.
+var GetBeforeOutput = fmt.Sprintf(`{"instance_usage_audit_log": %s}`, auditLogBody)
+
+// AuditLogExpected is the expected audit log of the sample responses.
+var AuditLogExpected = &instanceusageauditlog.AuditLog{
+	HostsNotRun: []string{"samplehost3"},
+	Log: map[string]instanceusageauditlog.HostLog{
+		"samplehost0": {
+			Errors:    1,
+			Instances: 1,
+			Message:   "Instance usage audit ran for host samplehost0, 1 instances in 0.01 seconds.",
+			State:     "DONE",
+		},
+		"samplehost1": {
+			Errors:    1,
+			Instances: 2,
+			Message:   "Instance usage audit ran for host samplehost1, 2 instances in 0.01 seconds.",
+			State:     "DONE",
+		},
+	},
+	NumHosts:        4,
+	NumHostsDone:    2,
+	NumHostsNotRun:  1,
+	NumHostsRunning: 1,
+	OverallStatus:   "2 of 4 hosts done. 2 errors.",
+	PeriodBeginning: time.Date(2012, 6, 1, 0, 0, 0, 0, time.UTC),
+	PeriodEnding:    time.Date(2012, 7, 1, 0, 0, 0, 0, time.UTC),
+	TotalErrors:     2,
+	TotalInstances:  3,
+}
+
+// HandleGetSuccessfully sets up the test server to respond to a Get request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-instance_usage_audit_log", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+// HandleGetBeforeSuccessfully sets up the test server to respond to a
+// GetBefore request.
+func HandleGetBeforeSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-instance_usage_audit_log/2012-07-05 10:00:00", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.AssertEquals(t, "/os-instance_usage_audit_log/2012-07-05%2010:00:00", r.URL.EscapedPath())
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, GetBeforeOutput)
+	})
+}
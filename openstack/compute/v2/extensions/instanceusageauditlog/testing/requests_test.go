@@ -0,0 +1,31 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/instanceusageauditlog"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := instanceusageauditlog.Get(client.ServiceClient()).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, AuditLogExpected, actual)
+}
+
+func TestGetBefore(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetBeforeSuccessfully(t)
+
+	before := time.Date(2012, 7, 5, 10, 0, 0, 0, time.UTC)
+	actual, err := instanceusageauditlog.GetBefore(client.ServiceClient(), before).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, AuditLogExpected, actual)
+}
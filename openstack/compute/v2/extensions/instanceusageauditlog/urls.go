@@ -0,0 +1,17 @@
+package instanceusageauditlog
+
+import (
+	"net/url"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+const rootPath = "os-instance_usage_audit_log"
+
+func getURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL(rootPath)
+}
+
+func getBeforeURL(client *gophercloud.ServiceClient, before string) string {
+	return client.ServiceURL(rootPath, url.PathEscape(before))
+}
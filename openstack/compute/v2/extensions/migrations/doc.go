@@ -0,0 +1,57 @@
+/*
+Package migrations provides the ability to observe and control the
+migrations of servers. Migrations are started with the migrate and evacuate
+packages.
+
+Example to List All Migrations
+
+	listOpts := migrations.ListOpts{
+		Host:          "compute-01",
+		Status:        "running",
+		MigrationType: migrations.TypeLiveMigration,
+	}
+
+	allPages, err := migrations.List(computeClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allMigrations, err := migrations.ExtractMigrations(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, migration := range allMigrations {
+		fmt.Printf("%+v\n", migration)
+	}
+
+Example to List the In-Progress Live Migrations of a Server
+
+	serverID := "b16ba811-199d-4ffd-8839-ba96c1185a67"
+
+	allPages, err := migrations.ListByServer(computeClient, serverID).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	serverMigrations, err := migrations.ExtractMigrations(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Force the Completion of a Live Migration
+
+	migrationID := serverMigrations[0].ID
+	err := migrations.ForceComplete(computeClient, serverID, migrationID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Abort a Live Migration
+
+	err := migrations.Abort(computeClient, serverID, migrationID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package migrations
@@ -0,0 +1,152 @@
+package migrations
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Type is the type of a migration.
+type Type string
+
+const (
+	TypeMigration     Type = "migration"
+	TypeLiveMigration Type = "live-migration"
+	TypeEvacuation    Type = "evacuation"
+	TypeResize        Type = "resize"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToMigrationListQuery() (string, error)
+}
+
+// ListOpts represents options used to filter migrations in a List request.
+type ListOpts struct {
+	// Host filters the migrations by their source or destination host.
+	Host string `q:"host"`
+
+	// InstanceUUID filters the migrations by the server they apply to.
+	InstanceUUID string `q:"instance_uuid"`
+
+	// SourceCompute filters the migrations by their source compute service.
+	SourceCompute string `q:"source_compute"`
+
+	// Status filters the migrations by their status.
+	Status string `q:"status"`
+
+	// MigrationType filters the migrations by their type.
+	// This requires microversion 2.23 or later.
+	MigrationType Type `q:"migration_type"`
+
+	// Hidden includes the hidden migrations if set to true.
+	Hidden *bool `q:"hidden"`
+
+	// Limit is an integer value to limit the results to return.
+	// This requires microversion 2.59 or later.
+	Limit int `q:"limit"`
+
+	// Marker is the UUID of the last-seen migration.
+	// This requires microversion 2.59 or later.
+	Marker string `q:"marker"`
+
+	// ChangesSince filters the response by migrations updated after the given
+	// time.
+	// This requires microversion 2.59 or later.
+	ChangesSince *time.Time `q:"changes-since"`
+
+	// ChangesBefore filters the response by migrations updated before the
+	// given time.
+	// This requires microversion 2.66 or later.
+	ChangesBefore *time.Time `q:"changes-before"`
+
+	// UserID filters the migrations by the user which initiated them.
+	// This requires microversion 2.80 or later.
+	UserID string `q:"user_id"`
+
+	// ProjectID filters the migrations by the project of the server.
+	// This requires microversion 2.80 or later.
+	ProjectID string `q:"project_id"`
+}
+
+// ToMigrationListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToMigrationListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+
+	params := q.Query()
+
+	if opts.ChangesSince != nil {
+		params.Add("changes-since", opts.ChangesSince.Format(time.RFC3339))
+	}
+
+	if opts.ChangesBefore != nil {
+		params.Add("changes-before", opts.ChangesBefore.Format(time.RFC3339))
+	}
+
+	q = &url.URL{RawQuery: params.Encode()}
+	return q.String(), nil
+}
+
+// List makes a request against the API to list the migrations of all the
+// servers.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToMigrationListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return MigrationPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// ListByServer makes a request against the API to list the in-progress live
+// migrations of a server.
+// This requires microversion 2.23 or later.
+func ListByServer(client *gophercloud.ServiceClient, serverID string) pagination.Pager {
+	return pagination.NewPager(client, serverMigrationsURL(client, serverID), func(r pagination.PageResult) pagination.Page {
+		return MigrationPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get makes a request against the API to get an in-progress live migration
+// of a server.
+// This requires microversion 2.23 or later.
+func Get(client *gophercloud.ServiceClient, serverID string, migrationID int) (r GetResult) {
+	resp, err := client.Get(serverMigrationURL(client, serverID, migrationID), &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ForceComplete forces an in-progress live migration of a server to
+// complete, by pausing the server on the source host.
+// This requires microversion 2.22 or later.
+func ForceComplete(client *gophercloud.ServiceClient, serverID string, migrationID int) (r ForceCompleteResult) {
+	b := map[string]interface{}{"force_complete": nil}
+	resp, err := client.Post(actionURL(client, serverID, migrationID), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Abort aborts an in-progress live migration of a server.
+// This requires microversion 2.24 or later.
+func Abort(client *gophercloud.ServiceClient, serverID string, migrationID int) (r AbortResult) {
+	resp, err := client.Delete(serverMigrationURL(client, serverID, migrationID), &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
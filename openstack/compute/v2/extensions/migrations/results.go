@@ -0,0 +1,185 @@
+package migrations
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Migration represents a migration of a server. Some fields are only set by
+// List, and others only by ListByServer and Get.
+type Migration struct {
+	// ID is the ID of the migration.
+	ID int `json:"id"`
+
+	// UUID is the UUID of the migration.
+	// This requires microversion 2.59 or later.
+	UUID string `json:"uuid"`
+
+	// InstanceUUID is the UUID of the migrated server. It is set by List.
+	InstanceUUID string `json:"instance_uuid"`
+
+	// ServerUUID is the UUID of the migrated server. It is set by
+	// ListByServer and Get.
+	ServerUUID string `json:"server_uuid"`
+
+	// Status is the status of the migration.
+	Status string `json:"status"`
+
+	// MigrationType is the type of the migration. It is set by List.
+	// This requires microversion 2.23 or later.
+	MigrationType Type `json:"migration_type"`
+
+	// SourceCompute is the source compute service of the migration.
+	SourceCompute string `json:"source_compute"`
+
+	// SourceNode is the source compute node of the migration.
+	SourceNode string `json:"source_node"`
+
+	// DestCompute is the destination compute service of the migration.
+	DestCompute string `json:"dest_compute"`
+
+	// DestHost is the IP address of the destination host of the migration.
+	DestHost string `json:"dest_host"`
+
+	// DestNode is the destination compute node of the migration.
+	DestNode string `json:"dest_node"`
+
+	// OldInstanceTypeID is the ID of the flavor of the server before the
+	// migration. It is set by List.
+	OldInstanceTypeID int `json:"old_instance_type_id"`
+
+	// NewInstanceTypeID is the ID of the flavor of the server after the
+	// migration. It is set by List.
+	NewInstanceTypeID int `json:"new_instance_type_id"`
+
+	// MemoryTotalBytes is the amount of memory to transfer, in bytes. It is
+	// set by ListByServer and Get.
+	MemoryTotalBytes int64 `json:"memory_total_bytes"`
+
+	// MemoryProcessedBytes is the amount of memory transferred, in bytes. It
+	// is set by ListByServer and Get.
+	MemoryProcessedBytes int64 `json:"memory_processed_bytes"`
+
+	// MemoryRemainingBytes is the amount of memory left to transfer, in
+	// bytes. It is set by ListByServer and Get.
+	MemoryRemainingBytes int64 `json:"memory_remaining_bytes"`
+
+	// DiskTotalBytes is the amount of disk to transfer, in bytes. It is set
+	// by ListByServer and Get.
+	DiskTotalBytes int64 `json:"disk_total_bytes"`
+
+	// DiskProcessedBytes is the amount of disk transferred, in bytes. It is
+	// set by ListByServer and Get.
+	DiskProcessedBytes int64 `json:"disk_processed_bytes"`
+
+	// DiskRemainingBytes is the amount of disk left to transfer, in bytes.
+	// It is set by ListByServer and Get.
+	DiskRemainingBytes int64 `json:"disk_remaining_bytes"`
+
+	// UserID is the ID of the user which initiated the migration.
+	// This requires microversion 2.80 or later.
+	UserID string `json:"user_id"`
+
+	// ProjectID is the ID of the project of the migrated server.
+	// This requires microversion 2.80 or later.
+	ProjectID string `json:"project_id"`
+
+	// Links are the links to the server migration, for in-progress live
+	// migrations. It is set by List.
+	Links []gophercloud.Link `json:"links"`
+
+	// CreatedAt is the time the migration was created.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the time the migration was last updated.
+	UpdatedAt time.Time `json:"-"`
+}
+
+// UnmarshalJSON converts our JSON API response into our migration struct.
+func (r *Migration) UnmarshalJSON(b []byte) error {
+	type tmp Migration
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Migration(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return nil
+}
+
+// MigrationPage stores a single page of migrations from a List or
+// ListByServer call.
+type MigrationPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines if a MigrationPage contains any results.
+func (r MigrationPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	migrations, err := ExtractMigrations(r)
+	return len(migrations) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results.
+func (r MigrationPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"migrations_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// ExtractMigrations interprets the results of a single page from a List or
+// ListByServer call, producing a slice of Migration entities.
+func ExtractMigrations(r pagination.Page) ([]Migration, error) {
+	var s struct {
+		Migrations []Migration `json:"migrations"`
+	}
+	err := (r.(MigrationPage)).ExtractInto(&s)
+	return s.Migrations, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method to
+// interpret it as a Migration.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult as a Migration.
+func (r GetResult) Extract() (*Migration, error) {
+	var s struct {
+		Migration *Migration `json:"migration"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Migration, err
+}
+
+// ForceCompleteResult is the response from a ForceComplete operation. Call
+// its ExtractErr method to determine if the request succeeded or failed.
+type ForceCompleteResult struct {
+	gophercloud.ErrResult
+}
+
+// AbortResult is the response from an Abort operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type AbortResult struct {
+	gophercloud.ErrResult
+}
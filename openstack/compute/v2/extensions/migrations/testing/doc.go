@@ -0,0 +1,2 @@
+// migrations unit tests
+package testing
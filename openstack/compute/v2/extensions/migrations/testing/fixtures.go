@@ -0,0 +1,194 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/migrations"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput is a sample response to a List request.
+const ListOutput = `
+{
+  "migrations": [
+    {
+      "created_at": "2016-06-23T14:42:02.000000",
+      "dest_compute": "compute20",
+      "dest_host": "5.6.7.8",
+      "dest_node": "node20",
+      "id": 3,
+      "instance_uuid": "8600d31b-d1a1-4632-b2ff-45c2be1a70ff",
+      "new_instance_type_id": 1,
+      "old_instance_type_id": 1,
+      "source_compute": "compute10",
+      "source_node": "node10",
+      "status": "running",
+      "migration_type": "live-migration",
+      "uuid": "12341d4b-346a-40d0-83c6-5f4f6892b650",
+      "links": [
+        {
+          "href": "http://openstack.example.com/v2.1/6f70656e737461636b20342065766572/servers/8600d31b-d1a1-4632-b2ff-45c2be1a70ff/migrations/3",
+          "rel": "self"
+        }
+      ],
+      "updated_at": "2016-06-23T14:42:02.000000"
+    }
+  ],
+  "migrations_links": [
+    {
+      "href": "%s/os-migrations?host=compute10&limit=1&marker=12341d4b-346a-40d0-83c6-5f4f6892b650&migration_type=live-migration&status=running",
+      "rel": "next"
+    }
+  ]
+}
+`
+
+// ListExpected is the expected result of a List request.
+var ListExpected = []migrations.Migration{
+	{
+		ID:                3,
+		UUID:              "12341d4b-346a-40d0-83c6-5f4f6892b650",
+		InstanceUUID:      "8600d31b-d1a1-4632-b2ff-45c2be1a70ff",
+		Status:            "running",
+		MigrationType:     migrations.TypeLiveMigration,
+		SourceCompute:     "compute10",
+		SourceNode:        "node10",
+		DestCompute:       "compute20",
+		DestHost:          "5.6.7.8",
+		DestNode:          "node20",
+		OldInstanceTypeID: 1,
+		NewInstanceTypeID: 1,
+		Links: []gophercloud.Link{
+			{
+				Href: "http://openstack.example.com/v2.1/6f70656e737461636b20342065766572/servers/8600d31b-d1a1-4632-b2ff-45c2be1a70ff/migrations/3",
+				Rel:  "self",
+			},
+		},
+		CreatedAt: time.Date(2016, 6, 23, 14, 42, 2, 0, time.UTC),
+		UpdatedAt: time.Date(2016, 6, 23, 14, 42, 2, 0, time.UTC),
+	},
+}
+
+// ServerMigrationOutput is a sample server migration, as returned by
+// ListByServer and Get requests.
+const ServerMigrationOutput = `
+{
+  "created_at": "2016-01-29T13:42:02.000000",
+  "dest_compute": "compute2",
+  "dest_host": "1.2.3.4",
+  "dest_node": "node2",
+  "id": 1,
+  "server_uuid": "4cfba335-03d8-49b2-8c52-e69043d1e8fe",
+  "source_compute": "compute1",
+  "source_node": "node1",
+  "status": "running",
+  "memory_total_bytes": 123456,
+  "memory_processed_bytes": 12345,
+  "memory_remaining_bytes": 111111,
+  "disk_total_bytes": 234567,
+  "disk_processed_bytes": 23456,
+  "disk_remaining_bytes": 211111,
+  "updated_at": "2016-01-29T13:42:02.000000",
+  "uuid": "12341d4b-346a-40d0-83c6-5f4f6892b650",
+  "user_id": "8dbaa0f0-ab95-4ffe-8cb4-9c89d2ac9d24",
+  "project_id": "5f705771-3aa9-4f4c-8660-0d9522ffdbea"
+}
+`
+
+// ServerMigrationExpected is the expected server migration from
+// ListByServer and Get requests.
+var ServerMigrationExpected = migrations.Migration{
+	ID:                   1,
+	UUID:                 "12341d4b-346a-40d0-83c6-5f4f6892b650",
+	ServerUUID:           "4cfba335-03d8-49b2-8c52-e69043d1e8fe",
+	Status:               "running",
+	SourceCompute:        "compute1",
+	SourceNode:           "node1",
+	DestCompute:          "compute2",
+	DestHost:             "1.2.3.4",
+	DestNode:             "node2",
+	MemoryTotalBytes:     123456,
+	MemoryProcessedBytes: 12345,
+	MemoryRemainingBytes: 111111,
+	DiskTotalBytes:       234567,
+	DiskProcessedBytes:   23456,
+	DiskRemainingBytes:   211111,
+	UserID:               "8dbaa0f0-ab95-4ffe-8cb4-9c89d2ac9d24",
+	ProjectID:            "5f705771-3aa9-4f4c-8660-0d9522ffdbea",
+	CreatedAt:            time.Date(2016, 1, 29, 13, 42, 2, 0, time.UTC),
+	UpdatedAt:            time.Date(2016, 1, 29, 13, 42, 2, 0, time.UTC),
+}
+
+// HandleListSuccessfully sets up the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-migrations", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		r.ParseForm()
+		th.AssertEquals(t, "compute10", r.Form.Get("host"))
+		th.AssertEquals(t, "running", r.Form.Get("status"))
+		th.AssertEquals(t, "live-migration", r.Form.Get("migration_type"))
+		th.AssertEquals(t, "1", r.Form.Get("limit"))
+
+		switch marker := r.Form.Get("marker"); marker {
+		case "":
+			th.AssertEquals(t, "2016-06-23T00:00:00Z", r.Form.Get("changes-since"))
+			fmt.Fprintf(w, ListOutput, th.Server.URL)
+		case "12341d4b-346a-40d0-83c6-5f4f6892b650":
+			fmt.Fprint(w, `{"migrations": []}`)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", marker)
+		}
+	})
+}
+
+// HandleListByServerSuccessfully sets up the test server to respond to a
+// ListByServer request.
+func HandleListByServerSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/4cfba335-03d8-49b2-8c52-e69043d1e8fe/migrations", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"migrations": [%s]}`, ServerMigrationOutput)
+	})
+}
+
+// HandleServerMigrationSuccessfully sets up the test server to respond to
+// Get and Abort requests.
+func HandleServerMigrationSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/4cfba335-03d8-49b2-8c52-e69043d1e8fe/migrations/1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		switch r.Method {
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"migration": %s}`, ServerMigrationOutput)
+		case "DELETE":
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleForceCompleteSuccessfully sets up the test server to respond to a
+// ForceComplete request.
+func HandleForceCompleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/4cfba335-03d8-49b2-8c52-e69043d1e8fe/migrations/1/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"force_complete": null}`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
@@ -0,0 +1,78 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/migrations"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	changesSince := time.Date(2016, 6, 23, 0, 0, 0, 0, time.UTC)
+	listOpts := migrations.ListOpts{
+		Host:          "compute10",
+		Status:        "running",
+		MigrationType: migrations.TypeLiveMigration,
+		Limit:         1,
+		ChangesSince:  &changesSince,
+	}
+
+	pages := 0
+	err := migrations.List(client.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		pages++
+		actual, err := migrations.ExtractMigrations(page)
+		th.AssertNoErr(t, err)
+		th.AssertDeepEquals(t, ListExpected, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, pages)
+}
+
+func TestListByServer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListByServerSuccessfully(t)
+
+	allPages, err := migrations.ListByServer(client.ServiceClient(), "4cfba335-03d8-49b2-8c52-e69043d1e8fe").AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := migrations.ExtractMigrations(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []migrations.Migration{ServerMigrationExpected}, actual)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerMigrationSuccessfully(t)
+
+	actual, err := migrations.Get(client.ServiceClient(), "4cfba335-03d8-49b2-8c52-e69043d1e8fe", 1).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &ServerMigrationExpected, actual)
+}
+
+func TestForceComplete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleForceCompleteSuccessfully(t)
+
+	err := migrations.ForceComplete(client.ServiceClient(), "4cfba335-03d8-49b2-8c52-e69043d1e8fe", 1).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestAbort(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerMigrationSuccessfully(t)
+
+	err := migrations.Abort(client.ServiceClient(), "4cfba335-03d8-49b2-8c52-e69043d1e8fe", 1).ExtractErr()
+	th.AssertNoErr(t, err)
+}
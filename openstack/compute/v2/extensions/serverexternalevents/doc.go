@@ -0,0 +1,27 @@
+/*
+Package serverexternalevents provides the ability to notify the Compute
+service of events which happened to servers outside of it, for example in the
+Networking or Block Storage services. This is an admin-only API.
+
+Example to Notify the Compute Service of an Extended Volume
+
+	createOpts := serverexternalevents.CreateOpts{
+		Events: []serverexternalevents.Event{
+			{
+				Name:       serverexternalevents.VolumeExtended,
+				ServerUUID: "3df201cf-2451-44f2-8d25-a4ca826fc1f3",
+				Tag:        "0c8a37f7-7d9e-4b3c-8a3d-3f0b1c1f2d0a",
+			},
+		},
+	}
+
+	events, err := serverexternalevents.Create(computeClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, event := range events {
+		fmt.Printf("%s: %s (%d)\n", event.ServerUUID, event.Status, event.Code)
+	}
+*/
+package serverexternalevents
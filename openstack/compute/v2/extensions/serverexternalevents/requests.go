@@ -0,0 +1,86 @@
+package serverexternalevents
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// Name is the name of a server external event.
+type Name string
+
+const (
+	NetworkChanged      Name = "network-changed"
+	NetworkVIFPlugged   Name = "network-vif-plugged"
+	NetworkVIFUnplugged Name = "network-vif-unplugged"
+	NetworkVIFDeleted   Name = "network-vif-deleted"
+
+	// VolumeExtended requires microversion 2.51 or later.
+	VolumeExtended Name = "volume-extended"
+
+	// PowerUpdate requires microversion 2.76 or later.
+	PowerUpdate Name = "power-update"
+
+	// AcceleratorRequestBound requires microversion 2.82 or later.
+	AcceleratorRequestBound Name = "accelerator-request-bound"
+
+	// VolumeReimaged requires microversion 2.93 or later.
+	VolumeReimaged Name = "volume-reimaged"
+)
+
+// Status is the status of a server external event.
+type Status string
+
+const (
+	StatusCompleted  Status = "completed"
+	StatusFailed     Status = "failed"
+	StatusInProgress Status = "in-progress"
+)
+
+// Event is a server external event to send to the Compute service.
+type Event struct {
+	// Name is the name of the event.
+	Name Name `json:"name" required:"true"`
+
+	// ServerUUID is the UUID of the server the event applies to.
+	ServerUUID string `json:"server_uuid" required:"true"`
+
+	// Status is the status of the event. The Compute service defaults it to
+	// StatusCompleted.
+	Status Status `json:"status,omitempty"`
+
+	// Tag identifies the resource the event applies to, for example the ID
+	// of the port for network events or of the volume for volume-extended.
+	Tag string `json:"tag,omitempty"`
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToServerExternalEventsCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies the events to send to the Compute service.
+type CreateOpts struct {
+	// Events are the events to send.
+	Events []Event `json:"events" required:"true"`
+}
+
+// ToServerExternalEventsCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToServerExternalEventsCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create sends server external events to the Compute service. The request
+// succeeds if at least one event was accepted; the Code of each returned
+// event tells whether that event was accepted.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToServerExternalEventsCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 207},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,39 @@
+package serverexternalevents
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// EventResult is the result of a server external event.
+type EventResult struct {
+	// Name is the name of the event.
+	Name Name `json:"name"`
+
+	// ServerUUID is the UUID of the server the event applies to.
+	ServerUUID string `json:"server_uuid"`
+
+	// Status is the status of the event.
+	Status Status `json:"status"`
+
+	// Tag identifies the resource the event applies to.
+	Tag string `json:"tag"`
+
+	// Code is the HTTP status code of the event: 200 if it was accepted,
+	// 400 or 404 otherwise.
+	Code int `json:"code"`
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a slice of EventResult.
+type CreateResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a CreateResult as a slice of EventResult.
+func (r CreateResult) Extract() ([]EventResult, error) {
+	var s struct {
+		Events []EventResult `json:"events"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Events, err
+}
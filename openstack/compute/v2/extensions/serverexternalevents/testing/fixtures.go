@@ -0,0 +1,65 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// CreateRequest is a sample request to send server external events.
+const CreateRequest = `
+{
+  "events": [
+    {
+      "name": "network-changed",
+      "server_uuid": "3df201cf-2451-44f2-8d25-a4ca826fc1f3",
+      "tag": "ff1df7b2-59f2-4c0f-a1e3-1b2b25a94e5b"
+    },
+    {
+      "name": "volume-extended",
+      "server_uuid": "a6b2f5c5-0f6b-4cf6-8d0a-8a1a2bb9c2fd",
+      "status": "completed",
+      "tag": "0c8a37f7-7d9e-4b3c-8a3d-3f0b1c1f2d0a"
+    }
+  ]
+}
+`
+
+// CreateResponse is a sample response to a partially successful request.
+const CreateResponse = `
+{
+  "events": [
+    {
+      "code": 200,
+      "name": "network-changed",
+      "server_uuid": "3df201cf-2451-44f2-8d25-a4ca826fc1f3",
+      "status": "completed",
+      "tag": "ff1df7b2-59f2-4c0f-a1e3-1b2b25a94e5b"
+    },
+    {
+      "code": 404,
+      "name": "volume-extended",
+      "server_uuid": "a6b2f5c5-0f6b-4cf6-8d0a-8a1a2bb9c2fd",
+      "status": "failed",
+      "tag": "0c8a37f7-7d9e-4b3c-8a3d-3f0b1c1f2d0a"
+    }
+  ]
+}
+`
+
+// HandleCreateSuccessfully sets up the test server to respond to a Create
+// request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-server-external-events", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusMultiStatus)
+		fmt.Fprint(w, CreateResponse)
+	})
+}
@@ -0,0 +1,64 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/serverexternalevents"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := serverexternalevents.CreateOpts{
+		Events: []serverexternalevents.Event{
+			{
+				Name:       serverexternalevents.NetworkChanged,
+				ServerUUID: "3df201cf-2451-44f2-8d25-a4ca826fc1f3",
+				Tag:        "ff1df7b2-59f2-4c0f-a1e3-1b2b25a94e5b",
+			},
+			{
+				Name:       serverexternalevents.VolumeExtended,
+				ServerUUID: "a6b2f5c5-0f6b-4cf6-8d0a-8a1a2bb9c2fd",
+				Status:     serverexternalevents.StatusCompleted,
+				Tag:        "0c8a37f7-7d9e-4b3c-8a3d-3f0b1c1f2d0a",
+			},
+		},
+	}
+
+	expected := []serverexternalevents.EventResult{
+		{
+			Name:       serverexternalevents.NetworkChanged,
+			ServerUUID: "3df201cf-2451-44f2-8d25-a4ca826fc1f3",
+			Status:     serverexternalevents.StatusCompleted,
+			Tag:        "ff1df7b2-59f2-4c0f-a1e3-1b2b25a94e5b",
+			Code:       200,
+		},
+		{
+			Name:       serverexternalevents.VolumeExtended,
+			ServerUUID: "a6b2f5c5-0f6b-4cf6-8d0a-8a1a2bb9c2fd",
+			Status:     serverexternalevents.StatusFailed,
+			Tag:        "0c8a37f7-7d9e-4b3c-8a3d-3f0b1c1f2d0a",
+			Code:       404,
+		},
+	}
+
+	actual, err := serverexternalevents.Create(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, expected, actual)
+}
+
+func TestCreateMissingServerUUID(t *testing.T) {
+	createOpts := serverexternalevents.CreateOpts{
+		Events: []serverexternalevents.Event{
+			{Name: serverexternalevents.NetworkChanged},
+		},
+	}
+	res := serverexternalevents.Create(client.ServiceClient(), createOpts)
+	if res.Err == nil {
+		t.Fatal("Expected error when sending an event without a server UUID")
+	}
+}
@@ -0,0 +1,7 @@
+package serverexternalevents
+
+import "github.com/gophercloud/gophercloud"
+
+func createURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("os-server-external-events")
+}
@@ -0,0 +1,19 @@
+/*
+Package servertopology provides the ability to retrieve the NUMA topology of
+a server, including its CPU pinning. This requires microversion 2.78 or
+later.
+
+Example to Get the Topology of a Server
+
+	computeClient.Microversion = "2.78"
+
+	topology, err := servertopology.Get(computeClient, serverID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, node := range topology.Nodes {
+		fmt.Printf("vCPUs %v pinned to %v\n", node.VCPUSet, node.CPUPinning)
+	}
+*/
+package servertopology
@@ -0,0 +1,12 @@
+package servertopology
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// Get retrieves the topology of a server.
+func Get(client *gophercloud.ServiceClient, serverID string) (r GetResult) {
+	resp, err := client.Get(getURL(client, serverID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
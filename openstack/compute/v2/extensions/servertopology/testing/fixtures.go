@@ -0,0 +1,74 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servertopology"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// GetOutput is a sample response to a Get request made by an administrator.
+const GetOutput = `
+{
+  "nodes": [
+    {
+      "cpu_pinning": {
+        "0": 0,
+        "1": 5
+      },
+      "host_node": 0,
+      "memory_mb": 1024,
+      "siblings": [[0, 1]],
+      "vcpu_set": [0, 1]
+    },
+    {
+      "cpu_pinning": {
+        "2": 1,
+        "3": 8
+      },
+      "host_node": 1,
+      "memory_mb": 2048,
+      "siblings": [[2, 3]],
+      "vcpu_set": [2, 3]
+    }
+  ],
+  "pagesize_kb": 4
+}
+`
+
+var hostNode0, hostNode1, pagesize = 0, 1, 4
+
+// GetExpected is the expected result of the Get request.
+var GetExpected = &servertopology.Topology{
+	Nodes: []servertopology.Node{
+		{
+			CPUPinning: map[int]int{0: 0, 1: 5},
+			HostNode:   &hostNode0,
+			MemoryMB:   1024,
+			Siblings:   [][]int{{0, 1}},
+			VCPUSet:    []int{0, 1},
+		},
+		{
+			CPUPinning: map[int]int{2: 1, 3: 8},
+			HostNode:   &hostNode1,
+			MemoryMB:   2048,
+			Siblings:   [][]int{{2, 3}},
+			VCPUSet:    []int{2, 3},
+		},
+	},
+	PagesizeKB: &pagesize,
+}
+
+// HandleGetSuccessfully sets up the test server to respond to a Get request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/2b9b4a1b-0c55-4d3a-8c8c-6a1c5e4e2d7a/topology", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, GetOutput)
+	})
+}
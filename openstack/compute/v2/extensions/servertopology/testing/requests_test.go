@@ -0,0 +1,19 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servertopology"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := servertopology.Get(client.ServiceClient(), "2b9b4a1b-0c55-4d3a-8c8c-6a1c5e4e2d7a").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, GetExpected, actual)
+}
@@ -0,0 +1,41 @@
+/*
+Package serverplacement plans the placement of a set of servers: it places them
+in a new or existing server group, spreads them over availability zones, and
+checks up front whether they fit in the quota of the project.
+
+Example to Plan and Create Anti-Affinity Servers
+
+	placement, err := serverplacement.Plan(computeClient, serverplacement.PlanOpts{
+		Replicas: 3,
+		Template: servers.CreateOpts{
+			Name:      "web",
+			ImageRef:  "f90f6034-2570-4974-8351-6b49732ef2eb",
+			FlavorRef: "1",
+		},
+		Policy:                  serverplacement.PolicySoftAntiAffinity,
+		SpreadAvailabilityZones: true,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	if !placement.Fits() {
+		for _, shortfall := range placement.Shortfalls {
+			fmt.Println(shortfall)
+		}
+		return
+	}
+
+	err = serverplacement.Apply(computeClient, placement)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, server := range placement.Servers {
+		_, err := servers.Create(computeClient, server.CreateOpts).Extract()
+		if err != nil {
+			panic(err)
+		}
+	}
+*/
+package serverplacement
@@ -0,0 +1,36 @@
+package serverplacement
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ErrInsufficientQuota is returned by Apply when the servers of a plan do not
+// fit in the quota of the project.
+type ErrInsufficientQuota struct {
+	gophercloud.BaseError
+	Shortfalls []Shortfall
+}
+
+func (e ErrInsufficientQuota) Error() string {
+	s := make([]string, len(e.Shortfalls))
+	for i, shortfall := range e.Shortfalls {
+		s[i] = shortfall.String()
+	}
+	return fmt.Sprintf("Insufficient quota: %s", strings.Join(s, "; "))
+}
+
+// ErrServerGroupPolicyMismatch is returned by Plan when the server group to
+// reuse does not have the requested policy.
+type ErrServerGroupPolicyMismatch struct {
+	gophercloud.BaseError
+	ServerGroup string
+	Policy      string
+	Expected    string
+}
+
+func (e ErrServerGroupPolicyMismatch) Error() string {
+	return fmt.Sprintf("Server group %s has policy %q, expected %q", e.ServerGroup, e.Policy, e.Expected)
+}
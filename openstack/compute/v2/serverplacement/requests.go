@@ -0,0 +1,385 @@
+package serverplacement
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/availabilityzones"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/limits"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/quotasets"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/schedulerhints"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+)
+
+// Policy is the policy of a server group.
+type Policy string
+
+const (
+	PolicyAffinity         Policy = "affinity"
+	PolicyAntiAffinity     Policy = "anti-affinity"
+	PolicySoftAffinity     Policy = "soft-affinity"
+	PolicySoftAntiAffinity Policy = "soft-anti-affinity"
+)
+
+// PlanOpts specifies the servers to place.
+type PlanOpts struct {
+	// Replicas is the number of servers to create.
+	Replicas int
+
+	// Template holds the options common to all servers. Its Name is used as
+	// the prefix of the server names, which are suffixed by their index, and
+	// its FlavorRef is used to compute the cores and RAM required.
+	Template servers.CreateOpts
+
+	// Policy is the policy of the server group the servers are placed in.
+	// The servers are not placed in a server group if empty.
+	Policy Policy
+
+	// MaxServerPerHost limits the number of servers per host of an
+	// anti-affinity server group. It requires microversion 2.64 or later.
+	MaxServerPerHost int
+
+	// ServerGroupID is the ID of an existing server group to place the
+	// servers in.
+	ServerGroupID string
+
+	// ServerGroupName is the name of the server group to reuse, or to create
+	// if there is none. It defaults to the name of the template.
+	ServerGroupName string
+
+	// AvailabilityZones are the availability zones the servers are spread
+	// over, in a round-robin fashion.
+	AvailabilityZones []string
+
+	// SpreadAvailabilityZones spreads the servers over all the available
+	// availability zones when AvailabilityZones is empty.
+	SpreadAvailabilityZones bool
+
+	// ProjectID is the ID of the project whose quota is checked, including
+	// the resources reserved by in-flight requests. The absolute limits of
+	// the current project are checked if empty.
+	ProjectID string
+}
+
+// Plan computes the placement of servers: it finds the server group to reuse,
+// the availability zones to spread the servers over, and checks whether the
+// servers fit in the quota of the project. It does not create any resource.
+func Plan(client *gophercloud.ServiceClient, opts PlanOpts) (*Placement, error) {
+	if opts.Replicas <= 0 {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "serverplacement.PlanOpts.Replicas"
+		return nil, err
+	}
+	if opts.Template.Name == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "serverplacement.PlanOpts.Template.Name"
+		return nil, err
+	}
+	if opts.Template.FlavorRef == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "serverplacement.PlanOpts.Template.FlavorRef"
+		return nil, err
+	}
+	if opts.MaxServerPerHost > 0 && opts.Policy != PolicyAntiAffinity {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "serverplacement.PlanOpts.MaxServerPerHost"
+		err.Value = opts.MaxServerPerHost
+		err.Info = "MaxServerPerHost requires the anti-affinity policy"
+		return nil, err
+	}
+
+	plan := new(Placement)
+
+	if opts.Policy != "" || opts.ServerGroupID != "" {
+		if err := planServerGroup(client, opts, plan); err != nil {
+			return nil, err
+		}
+	}
+
+	zones := opts.AvailabilityZones
+	if len(zones) == 0 && opts.SpreadAvailabilityZones {
+		var err error
+		zones, err = availableZones(client)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for i := 0; i < opts.Replicas; i++ {
+		createOpts := opts.Template
+		createOpts.Name = fmt.Sprintf("%s-%d", opts.Template.Name, i)
+		if len(zones) > 0 {
+			createOpts.AvailabilityZone = zones[i%len(zones)]
+		}
+
+		plan.Servers = append(plan.Servers, Server{
+			Name:             createOpts.Name,
+			AvailabilityZone: createOpts.AvailabilityZone,
+			CreateOpts:       createOpts,
+		})
+	}
+
+	if plan.ServerGroup != nil {
+		plan.wrapServers(plan.ServerGroup.ID)
+	}
+
+	flavor, err := flavors.Get(client, opts.Template.FlavorRef).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	available, err := availableQuota(client, opts.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	requested := map[Resource]int{
+		ResourceInstances: opts.Replicas,
+		ResourceCores:     opts.Replicas * flavor.VCPUs,
+		ResourceRAM:       opts.Replicas * flavor.RAM,
+	}
+	if plan.ServerGroupCreateOpts != nil {
+		requested[ResourceServerGroups] = 1
+		requested[ResourceServerGroupMembers] = opts.Replicas
+	} else if plan.ServerGroup != nil {
+		requested[ResourceServerGroupMembers] = len(plan.ServerGroup.Members) + opts.Replicas
+	}
+
+	for _, resource := range []Resource{ResourceInstances, ResourceCores, ResourceRAM, ResourceServerGroups, ResourceServerGroupMembers} {
+		n, ok := requested[resource]
+		if !ok {
+			continue
+		}
+		if limit, ok := available[resource]; ok && limit != unlimitedQuota && n > limit {
+			plan.Shortfalls = append(plan.Shortfalls, Shortfall{
+				Resource:  resource,
+				Requested: n,
+				Available: limit,
+			})
+		}
+	}
+
+	return plan, nil
+}
+
+// Apply creates the server group of a placement if it does not exist yet, and
+// places the servers in it. An ErrInsufficientQuota is returned if the
+// servers do not fit in the quota of the project.
+func Apply(client *gophercloud.ServiceClient, plan *Placement) error {
+	if !plan.Fits() {
+		return ErrInsufficientQuota{Shortfalls: plan.Shortfalls}
+	}
+
+	if plan.ServerGroup != nil || plan.ServerGroupCreateOpts == nil {
+		return nil
+	}
+
+	sg, err := servergroups.Create(client, plan.ServerGroupCreateOpts).Extract()
+	if err != nil {
+		return err
+	}
+
+	plan.ServerGroup = sg
+	plan.ServerGroupCreateOpts = nil
+	plan.wrapServers(sg.ID)
+
+	return nil
+}
+
+// wrapServers adds the scheduler hint placing the servers in a server group.
+func (p *Placement) wrapServers(serverGroupID string) {
+	for i := range p.Servers {
+		p.Servers[i].CreateOpts = schedulerhints.CreateOptsExt{
+			CreateOptsBuilder: p.Servers[i].CreateOpts,
+			SchedulerHints: schedulerhints.SchedulerHints{
+				Group: serverGroupID,
+			},
+		}
+	}
+}
+
+// planServerGroup finds the server group to reuse or the options to create
+// it with.
+func planServerGroup(client *gophercloud.ServiceClient, opts PlanOpts, plan *Placement) error {
+	if opts.ServerGroupID != "" {
+		sg, err := servergroups.Get(client, opts.ServerGroupID).Extract()
+		if err != nil {
+			return err
+		}
+		if err := checkPolicy(sg, opts.Policy); err != nil {
+			return err
+		}
+		plan.ServerGroup = sg
+		return nil
+	}
+
+	name := opts.ServerGroupName
+	if name == "" {
+		name = opts.Template.Name
+	}
+
+	allPages, err := servergroups.List(client, nil).AllPages()
+	if err != nil {
+		return err
+	}
+	allServerGroups, err := servergroups.ExtractServerGroups(allPages)
+	if err != nil {
+		return err
+	}
+
+	for i := range allServerGroups {
+		if allServerGroups[i].Name != name {
+			continue
+		}
+		if err := checkPolicy(&allServerGroups[i], opts.Policy); err != nil {
+			return err
+		}
+		plan.ServerGroup = &allServerGroups[i]
+		return nil
+	}
+
+	createOpts := &servergroups.CreateOpts{
+		Name: name,
+	}
+	if microversionAtLeast(client, 64) {
+		createOpts.Policy = string(opts.Policy)
+		if opts.MaxServerPerHost > 0 {
+			createOpts.Rules = &servergroups.Rules{
+				MaxServerPerHost: opts.MaxServerPerHost,
+			}
+		}
+	} else {
+		if opts.MaxServerPerHost > 0 {
+			err := gophercloud.ErrInvalidInput{}
+			err.Argument = "serverplacement.PlanOpts.MaxServerPerHost"
+			err.Value = opts.MaxServerPerHost
+			err.Info = "MaxServerPerHost requires microversion 2.64 or later"
+			return err
+		}
+		createOpts.Policies = []string{string(opts.Policy)}
+	}
+	plan.ServerGroupCreateOpts = createOpts
+
+	return nil
+}
+
+// checkPolicy checks that a server group has the given policy, if any.
+func checkPolicy(sg *servergroups.ServerGroup, policy Policy) error {
+	if policy == "" {
+		return nil
+	}
+
+	var actual string
+	if sg.Policy != nil {
+		actual = *sg.Policy
+	} else if len(sg.Policies) > 0 {
+		actual = sg.Policies[0]
+	}
+
+	if actual != string(policy) {
+		return ErrServerGroupPolicyMismatch{
+			ServerGroup: sg.ID,
+			Policy:      actual,
+			Expected:    string(policy),
+		}
+	}
+
+	return nil
+}
+
+// availableZones returns the names of the available availability zones,
+// sorted.
+func availableZones(client *gophercloud.ServiceClient) ([]string, error) {
+	allPages, err := availabilityzones.List(client).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	allZones, err := availabilityzones.ExtractAvailabilityZones(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var zones []string
+	for _, zone := range allZones {
+		if zone.ZoneState.Available {
+			zones = append(zones, zone.ZoneName)
+		}
+	}
+	sort.Strings(zones)
+
+	if len(zones) == 0 {
+		err := gophercloud.ErrResourceNotFound{}
+		err.ResourceType = "availability zone"
+		return nil, err
+	}
+
+	return zones, nil
+}
+
+// unlimitedQuota is the amount left of an unlimited resource.
+const unlimitedQuota = -1
+
+// quotaLeft returns the amount left of a resource with the limit and usage.
+// It is unlimitedQuota for unlimited resources, and 0 for resources whose
+// usage is above the limit, for example after the limit was lowered.
+func quotaLeft(limit, used int) int {
+	if limit == unlimitedQuota {
+		return unlimitedQuota
+	}
+	if used >= limit {
+		return 0
+	}
+	return limit - used
+}
+
+// availableQuota returns the amount left of each resource. The server group
+// members quota is a limit per server group, so the amount left is the limit.
+func availableQuota(client *gophercloud.ServiceClient, projectID string) (map[Resource]int, error) {
+	if projectID != "" {
+		q, err := quotasets.GetDetail(client, projectID).Extract()
+		if err != nil {
+			return nil, err
+		}
+
+		left := func(d quotasets.QuotaDetail) int {
+			return quotaLeft(d.Limit, d.InUse+d.Reserved)
+		}
+
+		return map[Resource]int{
+			ResourceInstances:          left(q.Instances),
+			ResourceCores:              left(q.Cores),
+			ResourceRAM:                left(q.RAM),
+			ResourceServerGroups:       left(q.ServerGroups),
+			ResourceServerGroupMembers: quotaLeft(q.ServerGroupMembers.Limit, 0),
+		}, nil
+	}
+
+	l, err := limits.Get(client, nil).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	return map[Resource]int{
+		ResourceInstances:          quotaLeft(l.Absolute.MaxTotalInstances, l.Absolute.TotalInstancesUsed),
+		ResourceCores:              quotaLeft(l.Absolute.MaxTotalCores, l.Absolute.TotalCoresUsed),
+		ResourceRAM:                quotaLeft(l.Absolute.MaxTotalRAMSize, l.Absolute.TotalRAMUsed),
+		ResourceServerGroups:       quotaLeft(l.Absolute.MaxServerGroups, l.Absolute.TotalServerGroupsUsed),
+		ResourceServerGroupMembers: quotaLeft(l.Absolute.MaxServerGroupMembers, 0),
+	}, nil
+}
+
+// microversionAtLeast reports whether the client requests a 2.x microversion
+// of at least 2.minor.
+func microversionAtLeast(client *gophercloud.ServiceClient, minor int) bool {
+	parts := strings.SplitN(client.Microversion, ".", 2)
+	if len(parts) != 2 || parts[0] != "2" {
+		return false
+	}
+	n, err := strconv.Atoi(parts[1])
+	return err == nil && n >= minor
+}
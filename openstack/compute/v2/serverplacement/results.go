@@ -0,0 +1,73 @@
+package serverplacement
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+)
+
+// Resource is a resource counted against the quota of a project.
+type Resource string
+
+const (
+	ResourceInstances          Resource = "instances"
+	ResourceCores              Resource = "cores"
+	ResourceRAM                Resource = "ram"
+	ResourceServerGroups       Resource = "server_groups"
+	ResourceServerGroupMembers Resource = "server_group_members"
+)
+
+// Shortfall describes a resource of which less is available than requested.
+type Shortfall struct {
+	Resource Resource
+
+	// Requested is the amount of the resource required by the placement.
+	Requested int
+
+	// Available is the amount of the resource left in the quota.
+	Available int
+}
+
+func (s Shortfall) String() string {
+	return fmt.Sprintf("%s: requested %d, available %d", s.Resource, s.Requested, s.Available)
+}
+
+// Server is a server of a placement.
+type Server struct {
+	// Name is the name of the server.
+	Name string
+
+	// AvailabilityZone is the availability zone of the server, if the servers
+	// are spread over availability zones.
+	AvailabilityZone string
+
+	// CreateOpts are the options to create the server with. Once the placement
+	// is applied, they include the scheduler hint placing the server in the
+	// server group.
+	CreateOpts servers.CreateOptsBuilder
+}
+
+// Placement is the placement of a set of servers.
+type Placement struct {
+	// ServerGroup is the server group the servers are placed in. It is nil
+	// if the server group has yet to be created.
+	ServerGroup *servergroups.ServerGroup
+
+	// ServerGroupCreateOpts are the options to create the server group with,
+	// if there is no server group to reuse.
+	ServerGroupCreateOpts *servergroups.CreateOpts
+
+	// Servers are the servers to create.
+	Servers []Server
+
+	// Shortfalls are the resources of which the quota is insufficient for
+	// the servers. The servers fit if there are none.
+	Shortfalls []Shortfall
+}
+
+// Fits reports whether the servers of the placement fit in the quota of the
+// project.
+func (p Placement) Fits() bool {
+	return len(p.Shortfalls) == 0
+}
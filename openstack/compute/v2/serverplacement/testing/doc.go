@@ -0,0 +1,2 @@
+// serverplacement unit tests
+package testing
@@ -0,0 +1,233 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ServerGroupListOutput is a sample response to a server group List call.
+const ServerGroupListOutput = `
+{
+    "server_groups": [
+        {
+            "id": "616fb98f-46ca-475e-917e-2563e5a8cd19",
+            "name": "db",
+            "policies": [
+                "anti-affinity"
+            ],
+            "members": [
+                "e5b0e9a4-6a76-4bd0-8bd4-3cf4b3ebf0a1",
+                "0c1de6f7-5b44-4f3f-b0ea-3bd8c4a1e3a2"
+            ],
+            "metadata": {}
+        }
+    ]
+}
+`
+
+// ServerGroupCreateRequest is a sample request to create a server group.
+const ServerGroupCreateRequest = `
+{
+    "server_group": {
+        "name": "web",
+        "policies": [
+            "soft-anti-affinity"
+        ]
+    }
+}
+`
+
+// ServerGroupCreateOutput is a sample response to a server group Create call.
+const ServerGroupCreateOutput = `
+{
+    "server_group": {
+        "id": "4d8c3732-a248-40ed-bebc-539a6ffd25c0",
+        "name": "web",
+        "policies": [
+            "soft-anti-affinity"
+        ],
+        "members": [],
+        "metadata": {}
+    }
+}
+`
+
+// AvailabilityZoneListOutput is a sample response to an availability zone
+// List call.
+const AvailabilityZoneListOutput = `
+{
+    "availabilityZoneInfo": [
+        {
+            "zoneName": "zone-b",
+            "zoneState": {"available": true},
+            "hosts": null
+        },
+        {
+            "zoneName": "zone-c",
+            "zoneState": {"available": false},
+            "hosts": null
+        },
+        {
+            "zoneName": "zone-a",
+            "zoneState": {"available": true},
+            "hosts": null
+        }
+    ]
+}
+`
+
+// FlavorGetOutput is a sample response to a flavor Get call.
+const FlavorGetOutput = `
+{
+    "flavor": {
+        "id": "1",
+        "name": "m1.medium",
+        "vcpus": 2,
+        "ram": 4096,
+        "disk": 40
+    }
+}
+`
+
+// LimitsGetOutput is a sample response to a limits Get call.
+const LimitsGetOutput = `
+{
+    "limits": {
+        "rate": [],
+        "absolute": {
+            "maxServerGroups": 10,
+            "totalServerGroupsUsed": 2,
+            "maxServerGroupMembers": 10,
+            "maxTotalCores": -1,
+            "totalCoresUsed": 12,
+            "maxTotalInstances": 10,
+            "totalInstancesUsed": 6,
+            "maxTotalRAMSize": 51200,
+            "totalRAMUsed": 24576
+        }
+    }
+}
+`
+
+// QuotaDetailGetOutput is a sample response to a quota set GetDetail call.
+const QuotaDetailGetOutput = `
+{
+    "quota_set": {
+        "id": "9fb2e5a1c5b4474c9cf6a3fb3f5d3e1a",
+        "instances": {"in_use": 4, "reserved": 1, "limit": 10},
+        "cores": {"in_use": 8, "reserved": 2, "limit": 12},
+        "ram": {"in_use": 16384, "reserved": 0, "limit": -1},
+        "server_groups": {"in_use": 1, "reserved": 0, "limit": 10},
+        "server_group_members": {"in_use": 0, "reserved": 0, "limit": 4}
+    }
+}
+`
+
+// LimitsOverQuotaGetOutput is a sample response to a limits Get call of a
+// project, whose instance usage is above the limit.
+const LimitsOverQuotaGetOutput = `
+{
+    "limits": {
+        "rate": [],
+        "absolute": {
+            "maxServerGroups": 10,
+            "totalServerGroupsUsed": 2,
+            "maxServerGroupMembers": 10,
+            "maxTotalCores": -1,
+            "totalCoresUsed": 24,
+            "maxTotalInstances": 10,
+            "totalInstancesUsed": 12,
+            "maxTotalRAMSize": 51200,
+            "totalRAMUsed": 24576
+        }
+    }
+}
+`
+
+// QuotaDetailOverQuotaGetOutput is a sample response to a quota set
+// GetDetail call of a project, whose instance and core usage is above the
+// limit.
+const QuotaDetailOverQuotaGetOutput = `
+{
+    "quota_set": {
+        "id": "9fb2e5a1c5b4474c9cf6a3fb3f5d3e1a",
+        "instances": {"in_use": 10, "reserved": 1, "limit": 10},
+        "cores": {"in_use": 16, "reserved": 0, "limit": 12},
+        "ram": {"in_use": 16384, "reserved": 0, "limit": -1},
+        "server_groups": {"in_use": 1, "reserved": 0, "limit": 10},
+        "server_group_members": {"in_use": 0, "reserved": 0, "limit": -1}
+    }
+}
+`
+
+// ProjectID is the ID of the project of QuotaDetailGetOutput.
+const ProjectID = "9fb2e5a1c5b4474c9cf6a3fb3f5d3e1a"
+
+func handleGet(t *testing.T, path, output string) {
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, output)
+	})
+}
+
+// HandleServerGroupListSuccessfully configures the test server to respond to
+// a server group List request.
+func HandleServerGroupListSuccessfully(t *testing.T) {
+	handleGet(t, "/os-server-groups", ServerGroupListOutput)
+}
+
+// HandleServerGroupCreateSuccessfully configures the test server to respond
+// to a server group Create request.
+func HandleServerGroupCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-server-groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, ServerGroupCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, ServerGroupCreateOutput)
+	})
+}
+
+// HandleAvailabilityZoneListSuccessfully configures the test server to
+// respond to an availability zone List request.
+func HandleAvailabilityZoneListSuccessfully(t *testing.T) {
+	handleGet(t, "/os-availability-zone", AvailabilityZoneListOutput)
+}
+
+// HandleFlavorGetSuccessfully configures the test server to respond to a
+// flavor Get request.
+func HandleFlavorGetSuccessfully(t *testing.T) {
+	handleGet(t, "/flavors/1", FlavorGetOutput)
+}
+
+// HandleLimitsGetSuccessfully configures the test server to respond to a
+// limits Get request.
+func HandleLimitsGetSuccessfully(t *testing.T) {
+	handleGet(t, "/limits", LimitsGetOutput)
+}
+
+// HandleQuotaDetailGetSuccessfully configures the test server to respond to
+// a quota set GetDetail request.
+func HandleQuotaDetailGetSuccessfully(t *testing.T) {
+	handleGet(t, "/os-quota-sets/"+ProjectID+"/detail", QuotaDetailGetOutput)
+}
+
+// HandleLimitsGetOverQuota configures the test server to respond to a limits
+// Get request with LimitsOverQuotaGetOutput.
+func HandleLimitsGetOverQuota(t *testing.T) {
+	handleGet(t, "/limits", LimitsOverQuotaGetOutput)
+}
+
+// HandleQuotaDetailGetOverQuota configures the test server to respond to a
+// quota set GetDetail request with QuotaDetailOverQuotaGetOutput.
+func HandleQuotaDetailGetOverQuota(t *testing.T) {
+	handleGet(t, "/os-quota-sets/"+ProjectID+"/detail", QuotaDetailOverQuotaGetOutput)
+}
@@ -0,0 +1,189 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/serverplacement"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+var template = servers.CreateOpts{
+	ImageRef:  "f90f6034-2570-4974-8351-6b49732ef2eb",
+	FlavorRef: "1",
+}
+
+func TestPlanAndApply(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerGroupListSuccessfully(t)
+	HandleAvailabilityZoneListSuccessfully(t)
+	HandleFlavorGetSuccessfully(t)
+	HandleLimitsGetSuccessfully(t)
+
+	tmpl := template
+	tmpl.Name = "web"
+	placement, err := serverplacement.Plan(client.ServiceClient(), serverplacement.PlanOpts{
+		Replicas:                3,
+		Template:                tmpl,
+		Policy:                  serverplacement.PolicySoftAntiAffinity,
+		SpreadAvailabilityZones: true,
+	})
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, true, placement.Fits())
+	th.AssertEquals(t, true, placement.ServerGroup == nil)
+	th.AssertDeepEquals(t, &servergroups.CreateOpts{
+		Name:     "web",
+		Policies: []string{"soft-anti-affinity"},
+	}, placement.ServerGroupCreateOpts)
+
+	th.AssertEquals(t, 3, len(placement.Servers))
+	for i, expected := range []struct{ name, zone string }{
+		{"web-0", "zone-a"},
+		{"web-1", "zone-b"},
+		{"web-2", "zone-a"},
+	} {
+		th.AssertEquals(t, expected.name, placement.Servers[i].Name)
+		th.AssertEquals(t, expected.zone, placement.Servers[i].AvailabilityZone)
+	}
+
+	th.TeardownHTTP()
+	th.SetupHTTP()
+	HandleServerGroupCreateSuccessfully(t)
+
+	err = serverplacement.Apply(client.ServiceClient(), placement)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "4d8c3732-a248-40ed-bebc-539a6ffd25c0", placement.ServerGroup.ID)
+
+	b, err := placement.Servers[1].CreateOpts.ToServerCreateMap()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, map[string]interface{}{
+		"group": "4d8c3732-a248-40ed-bebc-539a6ffd25c0",
+	}, b["os:scheduler_hints"])
+
+	server := b["server"].(map[string]interface{})
+	th.AssertEquals(t, "web-1", server["name"])
+	th.AssertEquals(t, "zone-b", server["availability_zone"])
+}
+
+func TestPlanReuseServerGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerGroupListSuccessfully(t)
+	HandleFlavorGetSuccessfully(t)
+	HandleQuotaDetailGetSuccessfully(t)
+
+	tmpl := template
+	tmpl.Name = "db"
+	placement, err := serverplacement.Plan(client.ServiceClient(), serverplacement.PlanOpts{
+		Replicas:          3,
+		Template:          tmpl,
+		Policy:            serverplacement.PolicyAntiAffinity,
+		AvailabilityZones: []string{"zone-a"},
+		ProjectID:         ProjectID,
+	})
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "616fb98f-46ca-475e-917e-2563e5a8cd19", placement.ServerGroup.ID)
+	th.AssertEquals(t, true, placement.ServerGroupCreateOpts == nil)
+	th.AssertEquals(t, "zone-a", placement.Servers[2].AvailabilityZone)
+
+	b, err := placement.Servers[0].CreateOpts.ToServerCreateMap()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, map[string]interface{}{
+		"group": "616fb98f-46ca-475e-917e-2563e5a8cd19",
+	}, b["os:scheduler_hints"])
+
+	expected := []serverplacement.Shortfall{
+		{Resource: serverplacement.ResourceCores, Requested: 6, Available: 2},
+		{Resource: serverplacement.ResourceServerGroupMembers, Requested: 5, Available: 4},
+	}
+	th.AssertEquals(t, false, placement.Fits())
+	th.AssertDeepEquals(t, expected, placement.Shortfalls)
+
+	err = serverplacement.Apply(client.ServiceClient(), placement)
+	if _, ok := err.(serverplacement.ErrInsufficientQuota); !ok {
+		t.Fatalf("Expected ErrInsufficientQuota, got %v", err)
+	}
+	th.AssertEquals(t, "Insufficient quota: cores: requested 6, available 2; server_group_members: requested 5, available 4", err.Error())
+}
+
+func TestPlanOverQuotaLimits(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerGroupListSuccessfully(t)
+	HandleFlavorGetSuccessfully(t)
+	HandleLimitsGetOverQuota(t)
+
+	tmpl := template
+	tmpl.Name = "web"
+	placement, err := serverplacement.Plan(client.ServiceClient(), serverplacement.PlanOpts{
+		Replicas: 2,
+		Template: tmpl,
+		Policy:   serverplacement.PolicySoftAntiAffinity,
+	})
+	th.AssertNoErr(t, err)
+
+	expected := []serverplacement.Shortfall{
+		{Resource: serverplacement.ResourceInstances, Requested: 2, Available: 0},
+	}
+	th.AssertEquals(t, false, placement.Fits())
+	th.AssertDeepEquals(t, expected, placement.Shortfalls)
+}
+
+func TestPlanOverQuotaDetail(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerGroupListSuccessfully(t)
+	HandleFlavorGetSuccessfully(t)
+	HandleQuotaDetailGetOverQuota(t)
+
+	tmpl := template
+	tmpl.Name = "db"
+	placement, err := serverplacement.Plan(client.ServiceClient(), serverplacement.PlanOpts{
+		Replicas:  1,
+		Template:  tmpl,
+		Policy:    serverplacement.PolicyAntiAffinity,
+		ProjectID: ProjectID,
+	})
+	th.AssertNoErr(t, err)
+
+	expected := []serverplacement.Shortfall{
+		{Resource: serverplacement.ResourceInstances, Requested: 1, Available: 0},
+		{Resource: serverplacement.ResourceCores, Requested: 2, Available: 0},
+	}
+	th.AssertEquals(t, false, placement.Fits())
+	th.AssertDeepEquals(t, expected, placement.Shortfalls)
+}
+
+func TestPlanPolicyMismatch(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerGroupListSuccessfully(t)
+
+	tmpl := template
+	tmpl.Name = "web"
+	_, err := serverplacement.Plan(client.ServiceClient(), serverplacement.PlanOpts{
+		Replicas:        2,
+		Template:        tmpl,
+		Policy:          serverplacement.PolicyAffinity,
+		ServerGroupName: "db",
+	})
+	if _, ok := err.(serverplacement.ErrServerGroupPolicyMismatch); !ok {
+		t.Fatalf("Expected ErrServerGroupPolicyMismatch, got %v", err)
+	}
+}
+
+func TestPlanMissingInput(t *testing.T) {
+	_, err := serverplacement.Plan(client.ServiceClient(), serverplacement.PlanOpts{
+		Replicas: 2,
+		Template: template,
+	})
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("Expected ErrMissingInput, got %v", err)
+	}
+}
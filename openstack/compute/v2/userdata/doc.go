@@ -0,0 +1,51 @@
+/*
+Package userdata builds the user data of servers for cloud-init. It composes
+cloud-config documents, shell scripts and include files into a MIME multipart
+message, which can be passed as servers.CreateOpts.UserData.
+
+Example to Build User Data
+
+	buildOpts := userdata.BuildOpts{
+		CloudConfig: &userdata.CloudConfig{
+			Packages: []string{"nginx"},
+			Users: []userdata.User{
+				{
+					Name:              "deploy",
+					Sudo:              "ALL=(ALL) NOPASSWD:ALL",
+					Shell:             "/bin/bash",
+					SSHAuthorizedKeys: []string{"ssh-ed25519 AAAAC3Nza... deploy@example.com"},
+				},
+			},
+			WriteFiles: []userdata.File{
+				{
+					Path:        "/etc/nginx/conf.d/app.conf",
+					Content:     "server { listen 80; }\n",
+					Permissions: "0644",
+				},
+			},
+			RunCmd: []interface{}{
+				"systemctl enable --now nginx",
+			},
+		},
+		Scripts: []userdata.Script{
+			{
+				Filename: "register.sh",
+				Content:  "#!/bin/sh\ncurl -X POST https://inventory.example.com/register\n",
+			},
+		},
+		Gzip: true,
+	}
+
+	data, err := userdata.Build(buildOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	createOpts := servers.CreateOpts{
+		Name:      "web",
+		ImageRef:  "f90f6034-2570-4974-8351-6b49732ef2eb",
+		FlavorRef: "1",
+		UserData:  data,
+	}
+*/
+package userdata
@@ -0,0 +1,21 @@
+package userdata
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ErrUserDataTooLarge is returned by Build when the user data exceeds the
+// size accepted by the Compute service.
+type ErrUserDataTooLarge struct {
+	gophercloud.BaseError
+	// Size is the size of the user data once base64 encoded.
+	Size int
+	// Limit is the maximum size of the base64 encoded user data.
+	Limit int
+}
+
+func (e ErrUserDataTooLarge) Error() string {
+	return fmt.Sprintf("User data is %d bytes once encoded, which exceeds the limit of %d bytes", e.Size, e.Limit)
+}
@@ -0,0 +1,2 @@
+// userdata unit tests
+package testing
@@ -0,0 +1,64 @@
+package testing
+
+import (
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/userdata"
+)
+
+// CloudConfig is a cloud-config document used in the tests.
+var CloudConfig = userdata.CloudConfig{
+	Hostname:      "web",
+	PackageUpdate: true,
+	Packages:      []string{"nginx"},
+	Users: []userdata.User{
+		{
+			Name:              "deploy",
+			Groups:            []string{"adm", "sudo"},
+			Sudo:              "ALL=(ALL) NOPASSWD:ALL",
+			Shell:             "/bin/bash",
+			SSHAuthorizedKeys: []string{"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5 deploy@example.com"},
+		},
+	},
+	WriteFiles: []userdata.File{
+		{
+			Path:        "/etc/motd",
+			Content:     "Welcome\n",
+			Permissions: "0644",
+		},
+	},
+	RunCmd: []interface{}{
+		"systemctl enable --now nginx",
+		[]string{"touch", "/var/lib/ready"},
+	},
+	Extra: map[string]interface{}{
+		"timezone": "UTC",
+	},
+}
+
+// ExpectedCloudConfig is the expected cloud-config part built from
+// CloudConfig.
+const ExpectedCloudConfig = `#cloud-config
+hostname: web
+users:
+- name: deploy
+  groups: [adm, sudo]
+  shell: /bin/bash
+  sudo: ALL=(ALL) NOPASSWD:ALL
+  ssh_authorized_keys:
+  - ssh-ed25519 AAAAC3NzaC1lZDI1NTE5 deploy@example.com
+package_update: true
+packages:
+- nginx
+write_files:
+- path: /etc/motd
+  content: |
+    Welcome
+  permissions: "0644"
+runcmd:
+- systemctl enable --now nginx
+- - touch
+  - /var/lib/ready
+timezone: UTC
+`
+
+// Script is a shell script used in the tests.
+const Script = "#!/bin/sh\necho ready\n"
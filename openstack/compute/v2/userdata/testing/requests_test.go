@@ -0,0 +1,154 @@
+package testing
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/userdata"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+type part struct {
+	ContentType string
+	Filename    string
+	Content     string
+}
+
+func readParts(t *testing.T, data []byte) []part {
+	msg, err := mail.ReadMessage(bytes.NewReader(data))
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "1.0", msg.Header.Get("MIME-Version"))
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "multipart/mixed", mediaType)
+
+	var parts []part
+	r := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		p, err := r.NextPart()
+		if err == io.EOF {
+			break
+		}
+		th.AssertNoErr(t, err)
+
+		contentType, _, err := mime.ParseMediaType(p.Header.Get("Content-Type"))
+		th.AssertNoErr(t, err)
+
+		var body io.Reader = p
+		if p.Header.Get("Content-Transfer-Encoding") == "base64" {
+			body = base64.NewDecoder(base64.StdEncoding, p)
+		}
+		content, err := io.ReadAll(body)
+		th.AssertNoErr(t, err)
+
+		parts = append(parts, part{
+			ContentType: contentType,
+			Filename:    p.FileName(),
+			Content:     string(content),
+		})
+	}
+
+	return parts
+}
+
+func TestBuild(t *testing.T) {
+	cloudConfig := CloudConfig
+	buildOpts := userdata.BuildOpts{
+		CloudConfig: &cloudConfig,
+		Scripts: []userdata.Script{
+			{Filename: "ready.sh", Content: Script},
+			{Content: "#!/bin/sh\necho h\xe9llo\n"},
+		},
+		Includes: []string{"https://example.com/a.yaml", "https://example.com/b.yaml"},
+		Parts: []userdata.Part{
+			{ContentType: userdata.ContentTypeBoothook, Content: []byte("#cloud-boothook\n")},
+		},
+		Boundary: "userdata-boundary",
+	}
+
+	data, err := userdata.Build(buildOpts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, strings.HasPrefix(string(data), "Content-Type: multipart/mixed; boundary=\"userdata-boundary\"\r\n"))
+
+	expected := []part{
+		{ContentType: "text/cloud-config", Filename: "cloud-config.txt", Content: ExpectedCloudConfig},
+		{ContentType: "text/x-include-url", Filename: "include.txt", Content: "#include\nhttps://example.com/a.yaml\nhttps://example.com/b.yaml\n"},
+		{ContentType: "text/x-shellscript", Filename: "ready.sh", Content: Script},
+		{ContentType: "text/x-shellscript", Filename: "script-1.sh", Content: "#!/bin/sh\necho h\xe9llo\n"},
+		{ContentType: "text/cloud-boothook", Content: "#cloud-boothook\n"},
+	}
+	th.AssertDeepEquals(t, expected, readParts(t, data))
+}
+
+func TestBuildGzip(t *testing.T) {
+	buildOpts := userdata.BuildOpts{
+		Scripts: []userdata.Script{{Filename: "ready.sh", Content: Script}},
+		Gzip:    true,
+	}
+
+	data, err := userdata.Build(buildOpts)
+	th.AssertNoErr(t, err)
+
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	th.AssertNoErr(t, err)
+	plain, err := io.ReadAll(r)
+	th.AssertNoErr(t, err)
+
+	expected := []part{
+		{ContentType: "text/x-shellscript", Filename: "ready.sh", Content: Script},
+	}
+	th.AssertDeepEquals(t, expected, readParts(t, plain))
+}
+
+func TestBuildTooLarge(t *testing.T) {
+	buildOpts := userdata.BuildOpts{
+		Scripts: []userdata.Script{{Content: "#!/bin/sh\n" + strings.Repeat("echo padding\n", 5000)}},
+	}
+
+	_, err := userdata.Build(buildOpts)
+	if _, ok := err.(userdata.ErrUserDataTooLarge); !ok {
+		t.Fatalf("Expected ErrUserDataTooLarge, got %v", err)
+	}
+
+	// The same script compresses well below the limit.
+	buildOpts.Gzip = true
+	_, err = userdata.Build(buildOpts)
+	th.AssertNoErr(t, err)
+}
+
+func TestBuildEmpty(t *testing.T) {
+	_, err := userdata.Build(userdata.BuildOpts{})
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("Expected ErrMissingInput, got %v", err)
+	}
+}
+
+func TestBuildServerCreateOpts(t *testing.T) {
+	data, err := userdata.Build(userdata.BuildOpts{
+		Scripts: []userdata.Script{{Content: Script}},
+		Gzip:    true,
+	})
+	th.AssertNoErr(t, err)
+
+	createOpts := servers.CreateOpts{
+		Name:      "web",
+		ImageRef:  "f90f6034-2570-4974-8351-6b49732ef2eb",
+		FlavorRef: "1",
+		UserData:  data,
+	}
+	b, err := createOpts.ToServerCreateMap()
+	th.AssertNoErr(t, err)
+
+	server := b["server"].(map[string]interface{})
+	th.AssertEquals(t, base64.StdEncoding.EncodeToString(data), *server["user_data"].(*string))
+}
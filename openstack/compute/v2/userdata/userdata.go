@@ -0,0 +1,281 @@
+package userdata
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// MaxSize is the maximum size, once base64 encoded, of the user data
+// accepted by the Compute service.
+const MaxSize = 65535
+
+// ContentType is the MIME type of a part of the user data, which tells
+// cloud-init how to handle it.
+type ContentType string
+
+const (
+	ContentTypeCloudConfig ContentType = "text/cloud-config"
+	ContentTypeShellScript ContentType = "text/x-shellscript"
+	ContentTypeIncludeURL  ContentType = "text/x-include-url"
+	ContentTypeBoothook    ContentType = "text/cloud-boothook"
+	ContentTypePartHandler ContentType = "text/part-handler"
+)
+
+// User is a user created by cloud-init.
+type User struct {
+	Name              string   `yaml:"name"`
+	Gecos             string   `yaml:"gecos,omitempty"`
+	Groups            []string `yaml:"groups,omitempty,flow"`
+	Shell             string   `yaml:"shell,omitempty"`
+	Sudo              string   `yaml:"sudo,omitempty"`
+	LockPasswd        *bool    `yaml:"lock_passwd,omitempty"`
+	Passwd            string   `yaml:"passwd,omitempty"`
+	System            bool     `yaml:"system,omitempty"`
+	SSHAuthorizedKeys []string `yaml:"ssh_authorized_keys,omitempty"`
+}
+
+// File is a file written by cloud-init.
+type File struct {
+	Path        string `yaml:"path"`
+	Content     string `yaml:"content,omitempty"`
+	Encoding    string `yaml:"encoding,omitempty"`
+	Owner       string `yaml:"owner,omitempty"`
+	Permissions string `yaml:"permissions,omitempty"`
+	Append      bool   `yaml:"append,omitempty"`
+	Defer       bool   `yaml:"defer,omitempty"`
+}
+
+// CloudConfig is a cloud-config document.
+type CloudConfig struct {
+	// Hostname is the host name of the server.
+	Hostname string `yaml:"hostname,omitempty"`
+
+	// Users are the users to create. Include "default" as a user name to
+	// keep the default user of the image.
+	Users []User `yaml:"users,omitempty"`
+
+	// SSHAuthorizedKeys are the SSH keys authorized for the default user.
+	SSHAuthorizedKeys []string `yaml:"ssh_authorized_keys,omitempty"`
+
+	// PackageUpdate updates the package database on first boot.
+	PackageUpdate bool `yaml:"package_update,omitempty"`
+
+	// PackageUpgrade upgrades the installed packages on first boot.
+	PackageUpgrade bool `yaml:"package_upgrade,omitempty"`
+
+	// Packages are the packages to install.
+	Packages []string `yaml:"packages,omitempty"`
+
+	// WriteFiles are the files to write.
+	WriteFiles []File `yaml:"write_files,omitempty"`
+
+	// RunCmd are the commands to run on first boot. Each command is either
+	// a string, run by a shell, or a slice of strings, run directly.
+	RunCmd []interface{} `yaml:"runcmd,omitempty"`
+
+	// Extra contains any other cloud-config module configuration.
+	Extra map[string]interface{} `yaml:",inline"`
+}
+
+// Script is a shell script run by cloud-init on first boot.
+type Script struct {
+	Filename string
+	Content  string
+}
+
+// Part is an arbitrary part of the user data.
+type Part struct {
+	ContentType ContentType
+	Filename    string
+	Content     []byte
+}
+
+// BuildOpts contains the parts of the user data to build.
+type BuildOpts struct {
+	// CloudConfig is the cloud-config document.
+	CloudConfig *CloudConfig
+
+	// Scripts are the shell scripts, in the order they run.
+	Scripts []Script
+
+	// Includes are the URLs of files cloud-init fetches and processes as
+	// user data.
+	Includes []string
+
+	// Parts are any other parts of the user data.
+	Parts []Part
+
+	// Gzip compresses the user data. cloud-init detects and decompresses
+	// gzipped user data.
+	Gzip bool
+
+	// Boundary is the MIME boundary. A random boundary is used if empty; set
+	// it to build reproducible user data.
+	Boundary string
+}
+
+// ToParts converts the BuildOpts to the parts of the user data, in order:
+// the cloud-config document, the include file, the scripts and the other
+// parts.
+func (opts BuildOpts) ToParts() ([]Part, error) {
+	var parts []Part
+
+	if opts.CloudConfig != nil {
+		b, err := yaml.Marshal(opts.CloudConfig)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, Part{
+			ContentType: ContentTypeCloudConfig,
+			Filename:    "cloud-config.txt",
+			Content:     append([]byte("#cloud-config\n"), b...),
+		})
+	}
+
+	if len(opts.Includes) > 0 {
+		parts = append(parts, Part{
+			ContentType: ContentTypeIncludeURL,
+			Filename:    "include.txt",
+			Content:     []byte("#include\n" + strings.Join(opts.Includes, "\n") + "\n"),
+		})
+	}
+
+	for i, script := range opts.Scripts {
+		filename := script.Filename
+		if filename == "" {
+			filename = fmt.Sprintf("script-%d.sh", i)
+		}
+		parts = append(parts, Part{
+			ContentType: ContentTypeShellScript,
+			Filename:    filename,
+			Content:     []byte(script.Content),
+		})
+	}
+
+	parts = append(parts, opts.Parts...)
+
+	if len(parts) == 0 {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "userdata.BuildOpts.CloudConfig/Scripts/Includes/Parts"
+		return nil, err
+	}
+
+	return parts, nil
+}
+
+// Build builds the user data as a MIME multipart message, gzipped if
+// requested. An ErrUserDataTooLarge is returned if the result exceeds
+// MaxSize once base64 encoded.
+func Build(opts BuildOpts) ([]byte, error) {
+	parts, err := opts.ToParts()
+	if err != nil {
+		return nil, err
+	}
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	if opts.Boundary != "" {
+		if err := w.SetBoundary(opts.Boundary); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, part := range parts {
+		if err := writePart(w, part); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "Content-Type: multipart/mixed; boundary=%q\r\n", w.Boundary())
+	fmt.Fprintf(&out, "MIME-Version: 1.0\r\n\r\n")
+	out.Write(body.Bytes())
+
+	data := out.Bytes()
+	if opts.Gzip {
+		var gz bytes.Buffer
+		zw := gzip.NewWriter(&gz)
+		if _, err := zw.Write(data); err != nil {
+			return nil, err
+		}
+		if err := zw.Close(); err != nil {
+			return nil, err
+		}
+		data = gz.Bytes()
+	}
+
+	if size := base64.StdEncoding.EncodedLen(len(data)); size > MaxSize {
+		return nil, ErrUserDataTooLarge{Size: size, Limit: MaxSize}
+	}
+
+	return data, nil
+}
+
+// writePart writes a part of the user data. Content which is not plain
+// ASCII text is base64 encoded.
+func writePart(w *multipart.Writer, part Part) error {
+	encoding := "7bit"
+	content := part.Content
+	if !is7bit(content) {
+		encoding = "base64"
+		content = wrap(base64.StdEncoding.EncodeToString(content), 76)
+	}
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Type", fmt.Sprintf("%s; charset=%q", part.ContentType, "us-ascii"))
+	header.Set("MIME-Version", "1.0")
+	header.Set("Content-Transfer-Encoding", encoding)
+	if part.Filename != "" {
+		header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", part.Filename))
+	}
+
+	pw, err := w.CreatePart(header)
+	if err != nil {
+		return err
+	}
+	_, err = pw.Write(content)
+	return err
+}
+
+// is7bit determines whether content can be sent without transfer encoding.
+func is7bit(content []byte) bool {
+	lineLength := 0
+	for _, c := range content {
+		if c == 0 || c > 127 {
+			return false
+		}
+		if c == '\n' {
+			lineLength = 0
+			continue
+		}
+		lineLength++
+		if lineLength > 998 {
+			return false
+		}
+	}
+	return true
+}
+
+// wrap splits s in lines of at most n characters.
+func wrap(s string, n int) []byte {
+	var b bytes.Buffer
+	for len(s) > n {
+		b.WriteString(s[:n])
+		b.WriteString("\r\n")
+		s = s[n:]
+	}
+	b.WriteString(s)
+	b.WriteString("\r\n")
+	return b.Bytes()
+}
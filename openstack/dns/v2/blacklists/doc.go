@@ -0,0 +1,53 @@
+/*
+Package blacklists provides information and interaction with the blacklist
+API resource for the OpenStack DNS service. A blacklist prevents the creation
+of the zones whose name matches its pattern.
+
+Example to List Blacklists
+
+	allPages, err := blacklists.List(dnsClient, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allBlacklists, err := blacklists.ExtractBlacklists(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, blacklist := range allBlacklists {
+		fmt.Printf("%+v\n", blacklist)
+	}
+
+Example to Create a Blacklist
+
+	createOpts := blacklists.CreateOpts{
+		Pattern:     "^([A-Za-z0-9_\\-]+\\.)*example\\.com\\.$",
+		Description: "Reserved for internal use",
+	}
+
+	blacklist, err := blacklists.Create(dnsClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Blacklist
+
+	description := ""
+	updateOpts := blacklists.UpdateOpts{
+		Description: &description,
+	}
+
+	blacklist, err := blacklists.Update(dnsClient, "8c2a3c1f-8e0d-4e1f-9a5e-6f4a3b0e2d11", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Blacklist
+
+	err := blacklists.Delete(dnsClient, "8c2a3c1f-8e0d-4e1f-9a5e-6f4a3b0e2d11").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package blacklists
@@ -0,0 +1,137 @@
+package blacklists
+
+import (
+	"net/http"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add parameters to the List request.
+type ListOptsBuilder interface {
+	ToBlacklistListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the blacklist attributes you want to see returned. Marker and Limit are used
+// for pagination.
+// https://developer.openstack.org/api-ref/dns/
+type ListOpts struct {
+	// Integer value for the limit of values to return.
+	Limit int `q:"limit"`
+
+	// UUID of the blacklist at which you want to set a marker.
+	Marker string `q:"marker"`
+
+	Pattern     string `q:"pattern"`
+	Description string `q:"description"`
+	SortDir     string `q:"sort_dir"`
+	SortKey     string `q:"sort_key"`
+}
+
+// ToBlacklistListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToBlacklistListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List implements a blacklist List request.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := baseURL(client)
+	if opts != nil {
+		query, err := opts.ToBlacklistListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return BlacklistPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get returns information about a blacklist, given its ID.
+func Get(client *gophercloud.ServiceClient, blacklistID string) (r GetResult) {
+	resp, err := client.Get(resourceURL(client, blacklistID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional attributes to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToBlacklistCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies the attributes used to create a blacklist.
+type CreateOpts struct {
+	// Pattern is the regular expression matching the zone names which can
+	// not be created, such as "^example\\.com\\.$".
+	Pattern string `json:"pattern" required:"true"`
+
+	// Description of the blacklist.
+	Description string `json:"description,omitempty"`
+}
+
+// ToBlacklistCreateMap formats an CreateOpts structure into a request body.
+func (opts CreateOpts) ToBlacklistCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create implements a blacklist create request.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToBlacklistCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(baseURL(client), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{http.StatusCreated},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional attributes to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToBlacklistUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies the attributes to update a blacklist.
+type UpdateOpts struct {
+	// Pattern is the regular expression matching the blacklisted zone names.
+	Pattern string `json:"pattern,omitempty"`
+
+	// Description of the blacklist.
+	Description *string `json:"description,omitempty"`
+}
+
+// ToBlacklistUpdateMap formats an UpdateOpts structure into a request body.
+func (opts UpdateOpts) ToBlacklistUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update implements a blacklist update request.
+func Update(client *gophercloud.ServiceClient, blacklistID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToBlacklistUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Patch(resourceURL(client, blacklistID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{http.StatusOK},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete implements a blacklist delete request.
+func Delete(client *gophercloud.ServiceClient, blacklistID string) (r DeleteResult) {
+	resp, err := client.Delete(resourceURL(client, blacklistID), &gophercloud.RequestOpts{
+		OkCodes: []int{http.StatusNoContent},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,110 @@
+package blacklists
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult, CreateResult or UpdateResult as a Blacklist.
+// An error is returned if the original call or the extraction failed.
+func (r commonResult) Extract() (*Blacklist, error) {
+	var s *Blacklist
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// CreateResult is the result of a Create request. Call its Extract method
+// to interpret the result as a Blacklist.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult is the result of a Get request. Call its Extract method
+// to interpret the result as a Blacklist.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult is the result of an Update request. Call its Extract method
+// to interpret the result as a Blacklist.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the result of a Delete request. Call its ExtractErr method
+// to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// BlacklistPage is a single page of Blacklist results.
+type BlacklistPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if the page contains no results.
+func (r BlacklistPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	s, err := ExtractBlacklists(r)
+	return len(s) == 0, err
+}
+
+// ExtractBlacklists extracts a slice of Blacklists from a List result.
+func ExtractBlacklists(r pagination.Page) ([]Blacklist, error) {
+	var s struct {
+		Blacklists []Blacklist `json:"blacklists"`
+	}
+	err := (r.(BlacklistPage)).ExtractInto(&s)
+	return s.Blacklists, err
+}
+
+// Blacklist represents a pattern of zone names which can not be created.
+type Blacklist struct {
+	// ID uniquely identifies this blacklist amongst all other blacklists.
+	ID string `json:"id"`
+
+	// Pattern is the regular expression matching the blacklisted zone names.
+	Pattern string `json:"pattern"`
+
+	// Description for this blacklist.
+	Description string `json:"description"`
+
+	// CreatedAt is the date when the blacklist was created.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the date when the last change was made to the blacklist.
+	UpdatedAt time.Time `json:"-"`
+
+	// Links includes HTTP references to the itself, useful for passing along
+	// to other APIs that might want a blacklist reference.
+	Links map[string]interface{} `json:"links"`
+}
+
+func (r *Blacklist) UnmarshalJSON(b []byte) error {
+	type tmp Blacklist
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Blacklist(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return err
+}
@@ -0,0 +1,2 @@
+// blacklists unit tests
+package testing
@@ -0,0 +1,177 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/blacklists"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput is a sample response to a List call.
+const ListOutput = `
+{
+    "blacklists": [
+        {
+            "id": "8c2a3c1f-8e0d-4e1f-9a5e-6f4a3b0e2d11",
+            "pattern": "^([A-Za-z0-9_\\-]+\\.)*example\\.com\\.$",
+            "description": "Reserved for internal use",
+            "created_at": "2023-04-12T08:38:58.000000",
+            "updated_at": null,
+            "links": {
+                "self": "https://127.0.0.1:9001/v2/blacklists/8c2a3c1f-8e0d-4e1f-9a5e-6f4a3b0e2d11"
+            }
+        },
+        {
+            "id": "f5a1d6e4-03b4-4a7b-8c31-0cfa1c7b6b0e",
+            "pattern": "^test\\.org\\.$",
+            "description": null,
+            "created_at": "2023-04-12T09:38:58.000000",
+            "updated_at": "2023-04-12T10:38:58.000000",
+            "links": {
+                "self": "https://127.0.0.1:9001/v2/blacklists/f5a1d6e4-03b4-4a7b-8c31-0cfa1c7b6b0e"
+            }
+        }
+    ],
+    "links": {
+        "self": "https://127.0.0.1:9001/v2/blacklists"
+    }
+}
+`
+
+// GetOutput is a sample response to a Get call.
+const GetOutput = `
+{
+    "id": "8c2a3c1f-8e0d-4e1f-9a5e-6f4a3b0e2d11",
+    "pattern": "^([A-Za-z0-9_\\-]+\\.)*example\\.com\\.$",
+    "description": "Reserved for internal use",
+    "created_at": "2023-04-12T08:38:58.000000",
+    "updated_at": null,
+    "links": {
+        "self": "https://127.0.0.1:9001/v2/blacklists/8c2a3c1f-8e0d-4e1f-9a5e-6f4a3b0e2d11"
+    }
+}
+`
+
+// FirstBlacklist is the first result in ListOutput
+var FirstBlacklistCreatedAt, _ = time.Parse(gophercloud.RFC3339MilliNoZ, "2023-04-12T08:38:58.000000")
+var FirstBlacklist = blacklists.Blacklist{
+	ID:          "8c2a3c1f-8e0d-4e1f-9a5e-6f4a3b0e2d11",
+	Pattern:     `^([A-Za-z0-9_\-]+\.)*example\.com\.$`,
+	Description: "Reserved for internal use",
+	CreatedAt:   FirstBlacklistCreatedAt,
+	Links: map[string]interface{}{
+		"self": "https://127.0.0.1:9001/v2/blacklists/8c2a3c1f-8e0d-4e1f-9a5e-6f4a3b0e2d11",
+	},
+}
+
+// SecondBlacklist is the second result in ListOutput
+var SecondBlacklistCreatedAt, _ = time.Parse(gophercloud.RFC3339MilliNoZ, "2023-04-12T09:38:58.000000")
+var SecondBlacklistUpdatedAt, _ = time.Parse(gophercloud.RFC3339MilliNoZ, "2023-04-12T10:38:58.000000")
+var SecondBlacklist = blacklists.Blacklist{
+	ID:        "f5a1d6e4-03b4-4a7b-8c31-0cfa1c7b6b0e",
+	Pattern:   `^test\.org\.$`,
+	CreatedAt: SecondBlacklistCreatedAt,
+	UpdatedAt: SecondBlacklistUpdatedAt,
+	Links: map[string]interface{}{
+		"self": "https://127.0.0.1:9001/v2/blacklists/f5a1d6e4-03b4-4a7b-8c31-0cfa1c7b6b0e",
+	},
+}
+
+// ExpectedBlacklistsSlice is the slice of results that should be parsed
+// from ListOutput, in the expected order.
+var ExpectedBlacklistsSlice = []blacklists.Blacklist{FirstBlacklist, SecondBlacklist}
+
+// HandleListSuccessfully configures the test server to respond to a List request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/blacklists",
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, ListOutput)
+		})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/blacklists/"+FirstBlacklist.ID,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, GetOutput)
+		})
+}
+
+// CreateBlacklistRequest is a sample request to create a blacklist.
+const CreateBlacklistRequest = `
+{
+    "pattern": "^([A-Za-z0-9_\\-]+\\.)*example\\.com\\.$",
+    "description": "Reserved for internal use"
+}
+`
+
+// HandleCreateSuccessfully configures the test server to respond to a Create request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/blacklists",
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "POST")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			th.TestJSONRequest(t, r, CreateBlacklistRequest)
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, GetOutput)
+		})
+}
+
+// UpdateBlacklistRequest is a sample request to update a blacklist.
+const UpdateBlacklistRequest = `
+{
+    "description": "Updated Description"
+}
+`
+
+// UpdatedBlacklistResponse is a sample response to update a blacklist.
+const UpdatedBlacklistResponse = `
+{
+    "id": "8c2a3c1f-8e0d-4e1f-9a5e-6f4a3b0e2d11",
+    "pattern": "^([A-Za-z0-9_\\-]+\\.)*example\\.com\\.$",
+    "description": "Updated Description",
+    "created_at": "2023-04-12T08:38:58.000000",
+    "updated_at": "2023-04-12T11:38:58.000000",
+    "links": {
+        "self": "https://127.0.0.1:9001/v2/blacklists/8c2a3c1f-8e0d-4e1f-9a5e-6f4a3b0e2d11"
+    }
+}
+`
+
+// HandleUpdateSuccessfully configures the test server to respond to an Update request.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/blacklists/"+FirstBlacklist.ID,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "PATCH")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			th.TestJSONRequest(t, r, UpdateBlacklistRequest)
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, UpdatedBlacklistResponse)
+		})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a Delete request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/blacklists/"+FirstBlacklist.ID,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "DELETE")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+}
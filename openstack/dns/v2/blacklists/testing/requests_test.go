@@ -0,0 +1,83 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/blacklists"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	count := 0
+	err := blacklists.List(client.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := blacklists.ExtractBlacklists(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, ExpectedBlacklistsSlice, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := blacklists.Get(client.ServiceClient(), FirstBlacklist.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstBlacklist, actual)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := blacklists.CreateOpts{
+		Pattern:     `^([A-Za-z0-9_\-]+\.)*example\.com\.$`,
+		Description: "Reserved for internal use",
+	}
+
+	actual, err := blacklists.Create(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstBlacklist, actual)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	description := "Updated Description"
+	updateOpts := blacklists.UpdateOpts{
+		Description: &description,
+	}
+
+	updatedAt, _ := time.Parse(gophercloud.RFC3339MilliNoZ, "2023-04-12T11:38:58.000000")
+	expected := FirstBlacklist
+	expected.Description = description
+	expected.UpdatedAt = updatedAt
+
+	actual, err := blacklists.Update(client.ServiceClient(), FirstBlacklist.ID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &expected, actual)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	err := blacklists.Delete(client.ServiceClient(), FirstBlacklist.ID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,13 @@
+package blacklists
+
+import "github.com/gophercloud/gophercloud"
+
+const resourcePath = "blacklists"
+
+func baseURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, blacklistID string) string {
+	return c.ServiceURL(resourcePath, blacklistID)
+}
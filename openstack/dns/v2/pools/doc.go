@@ -0,0 +1,29 @@
+/*
+Package pools provides information about the pools of DNS servers of the
+OpenStack DNS service. Pools are managed by the operator with designate-manage
+and are read only through the API.
+
+Example to List Pools
+
+	allPages, err := pools.List(dnsClient, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allPools, err := pools.ExtractPools(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, pool := range allPools {
+		fmt.Printf("%+v\n", pool)
+	}
+
+Example to Get a Pool
+
+	pool, err := pools.Get(dnsClient, "794ccc2c-d751-44fe-b57f-8894c9f5c842").Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package pools
@@ -0,0 +1,102 @@
+package pools
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// GetResult is the result of a Get request. Call its Extract method
+// to interpret the result as a Pool.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult as a Pool.
+func (r GetResult) Extract() (*Pool, error) {
+	var s *Pool
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// PoolPage is a single page of Pool results.
+type PoolPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if the page contains no results.
+func (r PoolPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	s, err := ExtractPools(r)
+	return len(s) == 0, err
+}
+
+// ExtractPools extracts a slice of Pools from a List result.
+func ExtractPools(r pagination.Page) ([]Pool, error) {
+	var s struct {
+		Pools []Pool `json:"pools"`
+	}
+	err := (r.(PoolPage)).ExtractInto(&s)
+	return s.Pools, err
+}
+
+// Pool represents a set of DNS servers serving the zones scheduled to it.
+type Pool struct {
+	// ID uniquely identifies this pool amongst all other pools.
+	ID string `json:"id"`
+
+	// Name is the name of the pool.
+	Name string `json:"name"`
+
+	// Description for this pool.
+	Description string `json:"description"`
+
+	// Attributes are the key:value pairs used to schedule zones on the pool.
+	Attributes map[string]string `json:"attributes"`
+
+	// NSRecords are the name servers of the pool.
+	NSRecords []NSRecord `json:"ns_records"`
+
+	// ProjectID is the ID of the project that owns the pool.
+	ProjectID string `json:"project_id"`
+
+	// CreatedAt is the date when the pool was created.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the date when the last change was made to the pool.
+	UpdatedAt time.Time `json:"-"`
+
+	// Links includes HTTP references to the itself, useful for passing along
+	// to other APIs that might want a pool reference.
+	Links map[string]interface{} `json:"links"`
+}
+
+// NSRecord is a name server of a pool.
+type NSRecord struct {
+	Hostname string `json:"hostname"`
+	Priority int    `json:"priority"`
+}
+
+func (r *Pool) UnmarshalJSON(b []byte) error {
+	type tmp Pool
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Pool(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return err
+}
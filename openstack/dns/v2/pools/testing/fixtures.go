@@ -0,0 +1,156 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/pools"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput is a sample response to a List call.
+const ListOutput = `
+{
+    "pools": [
+        {
+            "id": "794ccc2c-d751-44fe-b57f-8894c9f5c842",
+            "name": "default",
+            "description": "Default Pool",
+            "attributes": {},
+            "ns_records": [
+                {
+                    "hostname": "ns1.example.com.",
+                    "priority": 1
+                },
+                {
+                    "hostname": "ns2.example.com.",
+                    "priority": 2
+                }
+            ],
+            "project_id": "noauth-project",
+            "created_at": "2023-04-12T08:38:58.000000",
+            "updated_at": null,
+            "links": {
+                "self": "https://127.0.0.1:9001/v2/pools/794ccc2c-d751-44fe-b57f-8894c9f5c842"
+            }
+        },
+        {
+            "id": "d1716333-8c16-490f-85ee-29af36907605",
+            "name": "secondary",
+            "description": null,
+            "attributes": {
+                "service_tier": "gold"
+            },
+            "ns_records": [
+                {
+                    "hostname": "ns3.example.com.",
+                    "priority": 1
+                }
+            ],
+            "project_id": "noauth-project",
+            "created_at": "2023-04-12T09:38:58.000000",
+            "updated_at": "2023-04-12T10:38:58.000000",
+            "links": {
+                "self": "https://127.0.0.1:9001/v2/pools/d1716333-8c16-490f-85ee-29af36907605"
+            }
+        }
+    ],
+    "links": {
+        "self": "https://127.0.0.1:9001/v2/pools"
+    }
+}
+`
+
+// GetOutput is a sample response to a Get call.
+const GetOutput = `
+{
+    "id": "794ccc2c-d751-44fe-b57f-8894c9f5c842",
+    "name": "default",
+    "description": "Default Pool",
+    "attributes": {},
+    "ns_records": [
+        {
+            "hostname": "ns1.example.com.",
+            "priority": 1
+        },
+        {
+            "hostname": "ns2.example.com.",
+            "priority": 2
+        }
+    ],
+    "project_id": "noauth-project",
+    "created_at": "2023-04-12T08:38:58.000000",
+    "updated_at": null,
+    "links": {
+        "self": "https://127.0.0.1:9001/v2/pools/794ccc2c-d751-44fe-b57f-8894c9f5c842"
+    }
+}
+`
+
+// FirstPool is the first result in ListOutput
+var FirstPoolCreatedAt, _ = time.Parse(gophercloud.RFC3339MilliNoZ, "2023-04-12T08:38:58.000000")
+var FirstPool = pools.Pool{
+	ID:          "794ccc2c-d751-44fe-b57f-8894c9f5c842",
+	Name:        "default",
+	Description: "Default Pool",
+	Attributes:  map[string]string{},
+	NSRecords: []pools.NSRecord{
+		{Hostname: "ns1.example.com.", Priority: 1},
+		{Hostname: "ns2.example.com.", Priority: 2},
+	},
+	ProjectID: "noauth-project",
+	CreatedAt: FirstPoolCreatedAt,
+	Links: map[string]interface{}{
+		"self": "https://127.0.0.1:9001/v2/pools/794ccc2c-d751-44fe-b57f-8894c9f5c842",
+	},
+}
+
+// SecondPool is the second result in ListOutput
+var SecondPoolCreatedAt, _ = time.Parse(gophercloud.RFC3339MilliNoZ, "2023-04-12T09:38:58.000000")
+var SecondPoolUpdatedAt, _ = time.Parse(gophercloud.RFC3339MilliNoZ, "2023-04-12T10:38:58.000000")
+var SecondPool = pools.Pool{
+	ID:   "d1716333-8c16-490f-85ee-29af36907605",
+	Name: "secondary",
+	Attributes: map[string]string{
+		"service_tier": "gold",
+	},
+	NSRecords: []pools.NSRecord{
+		{Hostname: "ns3.example.com.", Priority: 1},
+	},
+	ProjectID: "noauth-project",
+	CreatedAt: SecondPoolCreatedAt,
+	UpdatedAt: SecondPoolUpdatedAt,
+	Links: map[string]interface{}{
+		"self": "https://127.0.0.1:9001/v2/pools/d1716333-8c16-490f-85ee-29af36907605",
+	},
+}
+
+// ExpectedPoolsSlice is the slice of results that should be parsed
+// from ListOutput, in the expected order.
+var ExpectedPoolsSlice = []pools.Pool{FirstPool, SecondPool}
+
+// HandleListSuccessfully configures the test server to respond to a List request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/pools",
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, ListOutput)
+		})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/pools/"+FirstPool.ID,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, GetOutput)
+		})
+}
@@ -0,0 +1,13 @@
+package pools
+
+import "github.com/gophercloud/gophercloud"
+
+const resourcePath = "pools"
+
+func baseURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, poolID string) string {
+	return c.ServiceURL(resourcePath, poolID)
+}
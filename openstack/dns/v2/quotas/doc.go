@@ -0,0 +1,33 @@
+/*
+Package quotas provides the ability to retrieve and manage the DNS quotas of
+a project.
+
+Example to Get the Quotas of a Project
+
+	quota, err := quotas.Get(dnsClient, "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%+v\n", quota)
+
+Example to Update the Quotas of a Project
+
+	zones := 50
+	updateOpts := quotas.UpdateOpts{
+		Zones: &zones,
+	}
+
+	quota, err := quotas.Update(dnsClient, "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Reset the Quotas of a Project to their Defaults
+
+	err := quotas.Delete(dnsClient, "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package quotas
@@ -0,0 +1,67 @@
+package quotas
+
+import (
+	"net/http"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// Get returns the quotas of a project.
+func Get(client *gophercloud.ServiceClient, projectID string) (r GetResult) {
+	resp, err := client.Get(resourceURL(client, projectID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional attributes to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToQuotaUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies the quotas to update. The quotas left nil keep their
+// current value.
+type UpdateOpts struct {
+	// APIExportSize is the maximum number of recordsets in a zone export.
+	APIExportSize *int `json:"api_export_size,omitempty"`
+
+	// RecordsetRecords is the maximum number of records in a recordset.
+	RecordsetRecords *int `json:"recordset_records,omitempty"`
+
+	// ZoneRecords is the maximum number of records in a zone.
+	ZoneRecords *int `json:"zone_records,omitempty"`
+
+	// ZoneRecordsets is the maximum number of recordsets in a zone.
+	ZoneRecordsets *int `json:"zone_recordsets,omitempty"`
+
+	// Zones is the maximum number of zones of the project.
+	Zones *int `json:"zones,omitempty"`
+}
+
+// ToQuotaUpdateMap formats an UpdateOpts structure into a request body.
+func (opts UpdateOpts) ToQuotaUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update sets the quotas of a project.
+func Update(client *gophercloud.ServiceClient, projectID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToQuotaUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Patch(resourceURL(client, projectID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{http.StatusOK},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete resets the quotas of a project to their default values.
+func Delete(client *gophercloud.ServiceClient, projectID string) (r DeleteResult) {
+	resp, err := client.Delete(resourceURL(client, projectID), &gophercloud.RequestOpts{
+		OkCodes: []int{http.StatusNoContent},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,53 @@
+package quotas
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult or UpdateResult as a Quota.
+// An error is returned if the original call or the extraction failed.
+func (r commonResult) Extract() (*Quota, error) {
+	var s *Quota
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// GetResult is the result of a Get request. Call its Extract method
+// to interpret the result as a Quota.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult is the result of an Update request. Call its Extract method
+// to interpret the result as a Quota.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the result of a Delete request. Call its ExtractErr method
+// to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// Quota represents the DNS quotas of a project.
+type Quota struct {
+	// APIExportSize is the maximum number of recordsets in a zone export.
+	APIExportSize int `json:"api_export_size"`
+
+	// RecordsetRecords is the maximum number of records in a recordset.
+	RecordsetRecords int `json:"recordset_records"`
+
+	// ZoneRecords is the maximum number of records in a zone.
+	ZoneRecords int `json:"zone_records"`
+
+	// ZoneRecordsets is the maximum number of recordsets in a zone.
+	ZoneRecordsets int `json:"zone_recordsets"`
+
+	// Zones is the maximum number of zones of the project.
+	Zones int `json:"zones"`
+}
@@ -0,0 +1,98 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/quotas"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ProjectID is the project used in the tests.
+const ProjectID = "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3"
+
+// GetOutput is a sample response to a Get call.
+const GetOutput = `
+{
+    "api_export_size": 1000,
+    "recordset_records": 20,
+    "zone_records": 500,
+    "zone_recordsets": 500,
+    "zones": 100
+}
+`
+
+// ExpectedQuota is the result of GetOutput.
+var ExpectedQuota = quotas.Quota{
+	APIExportSize:    1000,
+	RecordsetRecords: 20,
+	ZoneRecords:      500,
+	ZoneRecordsets:   500,
+	Zones:            100,
+}
+
+// UpdateRequest is a sample request to update the quotas.
+const UpdateRequest = `
+{
+    "zones": 50,
+    "zone_records": 1000
+}
+`
+
+// UpdateOutput is a sample response to an Update call.
+const UpdateOutput = `
+{
+    "api_export_size": 1000,
+    "recordset_records": 20,
+    "zone_records": 1000,
+    "zone_recordsets": 500,
+    "zones": 50
+}
+`
+
+// UpdatedQuota is the result of UpdateOutput.
+var UpdatedQuota = quotas.Quota{
+	APIExportSize:    1000,
+	RecordsetRecords: 20,
+	ZoneRecords:      1000,
+	ZoneRecordsets:   500,
+	Zones:            50,
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/quotas/"+ProjectID,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, GetOutput)
+		})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an Update request.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/quotas/"+ProjectID,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "PATCH")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			th.TestJSONRequest(t, r, UpdateRequest)
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, UpdateOutput)
+		})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a Delete request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/quotas/"+ProjectID,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "DELETE")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+}
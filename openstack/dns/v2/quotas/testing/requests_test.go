@@ -0,0 +1,45 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/quotas"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := quotas.Get(client.ServiceClient(), ProjectID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &ExpectedQuota, actual)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	zones := 50
+	zoneRecords := 1000
+	updateOpts := quotas.UpdateOpts{
+		Zones:       &zones,
+		ZoneRecords: &zoneRecords,
+	}
+
+	actual, err := quotas.Update(client.ServiceClient(), ProjectID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &UpdatedQuota, actual)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	err := quotas.Delete(client.ServiceClient(), ProjectID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
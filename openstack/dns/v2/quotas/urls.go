@@ -0,0 +1,9 @@
+package quotas
+
+import "github.com/gophercloud/gophercloud"
+
+const resourcePath = "quotas"
+
+func resourceURL(c *gophercloud.ServiceClient, projectID string) string {
+	return c.ServiceURL(resourcePath, projectID)
+}
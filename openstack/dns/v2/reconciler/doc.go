@@ -0,0 +1,43 @@
+/*
+Package reconciler brings the recordsets of a zone of the OpenStack DNS
+service to a desired state, typically parsed from a zone file with the
+zonefile package, with the minimal set of API calls.
+
+The SOA recordset and the NS recordset of the apex of the zone are managed by
+the DNS service and are left untouched. The existing recordsets which are not
+desired are deleted, unless KeepUnlisted is set.
+
+Example to Show the Changes Without Applying Them
+
+	f, err := os.Open("example.org.zone")
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	desired, err := zonefile.Parse(f, "example.org.")
+	if err != nil {
+		panic(err)
+	}
+
+	plan, err := reconciler.Reconcile(dnsClient, "2150b1bf-dee2-4221-9d85-11f7886fb15f", desired, reconciler.ReconcileOpts{
+		DryRun: true,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(plan)
+
+Example to Apply a Zone File to a Zone
+
+	plan, err := reconciler.Reconcile(dnsClient, "2150b1bf-dee2-4221-9d85-11f7886fb15f", desired, reconciler.ReconcileOpts{})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, op := range plan.Operations {
+		fmt.Println(op)
+	}
+*/
+package reconciler
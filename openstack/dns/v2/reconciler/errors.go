@@ -0,0 +1,47 @@
+package reconciler
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ErrOutOfZone is returned by Diff when a desired recordset is not within
+// the zone.
+type ErrOutOfZone struct {
+	gophercloud.BaseError
+	Name string
+	Zone string
+}
+
+func (e ErrOutOfZone) Error() string {
+	return fmt.Sprintf("Recordset %s is not within zone %s", e.Name, e.Zone)
+}
+
+// ErrDuplicateRecordSet is returned by Diff when several desired recordsets
+// have the same name and type.
+type ErrDuplicateRecordSet struct {
+	gophercloud.BaseError
+	Name string
+	Type string
+}
+
+func (e ErrDuplicateRecordSet) Error() string {
+	return fmt.Sprintf("Recordset %s %s is defined more than once", e.Name, e.Type)
+}
+
+// ErrOperationFailed is returned by Apply when an operation of the plan fails.
+// The operations before it have been applied.
+type ErrOperationFailed struct {
+	gophercloud.BaseError
+	Operation Operation
+	Err       error
+}
+
+func (e ErrOperationFailed) Error() string {
+	return fmt.Sprintf("Failed to %s recordset %s %s: %s", e.Operation.Action, e.Operation.Name, e.Operation.Type, e.Err)
+}
+
+func (e ErrOperationFailed) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,242 @@
+package reconciler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/recordsets"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/zones"
+)
+
+// DiffOpts tunes the computation of a Plan.
+type DiffOpts struct {
+	// KeepUnlisted keeps the existing recordsets that are not desired, instead
+	// of deleting them.
+	KeepUnlisted bool
+}
+
+// Diff computes the operations which bring the recordsets of a zone to the
+// desired ones, such as the output of zonefile.Parse. The names of the
+// desired recordsets must be absolute.
+//
+// The SOA recordset and the NS recordset of the apex of the zone are managed
+// by the DNS service: they are never created, updated or deleted, whatever
+// the desired recordsets. The NS recordsets below the apex, which delegate
+// subdomains, are handled like any other recordset.
+//
+// Records are compared regardless of their order and of the case of the
+// domain names they contain, except for TXT and SPF records.
+func Diff(client *gophercloud.ServiceClient, zoneID string, desired []recordsets.RecordSet, opts DiffOpts) (*Plan, error) {
+	zone, err := zones.Get(client, zoneID).Extract()
+	if err != nil {
+		return nil, err
+	}
+	zoneName := strings.ToLower(zone.Name)
+
+	want := make(map[string]recordsets.RecordSet)
+	var wantKeys []string
+	for _, rrset := range desired {
+		name := strings.ToLower(rrset.Name)
+		if name != zoneName && !strings.HasSuffix(name, "."+zoneName) {
+			return nil, ErrOutOfZone{Name: rrset.Name, Zone: zone.Name}
+		}
+		rrset.Name = name
+		rrset.Type = strings.ToUpper(rrset.Type)
+		if managed(rrset, zoneName) {
+			continue
+		}
+		k := key(rrset)
+		if _, ok := want[k]; ok {
+			return nil, ErrDuplicateRecordSet{Name: rrset.Name, Type: rrset.Type}
+		}
+		want[k] = rrset
+		wantKeys = append(wantKeys, k)
+	}
+
+	allPages, err := recordsets.ListByZone(client, zoneID, nil).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	current, err := recordsets.ExtractRecordSets(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	have := make(map[string]recordsets.RecordSet)
+	for _, rrset := range current {
+		rrset.Name = strings.ToLower(rrset.Name)
+		if managed(rrset, zoneName) || rrset.Action == "DELETE" {
+			continue
+		}
+		have[key(rrset)] = rrset
+	}
+
+	plan := &Plan{ZoneID: zoneID}
+
+	// Deletions come first so that a name can change from a CNAME to other
+	// types of records, then updates and creations.
+	if !opts.KeepUnlisted {
+		var deletes []Operation
+		for k, rrset := range have {
+			if _, ok := want[k]; !ok {
+				deletes = append(deletes, Operation{
+					Action: ActionDelete,
+					Name:   rrset.Name,
+					Type:   rrset.Type,
+					ID:     rrset.ID,
+				})
+			}
+		}
+		sort.Slice(deletes, func(i, j int) bool {
+			if deletes[i].Name != deletes[j].Name {
+				return deletes[i].Name < deletes[j].Name
+			}
+			return deletes[i].Type < deletes[j].Type
+		})
+		plan.Operations = append(plan.Operations, deletes...)
+	}
+
+	var creates []Operation
+	for _, k := range wantKeys {
+		w := want[k]
+		h, ok := have[k]
+		if !ok {
+			creates = append(creates, Operation{
+				Action:  ActionCreate,
+				Name:    w.Name,
+				Type:    w.Type,
+				TTL:     w.TTL,
+				Records: w.Records,
+			})
+			continue
+		}
+
+		changes := diffRecordSet(h, w)
+		if len(changes) > 0 {
+			plan.Operations = append(plan.Operations, Operation{
+				Action:  ActionUpdate,
+				Name:    w.Name,
+				Type:    w.Type,
+				ID:      h.ID,
+				TTL:     w.TTL,
+				Records: w.Records,
+				Changes: changes,
+			})
+		}
+	}
+	plan.Operations = append(plan.Operations, creates...)
+
+	return plan, nil
+}
+
+// Apply makes the API calls of a plan, in order. It stops at the first
+// failure, which is returned as an ErrOperationFailed.
+func Apply(client *gophercloud.ServiceClient, plan *Plan) error {
+	for _, op := range plan.Operations {
+		var err error
+		switch op.Action {
+		case ActionCreate:
+			_, err = recordsets.Create(client, plan.ZoneID, recordsets.CreateOpts{
+				Name:    op.Name,
+				Type:    op.Type,
+				TTL:     op.TTL,
+				Records: op.Records,
+			}).Extract()
+		case ActionUpdate:
+			ttl := op.TTL
+			_, err = recordsets.Update(client, plan.ZoneID, op.ID, recordsets.UpdateOpts{
+				TTL:     &ttl,
+				Records: op.Records,
+			}).Extract()
+		case ActionDelete:
+			err = recordsets.Delete(client, plan.ZoneID, op.ID).ExtractErr()
+		}
+		if err != nil {
+			return ErrOperationFailed{Operation: op, Err: err}
+		}
+	}
+	return nil
+}
+
+// ReconcileOpts tunes Reconcile.
+type ReconcileOpts struct {
+	// DryRun only computes the plan, without applying it.
+	DryRun bool
+
+	// KeepUnlisted is passed to Diff.
+	KeepUnlisted bool
+}
+
+// Reconcile computes the plan which brings the recordsets of a zone to the
+// desired ones and, unless opts.DryRun is set, applies it. The plan is
+// returned in both cases.
+func Reconcile(client *gophercloud.ServiceClient, zoneID string, desired []recordsets.RecordSet, opts ReconcileOpts) (*Plan, error) {
+	plan, err := Diff(client, zoneID, desired, DiffOpts{
+		KeepUnlisted: opts.KeepUnlisted,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.DryRun {
+		return plan, nil
+	}
+
+	return plan, Apply(client, plan)
+}
+
+// managed reports whether a recordset is managed by the DNS service.
+func managed(rrset recordsets.RecordSet, zoneName string) bool {
+	switch strings.ToUpper(rrset.Type) {
+	case "SOA":
+		return true
+	case "NS":
+		return rrset.Name == zoneName
+	}
+	return false
+}
+
+func key(rrset recordsets.RecordSet) string {
+	return rrset.Name + " " + strings.ToUpper(rrset.Type)
+}
+
+// diffRecordSet returns the changes between an existing recordset and the
+// desired one.
+func diffRecordSet(have, want recordsets.RecordSet) []string {
+	var changes []string
+	if have.TTL != want.TTL {
+		changes = append(changes, fmt.Sprintf("ttl %d -> %d", have.TTL, want.TTL))
+	}
+
+	haveRecords := normalize(have)
+	wantRecords := normalize(want)
+	for _, r := range want.Records {
+		if !haveRecords[normalizeRecord(want.Type, r)] {
+			changes = append(changes, "+"+r)
+		}
+	}
+	for _, r := range have.Records {
+		if !wantRecords[normalizeRecord(have.Type, r)] {
+			changes = append(changes, "-"+r)
+		}
+	}
+	return changes
+}
+
+func normalize(rrset recordsets.RecordSet) map[string]bool {
+	records := make(map[string]bool, len(rrset.Records))
+	for _, r := range rrset.Records {
+		records[normalizeRecord(rrset.Type, r)] = true
+	}
+	return records
+}
+
+func normalizeRecord(rrtype, record string) string {
+	switch strings.ToUpper(rrtype) {
+	case "TXT", "SPF":
+		return strings.TrimSpace(record)
+	}
+	return strings.ToLower(strings.Join(strings.Fields(record), " "))
+}
@@ -0,0 +1,83 @@
+package reconciler
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Action is the kind of change an Operation makes.
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// Operation is a single API call of a Plan.
+type Operation struct {
+	// Action is the kind of change made by the operation.
+	Action Action
+
+	// Name and Type identify the recordset.
+	Name string
+	Type string
+
+	// ID is the ID of the existing recordset, if any.
+	ID string
+
+	// TTL and Records are the desired TTL and records of the recordset. They
+	// are empty for a deletion.
+	TTL     int
+	Records []string
+
+	// Changes lists the changes made by an update: the TTL change, and the
+	// records added (+) and removed (-).
+	Changes []string
+}
+
+// String returns the operation as a line of a diff.
+func (o Operation) String() string {
+	var sign string
+	switch o.Action {
+	case ActionCreate:
+		sign = "+"
+	case ActionUpdate:
+		sign = "~"
+	case ActionDelete:
+		sign = "-"
+	}
+
+	s := fmt.Sprintf("%s %s %s", sign, o.Name, o.Type)
+	switch {
+	case o.Action == ActionCreate:
+		s += " (" + strings.Join(o.Records, ", ") + ")"
+	case len(o.Changes) > 0:
+		s += " (" + strings.Join(o.Changes, ", ") + ")"
+	}
+	return s
+}
+
+// Plan is the ordered list of operations which brings the recordsets of a
+// zone to the desired state.
+type Plan struct {
+	// ZoneID is the ID of the reconciled zone.
+	ZoneID string
+
+	// Operations are the API calls to make, in order.
+	Operations []Operation
+}
+
+// Empty reports whether the zone already matches the desired recordsets.
+func (p Plan) Empty() bool {
+	return len(p.Operations) == 0
+}
+
+// String returns the plan as a diff, one operation per line.
+func (p Plan) String() string {
+	lines := make([]string, len(p.Operations))
+	for i, op := range p.Operations {
+		lines[i] = op.String()
+	}
+	return strings.Join(lines, "\n")
+}
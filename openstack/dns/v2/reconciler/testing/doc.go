@@ -0,0 +1,2 @@
+// dns reconciler unit tests
+package testing
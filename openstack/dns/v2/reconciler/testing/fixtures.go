@@ -0,0 +1,195 @@
+package testing
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ZoneID is the zone used in the tests.
+const ZoneID = "2150b1bf-dee2-4221-9d85-11f7886fb15f"
+
+// ZoneGetOutput is a sample response to a zone Get call.
+const ZoneGetOutput = `
+{
+    "id": "2150b1bf-dee2-4221-9d85-11f7886fb15f",
+    "name": "example.org.",
+    "email": "hostmaster@example.org",
+    "ttl": 3600,
+    "status": "ACTIVE",
+    "type": "PRIMARY"
+}
+`
+
+// ListByZoneOutput is a sample response to a recordsets ListByZone call.
+const ListByZoneOutput = `
+{
+    "recordsets": [
+        {
+            "id": "b1a2c3d4-0000-4000-8000-000000000001",
+            "name": "example.org.",
+            "type": "SOA",
+            "ttl": 3600,
+            "records": ["ns1.designate.net. hostmaster.example.org. 1681290000 3600 600 86400 3600"],
+            "status": "ACTIVE",
+            "action": "NONE"
+        },
+        {
+            "id": "b1a2c3d4-0000-4000-8000-000000000002",
+            "name": "example.org.",
+            "type": "NS",
+            "ttl": 3600,
+            "records": ["ns1.designate.net."],
+            "status": "ACTIVE",
+            "action": "NONE"
+        },
+        {
+            "id": "b1a2c3d4-0000-4000-8000-000000000003",
+            "name": "www.example.org.",
+            "type": "A",
+            "ttl": 300,
+            "records": ["192.0.2.10"],
+            "status": "ACTIVE",
+            "action": "NONE"
+        },
+        {
+            "id": "b1a2c3d4-0000-4000-8000-000000000004",
+            "name": "Mail.Example.org.",
+            "type": "MX",
+            "ttl": 3600,
+            "records": ["10  MX1.example.org."],
+            "status": "ACTIVE",
+            "action": "NONE"
+        },
+        {
+            "id": "b1a2c3d4-0000-4000-8000-000000000005",
+            "name": "ftp.example.org.",
+            "type": "CNAME",
+            "ttl": 3600,
+            "records": ["www.example.org."],
+            "status": "ACTIVE",
+            "action": "NONE"
+        },
+        {
+            "id": "b1a2c3d4-0000-4000-8000-000000000006",
+            "name": "gone.example.org.",
+            "type": "A",
+            "ttl": 3600,
+            "records": ["192.0.2.99"],
+            "status": "PENDING",
+            "action": "DELETE"
+        }
+    ],
+    "links": {
+        "self": "https://127.0.0.1:9001/v2/zones/2150b1bf-dee2-4221-9d85-11f7886fb15f/recordsets"
+    }
+}
+`
+
+// ZoneFile is the desired state of the zone.
+const ZoneFile = `$ORIGIN example.org.
+$TTL 3600
+@       SOA ns1 hostmaster 1 3600 600 86400 3600
+@       NS  ns1
+        NS  ns2
+www 600 A   192.0.2.10
+        A   192.0.2.11
+mail    MX  10 mx1
+ftp     A   192.0.2.20
+sub     NS  ns.sub
+`
+
+// ExpectedPlan is the plan which brings the zone of ListByZoneOutput to
+// ZoneFile.
+const ExpectedPlan = `- ftp.example.org. CNAME
+~ www.example.org. A (ttl 300 -> 600, +192.0.2.11)
++ ftp.example.org. A (192.0.2.20)
++ sub.example.org. NS (ns.sub.example.org.)`
+
+// Calls records the calls which change the recordsets of the zone.
+type Calls struct {
+	mu    sync.Mutex
+	calls []string
+}
+
+// List returns the recorded calls.
+func (c *Calls) List() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.calls...)
+}
+
+func (c *Calls) add(call string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls = append(c.calls, call)
+}
+
+// HandleZone configures the test server to respond to the calls of Diff and
+// Apply. failOn makes the change calls whose description starts with it
+// fail.
+func HandleZone(t *testing.T, failOn string) *Calls {
+	calls := new(Calls)
+
+	th.Mux.HandleFunc("/zones/"+ZoneID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, ZoneGetOutput)
+	})
+
+	th.Mux.HandleFunc("/zones/"+ZoneID+"/recordsets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		switch r.Method {
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, ListByZoneOutput)
+		case "POST":
+			respond(t, w, r, calls, "POST", failOn, http.StatusAccepted)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	th.Mux.HandleFunc("/zones/"+ZoneID+"/recordsets/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		id := strings.TrimPrefix(r.URL.Path, "/zones/"+ZoneID+"/recordsets/")
+		switch r.Method {
+		case "PUT":
+			respond(t, w, r, calls, "PUT "+id, failOn, http.StatusAccepted)
+		case "DELETE":
+			calls.add("DELETE " + id)
+			if failOn != "" && strings.HasPrefix("DELETE "+id, failOn) {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	return calls
+}
+
+func respond(t *testing.T, w http.ResponseWriter, r *http.Request, calls *Calls, call, failOn string, status int) {
+	b, err := ioutil.ReadAll(r.Body)
+	th.AssertNoErr(t, err)
+	call += " " + string(b)
+	calls.add(call)
+
+	if failOn != "" && strings.HasPrefix(call, failOn) {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `{"id": "c0ffee00-0000-4000-8000-000000000001", "status": "PENDING"}`)
+}
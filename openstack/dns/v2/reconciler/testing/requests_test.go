@@ -0,0 +1,132 @@
+package testing
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/reconciler"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/recordsets"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/zonefile"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func desired(t *testing.T) []recordsets.RecordSet {
+	rrsets, err := zonefile.Parse(strings.NewReader(ZoneFile), "")
+	th.AssertNoErr(t, err)
+	return rrsets
+}
+
+func TestDryRun(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	calls := HandleZone(t, "")
+
+	plan, err := reconciler.Reconcile(client.ServiceClient(), ZoneID, desired(t), reconciler.ReconcileOpts{
+		DryRun: true,
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, ExpectedPlan, plan.String())
+	th.CheckEquals(t, 0, len(calls.List()))
+}
+
+func TestKeepUnlisted(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleZone(t, "")
+
+	plan, err := reconciler.Diff(client.ServiceClient(), ZoneID, desired(t), reconciler.DiffOpts{
+		KeepUnlisted: true,
+	})
+	th.AssertNoErr(t, err)
+	for _, op := range plan.Operations {
+		if op.Action == reconciler.ActionDelete {
+			t.Errorf("Unexpected deletion %s", op)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	calls := HandleZone(t, "")
+
+	_, err := reconciler.Reconcile(client.ServiceClient(), ZoneID, desired(t), reconciler.ReconcileOpts{})
+	th.AssertNoErr(t, err)
+
+	actual := calls.List()
+	th.AssertEquals(t, 4, len(actual))
+	th.CheckEquals(t, "DELETE b1a2c3d4-0000-4000-8000-000000000005", actual[0])
+	th.CheckJSONEquals(t, `{"ttl": 600, "records": ["192.0.2.10", "192.0.2.11"]}`, jsonBody(actual[1], "PUT b1a2c3d4-0000-4000-8000-000000000003 "))
+	th.CheckJSONEquals(t, `{"name": "ftp.example.org.", "type": "A", "ttl": 3600, "records": ["192.0.2.20"]}`, jsonBody(actual[2], "POST "))
+	th.CheckJSONEquals(t, `{"name": "sub.example.org.", "type": "NS", "ttl": 3600, "records": ["ns.sub.example.org."]}`, jsonBody(actual[3], "POST "))
+}
+
+func TestApplyFailure(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	calls := HandleZone(t, "PUT")
+
+	_, err := reconciler.Reconcile(client.ServiceClient(), ZoneID, desired(t), reconciler.ReconcileOpts{})
+	failed, ok := err.(reconciler.ErrOperationFailed)
+	if !ok {
+		t.Fatalf("Expected ErrOperationFailed, got %v", err)
+	}
+	th.CheckEquals(t, reconciler.ActionUpdate, failed.Operation.Action)
+	th.CheckEquals(t, "www.example.org.", failed.Operation.Name)
+	th.CheckEquals(t, 2, len(calls.List()))
+}
+
+func TestNoChanges(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleZone(t, "")
+
+	rrsets := []recordsets.RecordSet{
+		{Name: "www.example.org.", Type: "A", TTL: 300, Records: []string{"192.0.2.10"}},
+		{Name: "mail.example.org.", Type: "MX", TTL: 3600, Records: []string{"10 mx1.example.org."}},
+		{Name: "ftp.example.org.", Type: "CNAME", TTL: 3600, Records: []string{"www.example.org."}},
+	}
+
+	plan, err := reconciler.Diff(client.ServiceClient(), ZoneID, rrsets, reconciler.DiffOpts{})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, true, plan.Empty())
+}
+
+func TestOutOfZone(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleZone(t, "")
+
+	rrsets := []recordsets.RecordSet{
+		{Name: "www.example.com.", Type: "A", Records: []string{"192.0.2.10"}},
+	}
+
+	_, err := reconciler.Diff(client.ServiceClient(), ZoneID, rrsets, reconciler.DiffOpts{})
+	if _, ok := err.(reconciler.ErrOutOfZone); !ok {
+		t.Fatalf("Expected ErrOutOfZone, got %v", err)
+	}
+}
+
+func TestDuplicateRecordSet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleZone(t, "")
+
+	rrsets := []recordsets.RecordSet{
+		{Name: "www.example.org.", Type: "A", Records: []string{"192.0.2.10"}},
+		{Name: "WWW.example.org.", Type: "a", Records: []string{"192.0.2.11"}},
+	}
+
+	_, err := reconciler.Diff(client.ServiceClient(), ZoneID, rrsets, reconciler.DiffOpts{})
+	if _, ok := err.(reconciler.ErrDuplicateRecordSet); !ok {
+		t.Fatalf("Expected ErrDuplicateRecordSet, got %v", err)
+	}
+}
+
+func jsonBody(call, prefix string) interface{} {
+	var body interface{}
+	json.Unmarshal([]byte(strings.TrimPrefix(call, prefix)), &body)
+	return body
+}
@@ -0,0 +1,63 @@
+/*
+Package floatingips provides the ability to manage the reverse DNS (PTR)
+records of the floating IPs through the OpenStack DNS service.
+
+The PTR records are identified by the region of the floating IP and by its ID,
+joined by a colon. ID builds such an identifier.
+
+Example to List the PTR Records of the Floating IPs
+
+	allPages, err := floatingips.List(dnsClient, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allFIPs, err := floatingips.ExtractFloatingIPs(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, fip := range allFIPs {
+		fmt.Printf("%s -> %s\n", fip.Address, fip.PTRDName)
+	}
+
+Example to Set the PTR Record of a Floating IP
+
+	setOpts := floatingips.SetOpts{
+		PTRDName: "www.example.org.",
+		TTL:      3600,
+	}
+
+	id := floatingips.ID("RegionOne", "c5dc4d36-fb65-4d7f-8cd9-9f8e4a8e9a3b")
+	ptr, err := floatingips.Set(dnsClient, id, setOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Set the PTR Record of a Newly Allocated Floating IP
+
+	fip, err := l3floatingips.Create(networkClient, l3floatingips.CreateOpts{
+		FloatingNetworkID: "a6917946-38ab-4ffd-a55a-26c0980ce5ee",
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	setOpts := floatingips.SetOpts{
+		PTRDName: "www.example.org.",
+	}
+
+	ptr, err := floatingips.SetForFloatingIP(dnsClient, "RegionOne", *fip, setOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Unset the PTR Record of a Floating IP
+
+	id := floatingips.ID("RegionOne", "c5dc4d36-fb65-4d7f-8cd9-9f8e4a8e9a3b")
+	err := floatingips.Unset(dnsClient, id).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package floatingips
@@ -0,0 +1,138 @@
+package floatingips
+
+import (
+	"net/http"
+
+	"github.com/gophercloud/gophercloud"
+	l3floatingips "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ID returns the identifier of the PTR record of a floating IP, made of the
+// region of the floating IP and of its ID.
+func ID(region, floatingIPID string) string {
+	return region + ":" + floatingIPID
+}
+
+// ListOptsBuilder allows extensions to add parameters to the List request.
+type ListOptsBuilder interface {
+	ToFloatingIPListQuery() (string, error)
+}
+
+// ListOpts allows the paging of the PTR records of the floating IPs through
+// the API. Marker and Limit are used for pagination.
+// https://developer.openstack.org/api-ref/dns/
+type ListOpts struct {
+	// Integer value for the limit of values to return.
+	Limit int `q:"limit"`
+
+	// ID of the PTR record at which you want to set a marker.
+	Marker string `q:"marker"`
+}
+
+// ToFloatingIPListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToFloatingIPListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns the PTR records of the floating IPs of the project.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := baseURL(client)
+	if opts != nil {
+		query, err := opts.ToFloatingIPListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return FloatingIPPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get returns the PTR record of a floating IP, given its "region:id"
+// identifier as built by ID.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := client.Get(resourceURL(client, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// SetOptsBuilder allows extensions to add additional attributes to the Set
+// request.
+type SetOptsBuilder interface {
+	ToFloatingIPSetMap() (map[string]interface{}, error)
+}
+
+// SetOpts specifies the PTR record of a floating IP.
+type SetOpts struct {
+	// PTRDName is the domain name the address resolves to, such as
+	// "www.example.org.".
+	PTRDName string `json:"ptrdname" required:"true"`
+
+	// Description of the PTR record.
+	Description string `json:"description,omitempty"`
+
+	// TTL is the time to live of the PTR record.
+	TTL int `json:"ttl,omitempty"`
+}
+
+// ToFloatingIPSetMap formats a SetOpts structure into a request body.
+func (opts SetOpts) ToFloatingIPSetMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Set creates or replaces the PTR record of a floating IP, given its
+// "region:id" identifier as built by ID.
+func Set(client *gophercloud.ServiceClient, id string, opts SetOptsBuilder) (r SetResult) {
+	b, err := opts.ToFloatingIPSetMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Patch(resourceURL(client, id), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{http.StatusOK, http.StatusAccepted},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Unset removes the PTR record of a floating IP, given its "region:id"
+// identifier as built by ID.
+func Unset(client *gophercloud.ServiceClient, id string) (r UnsetResult) {
+	b := map[string]interface{}{"ptrdname": nil}
+	resp, err := client.Patch(resourceURL(client, id), &b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{http.StatusOK, http.StatusAccepted},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// SetForFloatingIP sets the PTR record of a floating IP allocated through
+// the Networking service, in the given region.
+func SetForFloatingIP(client *gophercloud.ServiceClient, region string, fip l3floatingips.FloatingIP, opts SetOptsBuilder) (r SetResult) {
+	if region == "" {
+		r.Err = gophercloud.ErrMissingInput{Argument: "region"}
+		return
+	}
+	if fip.ID == "" {
+		r.Err = gophercloud.ErrMissingInput{Argument: "FloatingIP.ID"}
+		return
+	}
+	return Set(client, ID(region, fip.ID), opts)
+}
+
+// UnsetForFloatingIP removes the PTR record of a floating IP allocated
+// through the Networking service, in the given region.
+func UnsetForFloatingIP(client *gophercloud.ServiceClient, region string, fip l3floatingips.FloatingIP) (r UnsetResult) {
+	if region == "" {
+		r.Err = gophercloud.ErrMissingInput{Argument: "region"}
+		return
+	}
+	if fip.ID == "" {
+		r.Err = gophercloud.ErrMissingInput{Argument: "FloatingIP.ID"}
+		return
+	}
+	return Unset(client, ID(region, fip.ID))
+}
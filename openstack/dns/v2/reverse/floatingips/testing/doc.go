@@ -0,0 +1,2 @@
+// reverse floatingips unit tests
+package testing
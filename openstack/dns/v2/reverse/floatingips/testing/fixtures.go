@@ -0,0 +1,166 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/reverse/floatingips"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput is a sample response to a List call.
+const ListOutput = `
+{
+    "floatingips": [
+        {
+            "id": "RegionOne:c5dc4d36-fb65-4d7f-8cd9-9f8e4a8e9a3b",
+            "ptrdname": "www.example.org.",
+            "description": "Web server",
+            "ttl": 3600,
+            "address": "172.24.4.10",
+            "status": "ACTIVE",
+            "action": "NONE",
+            "links": {
+                "self": "https://127.0.0.1:9001/v2/reverse/floatingips/RegionOne:c5dc4d36-fb65-4d7f-8cd9-9f8e4a8e9a3b"
+            }
+        },
+        {
+            "id": "RegionOne:0b1e3d47-4b2a-4b9e-8a5a-3c6f7e8d9a01",
+            "ptrdname": "mail.example.org.",
+            "description": null,
+            "ttl": 300,
+            "address": "172.24.4.11",
+            "status": "PENDING",
+            "action": "CREATE",
+            "links": {
+                "self": "https://127.0.0.1:9001/v2/reverse/floatingips/RegionOne:0b1e3d47-4b2a-4b9e-8a5a-3c6f7e8d9a01"
+            }
+        }
+    ],
+    "links": {
+        "self": "https://127.0.0.1:9001/v2/reverse/floatingips"
+    }
+}
+`
+
+// GetOutput is a sample response to a Get call.
+const GetOutput = `
+{
+    "id": "RegionOne:c5dc4d36-fb65-4d7f-8cd9-9f8e4a8e9a3b",
+    "ptrdname": "www.example.org.",
+    "description": "Web server",
+    "ttl": 3600,
+    "address": "172.24.4.10",
+    "status": "ACTIVE",
+    "action": "NONE",
+    "links": {
+        "self": "https://127.0.0.1:9001/v2/reverse/floatingips/RegionOne:c5dc4d36-fb65-4d7f-8cd9-9f8e4a8e9a3b"
+    }
+}
+`
+
+// FirstFloatingIP is the first result in ListOutput
+var FirstFloatingIP = floatingips.FloatingIP{
+	ID:          "RegionOne:c5dc4d36-fb65-4d7f-8cd9-9f8e4a8e9a3b",
+	PTRDName:    "www.example.org.",
+	Description: "Web server",
+	TTL:         3600,
+	Address:     "172.24.4.10",
+	Status:      "ACTIVE",
+	Action:      "NONE",
+	Links: map[string]interface{}{
+		"self": "https://127.0.0.1:9001/v2/reverse/floatingips/RegionOne:c5dc4d36-fb65-4d7f-8cd9-9f8e4a8e9a3b",
+	},
+}
+
+// SecondFloatingIP is the second result in ListOutput
+var SecondFloatingIP = floatingips.FloatingIP{
+	ID:       "RegionOne:0b1e3d47-4b2a-4b9e-8a5a-3c6f7e8d9a01",
+	PTRDName: "mail.example.org.",
+	TTL:      300,
+	Address:  "172.24.4.11",
+	Status:   "PENDING",
+	Action:   "CREATE",
+	Links: map[string]interface{}{
+		"self": "https://127.0.0.1:9001/v2/reverse/floatingips/RegionOne:0b1e3d47-4b2a-4b9e-8a5a-3c6f7e8d9a01",
+	},
+}
+
+// ExpectedFloatingIPsSlice is the slice of results that should be parsed
+// from ListOutput, in the expected order.
+var ExpectedFloatingIPsSlice = []floatingips.FloatingIP{FirstFloatingIP, SecondFloatingIP}
+
+// HandleListSuccessfully configures the test server to respond to a List request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/reverse/floatingips",
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, ListOutput)
+		})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/reverse/floatingips/"+FirstFloatingIP.ID,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, GetOutput)
+		})
+}
+
+// SetRequest is a sample request to set a PTR record.
+const SetRequest = `
+{
+    "ptrdname": "www.example.org.",
+    "description": "Web server",
+    "ttl": 3600
+}
+`
+
+// SetOutput is a sample response to a Set call.
+const SetOutput = `
+{
+    "id": "RegionOne:c5dc4d36-fb65-4d7f-8cd9-9f8e4a8e9a3b",
+    "ptrdname": "www.example.org.",
+    "description": "Web server",
+    "ttl": 3600,
+    "address": "172.24.4.10",
+    "status": "PENDING",
+    "action": "CREATE",
+    "links": {
+        "self": "https://127.0.0.1:9001/v2/reverse/floatingips/RegionOne:c5dc4d36-fb65-4d7f-8cd9-9f8e4a8e9a3b"
+    }
+}
+`
+
+// HandleSetSuccessfully configures the test server to respond to a Set request.
+func HandleSetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/reverse/floatingips/"+FirstFloatingIP.ID,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "PATCH")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			th.TestJSONRequest(t, r, SetRequest)
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusAccepted)
+			fmt.Fprintf(w, SetOutput)
+		})
+}
+
+// HandleUnsetSuccessfully configures the test server to respond to an Unset request.
+func HandleUnsetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/reverse/floatingips/"+FirstFloatingIP.ID,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "PATCH")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			th.TestJSONRequest(t, r, `{"ptrdname": null}`)
+
+			w.WriteHeader(http.StatusAccepted)
+		})
+}
@@ -0,0 +1,113 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/reverse/floatingips"
+	l3floatingips "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestID(t *testing.T) {
+	th.CheckEquals(t, FirstFloatingIP.ID, floatingips.ID("RegionOne", "c5dc4d36-fb65-4d7f-8cd9-9f8e4a8e9a3b"))
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	count := 0
+	err := floatingips.List(client.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := floatingips.ExtractFloatingIPs(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, ExpectedFloatingIPsSlice, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := floatingips.Get(client.ServiceClient(), FirstFloatingIP.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstFloatingIP, actual)
+}
+
+func TestSet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleSetSuccessfully(t)
+
+	setOpts := floatingips.SetOpts{
+		PTRDName:    "www.example.org.",
+		Description: "Web server",
+		TTL:         3600,
+	}
+
+	actual, err := floatingips.Set(client.ServiceClient(), FirstFloatingIP.ID, setOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "PENDING", actual.Status)
+	th.CheckEquals(t, "www.example.org.", actual.PTRDName)
+}
+
+func TestSetForFloatingIP(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleSetSuccessfully(t)
+
+	fip := l3floatingips.FloatingIP{
+		ID:         "c5dc4d36-fb65-4d7f-8cd9-9f8e4a8e9a3b",
+		FloatingIP: "172.24.4.10",
+	}
+	setOpts := floatingips.SetOpts{
+		PTRDName:    "www.example.org.",
+		Description: "Web server",
+		TTL:         3600,
+	}
+
+	actual, err := floatingips.SetForFloatingIP(client.ServiceClient(), "RegionOne", fip, setOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, fip.FloatingIP, actual.Address)
+}
+
+func TestSetForFloatingIPMissingInput(t *testing.T) {
+	setOpts := floatingips.SetOpts{PTRDName: "www.example.org."}
+
+	err := floatingips.SetForFloatingIP(client.ServiceClient(), "", l3floatingips.FloatingIP{ID: "c5dc4d36"}, setOpts).Err
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("Expected ErrMissingInput, got %v", err)
+	}
+
+	err = floatingips.SetForFloatingIP(client.ServiceClient(), "RegionOne", l3floatingips.FloatingIP{}, setOpts).Err
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("Expected ErrMissingInput, got %v", err)
+	}
+}
+
+func TestUnset(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUnsetSuccessfully(t)
+
+	err := floatingips.Unset(client.ServiceClient(), FirstFloatingIP.ID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestUnsetForFloatingIP(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUnsetSuccessfully(t)
+
+	fip := l3floatingips.FloatingIP{ID: "c5dc4d36-fb65-4d7f-8cd9-9f8e4a8e9a3b"}
+	err := floatingips.UnsetForFloatingIP(client.ServiceClient(), "RegionOne", fip).ExtractErr()
+	th.AssertNoErr(t, err)
+}
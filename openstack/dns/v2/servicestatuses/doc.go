@@ -0,0 +1,32 @@
+/*
+Package servicestatuses provides the status of the services of the OpenStack
+DNS service, as reported by their last heartbeat.
+
+Example to List the Services that are Down
+
+	listOpts := servicestatuses.ListOpts{
+		Status: "DOWN",
+	}
+
+	allPages, err := servicestatuses.List(dnsClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allStatuses, err := servicestatuses.ExtractServiceStatuses(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, status := range allStatuses {
+		fmt.Printf("%s on %s: %s\n", status.ServiceName, status.Hostname, status.Status)
+	}
+
+Example to Get a Service Status
+
+	status, err := servicestatuses.Get(dnsClient, "af91edb5-ede8-453f-af13-feabdd088f9c").Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package servicestatuses
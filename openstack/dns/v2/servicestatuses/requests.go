@@ -0,0 +1,56 @@
+package servicestatuses
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add parameters to the List request.
+type ListOptsBuilder interface {
+	ToServiceStatusListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the service status attributes you want to see returned. Marker and Limit are
+// used for pagination.
+// https://developer.openstack.org/api-ref/dns/
+type ListOpts struct {
+	// Integer value for the limit of values to return.
+	Limit int `q:"limit"`
+
+	// UUID of the service status at which you want to set a marker.
+	Marker string `q:"marker"`
+
+	Hostname    string `q:"hostname"`
+	ServiceName string `q:"service_name"`
+	Status      string `q:"status"`
+}
+
+// ToServiceStatusListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToServiceStatusListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List implements a service status List request.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := baseURL(client)
+	if opts != nil {
+		query, err := opts.ToServiceStatusListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ServiceStatusPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get returns the status of a service, given its ID.
+func Get(client *gophercloud.ServiceClient, serviceStatusID string) (r GetResult) {
+	resp, err := client.Get(resourceURL(client, serviceStatusID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,101 @@
+package servicestatuses
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// GetResult is the result of a Get request. Call its Extract method
+// to interpret the result as a ServiceStatus.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult as a ServiceStatus.
+func (r GetResult) Extract() (*ServiceStatus, error) {
+	var s *ServiceStatus
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// ServiceStatusPage is a single page of ServiceStatus results.
+type ServiceStatusPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if the page contains no results.
+func (r ServiceStatusPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	s, err := ExtractServiceStatuses(r)
+	return len(s) == 0, err
+}
+
+// ExtractServiceStatuses extracts a slice of ServiceStatuses from a List
+// result.
+func ExtractServiceStatuses(r pagination.Page) ([]ServiceStatus, error) {
+	var s struct {
+		ServiceStatuses []ServiceStatus `json:"service_statuses"`
+	}
+	err := (r.(ServiceStatusPage)).ExtractInto(&s)
+	return s.ServiceStatuses, err
+}
+
+// ServiceStatus represents the last heartbeat of a Designate service.
+type ServiceStatus struct {
+	// ID uniquely identifies this service status.
+	ID string `json:"id"`
+
+	// Hostname is the host the service runs on.
+	Hostname string `json:"hostname"`
+
+	// ServiceName is the name of the service, such as "central" or "worker".
+	ServiceName string `json:"service_name"`
+
+	// Status is the status of the service: UP, DOWN or WARNING.
+	Status string `json:"status"`
+
+	// Stats are the statistics reported by the service.
+	Stats map[string]interface{} `json:"stats"`
+
+	// Capabilities are the capabilities reported by the service.
+	Capabilities map[string]interface{} `json:"capabilities"`
+
+	// HeartbeatedAt is the date of the last heartbeat of the service.
+	HeartbeatedAt time.Time `json:"-"`
+
+	// CreatedAt is the date when the service was first seen.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the date when the last change was made to the status.
+	UpdatedAt time.Time `json:"-"`
+
+	// Links includes HTTP references to the itself.
+	Links map[string]interface{} `json:"links"`
+}
+
+func (r *ServiceStatus) UnmarshalJSON(b []byte) error {
+	type tmp ServiceStatus
+	var s struct {
+		tmp
+		HeartbeatedAt gophercloud.JSONRFC3339MilliNoZ `json:"heartbeated_at"`
+		CreatedAt     gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt     gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = ServiceStatus(s.tmp)
+
+	r.HeartbeatedAt = time.Time(s.HeartbeatedAt)
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return err
+}
@@ -0,0 +1,2 @@
+// servicestatuses unit tests
+package testing
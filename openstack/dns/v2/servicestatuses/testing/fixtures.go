@@ -0,0 +1,132 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/servicestatuses"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput is a sample response to a List call.
+const ListOutput = `
+{
+    "service_statuses": [
+        {
+            "id": "af91edb5-ede8-453f-af13-feabdd088f9c",
+            "hostname": "dns-1",
+            "service_name": "central",
+            "status": "UP",
+            "stats": {},
+            "capabilities": {},
+            "heartbeated_at": "2023-04-12T11:08:58.000000",
+            "created_at": "2023-04-12T08:38:58.000000",
+            "updated_at": "2023-04-12T11:08:58.000000",
+            "links": {
+                "self": "https://127.0.0.1:9001/v2/service_statuses/af91edb5-ede8-453f-af13-feabdd088f9c"
+            }
+        },
+        {
+            "id": "3d1c4a64-a7d9-4dd6-9b9f-a3e1ad0e8a4b",
+            "hostname": "dns-1",
+            "service_name": "worker",
+            "status": "DOWN",
+            "stats": {},
+            "capabilities": {},
+            "heartbeated_at": "2023-04-12T10:38:58.000000",
+            "created_at": "2023-04-12T08:38:58.000000",
+            "updated_at": "2023-04-12T10:38:58.000000",
+            "links": {
+                "self": "https://127.0.0.1:9001/v2/service_statuses/3d1c4a64-a7d9-4dd6-9b9f-a3e1ad0e8a4b"
+            }
+        }
+    ],
+    "links": {
+        "self": "https://127.0.0.1:9001/v2/service_statuses"
+    }
+}
+`
+
+// GetOutput is a sample response to a Get call.
+const GetOutput = `
+{
+    "id": "af91edb5-ede8-453f-af13-feabdd088f9c",
+    "hostname": "dns-1",
+    "service_name": "central",
+    "status": "UP",
+    "stats": {},
+    "capabilities": {},
+    "heartbeated_at": "2023-04-12T11:08:58.000000",
+    "created_at": "2023-04-12T08:38:58.000000",
+    "updated_at": "2023-04-12T11:08:58.000000",
+    "links": {
+        "self": "https://127.0.0.1:9001/v2/service_statuses/af91edb5-ede8-453f-af13-feabdd088f9c"
+    }
+}
+`
+
+var createdAt, _ = time.Parse(gophercloud.RFC3339MilliNoZ, "2023-04-12T08:38:58.000000")
+
+// FirstServiceStatus is the first result in ListOutput
+var FirstServiceStatusHeartbeatedAt, _ = time.Parse(gophercloud.RFC3339MilliNoZ, "2023-04-12T11:08:58.000000")
+var FirstServiceStatus = servicestatuses.ServiceStatus{
+	ID:            "af91edb5-ede8-453f-af13-feabdd088f9c",
+	Hostname:      "dns-1",
+	ServiceName:   "central",
+	Status:        "UP",
+	Stats:         map[string]interface{}{},
+	Capabilities:  map[string]interface{}{},
+	HeartbeatedAt: FirstServiceStatusHeartbeatedAt,
+	CreatedAt:     createdAt,
+	UpdatedAt:     FirstServiceStatusHeartbeatedAt,
+	Links: map[string]interface{}{
+		"self": "https://127.0.0.1:9001/v2/service_statuses/af91edb5-ede8-453f-af13-feabdd088f9c",
+	},
+}
+
+// SecondServiceStatus is the second result in ListOutput
+var SecondServiceStatusHeartbeatedAt, _ = time.Parse(gophercloud.RFC3339MilliNoZ, "2023-04-12T10:38:58.000000")
+var SecondServiceStatus = servicestatuses.ServiceStatus{
+	ID:            "3d1c4a64-a7d9-4dd6-9b9f-a3e1ad0e8a4b",
+	Hostname:      "dns-1",
+	ServiceName:   "worker",
+	Status:        "DOWN",
+	Stats:         map[string]interface{}{},
+	Capabilities:  map[string]interface{}{},
+	HeartbeatedAt: SecondServiceStatusHeartbeatedAt,
+	CreatedAt:     createdAt,
+	UpdatedAt:     SecondServiceStatusHeartbeatedAt,
+	Links: map[string]interface{}{
+		"self": "https://127.0.0.1:9001/v2/service_statuses/3d1c4a64-a7d9-4dd6-9b9f-a3e1ad0e8a4b",
+	},
+}
+
+// ExpectedServiceStatusesSlice is the slice of results that should be parsed
+// from ListOutput, in the expected order.
+var ExpectedServiceStatusesSlice = []servicestatuses.ServiceStatus{FirstServiceStatus, SecondServiceStatus}
+
+// HandleListSuccessfully configures the test server to respond to a List request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/service_statuses",
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, ListOutput)
+		})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/service_statuses/"+FirstServiceStatus.ID,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, GetOutput)
+		})
+}
@@ -0,0 +1,47 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/servicestatuses"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	count := 0
+	err := servicestatuses.List(client.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := servicestatuses.ExtractServiceStatuses(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, ExpectedServiceStatusesSlice, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestListOpts(t *testing.T) {
+	listOpts := servicestatuses.ListOpts{
+		ServiceName: "worker",
+		Status:      "DOWN",
+	}
+	query, err := listOpts.ToServiceStatusListQuery()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "?service_name=worker&status=DOWN", query)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := servicestatuses.Get(client.ServiceClient(), FirstServiceStatus.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstServiceStatus, actual)
+}
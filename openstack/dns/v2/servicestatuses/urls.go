@@ -0,0 +1,13 @@
+package servicestatuses
+
+import "github.com/gophercloud/gophercloud"
+
+const resourcePath = "service_statuses"
+
+func baseURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, serviceStatusID string) string {
+	return c.ServiceURL(resourcePath, serviceStatusID)
+}
@@ -0,0 +1,39 @@
+/*
+Package sharedzones provides the ability to share a zone of the OpenStack DNS
+service with other projects.
+
+Example to Share a Zone
+
+	createOpts := sharedzones.CreateOpts{
+		TargetProjectID: "05d98711-b3a1-4264-a395-f46383671ee6",
+	}
+
+	share, err := sharedzones.Create(dnsClient, "a6a8515c-5d80-48c0-955b-fde631b59791", createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List the Shares of a Zone
+
+	allPages, err := sharedzones.List(dnsClient, "a6a8515c-5d80-48c0-955b-fde631b59791", nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allShares, err := sharedzones.ExtractSharedZones(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, share := range allShares {
+		fmt.Printf("%+v\n", share)
+	}
+
+Example to Stop Sharing a Zone
+
+	err := sharedzones.Delete(dnsClient, "a6a8515c-5d80-48c0-955b-fde631b59791", "fd40b017-bf4a-4a47-9fd1-b2d5d86d6a7a").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package sharedzones
@@ -0,0 +1,94 @@
+package sharedzones
+
+import (
+	"net/http"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add parameters to the List request.
+type ListOptsBuilder interface {
+	ToSharedZoneListQuery() (string, error)
+}
+
+// ListOpts allows the filtering of paginated collections through the API.
+// Marker and Limit are used for pagination.
+// https://developer.openstack.org/api-ref/dns/
+type ListOpts struct {
+	// Integer value for the limit of values to return.
+	Limit int `q:"limit"`
+
+	// UUID of the share at which you want to set a marker.
+	Marker string `q:"marker"`
+
+	TargetProjectID string `q:"target_project_id"`
+}
+
+// ToSharedZoneListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToSharedZoneListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns the shares of a zone.
+func List(client *gophercloud.ServiceClient, zoneID string, opts ListOptsBuilder) pagination.Pager {
+	url := baseURL(client, zoneID)
+	if opts != nil {
+		query, err := opts.ToSharedZoneListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return SharedZonePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get returns information about a share of a zone.
+func Get(client *gophercloud.ServiceClient, zoneID, shareID string) (r GetResult) {
+	resp, err := client.Get(resourceURL(client, zoneID, shareID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional attributes to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToSharedZoneCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies the project a zone is shared with.
+type CreateOpts struct {
+	// TargetProjectID is the ID of the project the zone is shared with.
+	TargetProjectID string `json:"target_project_id" required:"true"`
+}
+
+// ToSharedZoneCreateMap formats an CreateOpts structure into a request body.
+func (opts CreateOpts) ToSharedZoneCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create shares a zone with another project.
+func Create(client *gophercloud.ServiceClient, zoneID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToSharedZoneCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(baseURL(client, zoneID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{http.StatusCreated},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete stops sharing a zone with a project.
+func Delete(client *gophercloud.ServiceClient, zoneID, shareID string) (r DeleteResult) {
+	resp, err := client.Delete(resourceURL(client, zoneID, shareID), &gophercloud.RequestOpts{
+		OkCodes: []int{http.StatusNoContent},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
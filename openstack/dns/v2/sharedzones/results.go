@@ -0,0 +1,107 @@
+package sharedzones
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult or CreateResult as a SharedZone.
+// An error is returned if the original call or the extraction failed.
+func (r commonResult) Extract() (*SharedZone, error) {
+	var s *SharedZone
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// CreateResult is the result of a Create request. Call its Extract method
+// to interpret the result as a SharedZone.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult is the result of a Get request. Call its Extract method
+// to interpret the result as a SharedZone.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult is the result of a Delete request. Call its ExtractErr method
+// to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// SharedZonePage is a single page of SharedZone results.
+type SharedZonePage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if the page contains no results.
+func (r SharedZonePage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	s, err := ExtractSharedZones(r)
+	return len(s) == 0, err
+}
+
+// ExtractSharedZones extracts a slice of SharedZones from a List result.
+func ExtractSharedZones(r pagination.Page) ([]SharedZone, error) {
+	var s struct {
+		SharedZones []SharedZone `json:"shared_zones"`
+	}
+	err := (r.(SharedZonePage)).ExtractInto(&s)
+	return s.SharedZones, err
+}
+
+// SharedZone represents the share of a zone with another project, which can
+// then manage the recordsets of the zone.
+type SharedZone struct {
+	// ID uniquely identifies this share amongst all other shares.
+	ID string `json:"id"`
+
+	// ZoneID is the ID of the shared zone.
+	ZoneID string `json:"zone_id"`
+
+	// ProjectID is the ID of the project that owns the zone.
+	ProjectID string `json:"project_id"`
+
+	// TargetProjectID is the ID of the project the zone is shared with.
+	TargetProjectID string `json:"target_project_id"`
+
+	// CreatedAt is the date when the share was created.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the date when the last change was made to the share.
+	UpdatedAt time.Time `json:"-"`
+
+	// Links includes HTTP references to the itself.
+	Links map[string]interface{} `json:"links"`
+}
+
+func (r *SharedZone) UnmarshalJSON(b []byte) error {
+	type tmp SharedZone
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = SharedZone(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return err
+}
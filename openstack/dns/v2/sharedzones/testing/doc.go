@@ -0,0 +1,2 @@
+// sharedzones unit tests
+package testing
@@ -0,0 +1,153 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/sharedzones"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ZoneID is the zone used in the tests.
+const ZoneID = "a6a8515c-5d80-48c0-955b-fde631b59791"
+
+// ListOutput is a sample response to a List call.
+const ListOutput = `
+{
+    "shared_zones": [
+        {
+            "id": "fd40b017-bf4a-4a47-9fd1-b2d5d86d6a7a",
+            "zone_id": "a6a8515c-5d80-48c0-955b-fde631b59791",
+            "project_id": "4335d1f0-f793-11e2-b778-0800200c9a66",
+            "target_project_id": "05d98711-b3a1-4264-a395-f46383671ee6",
+            "created_at": "2023-04-12T08:38:58.000000",
+            "updated_at": null,
+            "links": {
+                "self": "https://127.0.0.1:9001/v2/zones/a6a8515c-5d80-48c0-955b-fde631b59791/shares/fd40b017-bf4a-4a47-9fd1-b2d5d86d6a7a",
+                "zone": "https://127.0.0.1:9001/v2/zones/a6a8515c-5d80-48c0-955b-fde631b59791"
+            }
+        },
+        {
+            "id": "2ea1c6b9-3e3d-4a4f-8c4e-9e1a95a6f0c7",
+            "zone_id": "a6a8515c-5d80-48c0-955b-fde631b59791",
+            "project_id": "4335d1f0-f793-11e2-b778-0800200c9a66",
+            "target_project_id": "7f1c4b2e-2a1d-4b8e-9b1f-1f0f2c7e3d5a",
+            "created_at": "2023-04-12T09:38:58.000000",
+            "updated_at": null,
+            "links": {
+                "self": "https://127.0.0.1:9001/v2/zones/a6a8515c-5d80-48c0-955b-fde631b59791/shares/2ea1c6b9-3e3d-4a4f-8c4e-9e1a95a6f0c7",
+                "zone": "https://127.0.0.1:9001/v2/zones/a6a8515c-5d80-48c0-955b-fde631b59791"
+            }
+        }
+    ],
+    "links": {
+        "self": "https://127.0.0.1:9001/v2/zones/a6a8515c-5d80-48c0-955b-fde631b59791/shares"
+    }
+}
+`
+
+// GetOutput is a sample response to a Get call.
+const GetOutput = `
+{
+    "id": "fd40b017-bf4a-4a47-9fd1-b2d5d86d6a7a",
+    "zone_id": "a6a8515c-5d80-48c0-955b-fde631b59791",
+    "project_id": "4335d1f0-f793-11e2-b778-0800200c9a66",
+    "target_project_id": "05d98711-b3a1-4264-a395-f46383671ee6",
+    "created_at": "2023-04-12T08:38:58.000000",
+    "updated_at": null,
+    "links": {
+        "self": "https://127.0.0.1:9001/v2/zones/a6a8515c-5d80-48c0-955b-fde631b59791/shares/fd40b017-bf4a-4a47-9fd1-b2d5d86d6a7a",
+        "zone": "https://127.0.0.1:9001/v2/zones/a6a8515c-5d80-48c0-955b-fde631b59791"
+    }
+}
+`
+
+// FirstSharedZone is the first result in ListOutput
+var FirstSharedZoneCreatedAt, _ = time.Parse(gophercloud.RFC3339MilliNoZ, "2023-04-12T08:38:58.000000")
+var FirstSharedZone = sharedzones.SharedZone{
+	ID:              "fd40b017-bf4a-4a47-9fd1-b2d5d86d6a7a",
+	ZoneID:          ZoneID,
+	ProjectID:       "4335d1f0-f793-11e2-b778-0800200c9a66",
+	TargetProjectID: "05d98711-b3a1-4264-a395-f46383671ee6",
+	CreatedAt:       FirstSharedZoneCreatedAt,
+	Links: map[string]interface{}{
+		"self": "https://127.0.0.1:9001/v2/zones/a6a8515c-5d80-48c0-955b-fde631b59791/shares/fd40b017-bf4a-4a47-9fd1-b2d5d86d6a7a",
+		"zone": "https://127.0.0.1:9001/v2/zones/a6a8515c-5d80-48c0-955b-fde631b59791",
+	},
+}
+
+// SecondSharedZone is the second result in ListOutput
+var SecondSharedZoneCreatedAt, _ = time.Parse(gophercloud.RFC3339MilliNoZ, "2023-04-12T09:38:58.000000")
+var SecondSharedZone = sharedzones.SharedZone{
+	ID:              "2ea1c6b9-3e3d-4a4f-8c4e-9e1a95a6f0c7",
+	ZoneID:          ZoneID,
+	ProjectID:       "4335d1f0-f793-11e2-b778-0800200c9a66",
+	TargetProjectID: "7f1c4b2e-2a1d-4b8e-9b1f-1f0f2c7e3d5a",
+	CreatedAt:       SecondSharedZoneCreatedAt,
+	Links: map[string]interface{}{
+		"self": "https://127.0.0.1:9001/v2/zones/a6a8515c-5d80-48c0-955b-fde631b59791/shares/2ea1c6b9-3e3d-4a4f-8c4e-9e1a95a6f0c7",
+		"zone": "https://127.0.0.1:9001/v2/zones/a6a8515c-5d80-48c0-955b-fde631b59791",
+	},
+}
+
+// ExpectedSharedZonesSlice is the slice of results that should be parsed
+// from ListOutput, in the expected order.
+var ExpectedSharedZonesSlice = []sharedzones.SharedZone{FirstSharedZone, SecondSharedZone}
+
+// HandleListSuccessfully configures the test server to respond to a List request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/zones/"+ZoneID+"/shares",
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, ListOutput)
+		})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/zones/"+ZoneID+"/shares/"+FirstSharedZone.ID,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, GetOutput)
+		})
+}
+
+// CreateSharedZoneRequest is a sample request to share a zone.
+const CreateSharedZoneRequest = `
+{
+    "target_project_id": "05d98711-b3a1-4264-a395-f46383671ee6"
+}
+`
+
+// HandleCreateSuccessfully configures the test server to respond to a Create request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/zones/"+ZoneID+"/shares",
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "POST")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			th.TestJSONRequest(t, r, CreateSharedZoneRequest)
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, GetOutput)
+		})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a Delete request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/zones/"+ZoneID+"/shares/"+FirstSharedZone.ID,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "DELETE")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+}
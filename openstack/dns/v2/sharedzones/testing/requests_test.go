@@ -0,0 +1,60 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/sharedzones"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	count := 0
+	err := sharedzones.List(client.ServiceClient(), ZoneID, nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := sharedzones.ExtractSharedZones(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, ExpectedSharedZonesSlice, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := sharedzones.Get(client.ServiceClient(), ZoneID, FirstSharedZone.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstSharedZone, actual)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := sharedzones.CreateOpts{
+		TargetProjectID: "05d98711-b3a1-4264-a395-f46383671ee6",
+	}
+
+	actual, err := sharedzones.Create(client.ServiceClient(), ZoneID, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstSharedZone, actual)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	err := sharedzones.Delete(client.ServiceClient(), ZoneID, FirstSharedZone.ID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
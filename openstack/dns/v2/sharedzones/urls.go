@@ -0,0 +1,16 @@
+package sharedzones
+
+import "github.com/gophercloud/gophercloud"
+
+const (
+	rootPath     = "zones"
+	resourcePath = "shares"
+)
+
+func baseURL(c *gophercloud.ServiceClient, zoneID string) string {
+	return c.ServiceURL(rootPath, zoneID, resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, zoneID, shareID string) string {
+	return c.ServiceURL(rootPath, zoneID, resourcePath, shareID)
+}
@@ -0,0 +1,53 @@
+/*
+Package tlds provides information and interaction with the TLD API resource
+for the OpenStack DNS service. When TLDs are defined, zones can only be
+created under one of them.
+
+Example to List TLDs
+
+	allPages, err := tlds.List(dnsClient, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allTLDs, err := tlds.ExtractTLDs(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, tld := range allTLDs {
+		fmt.Printf("%+v\n", tld)
+	}
+
+Example to Create a TLD
+
+	createOpts := tlds.CreateOpts{
+		Name:        "com",
+		Description: "Commercial TLD",
+	}
+
+	tld, err := tlds.Create(dnsClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a TLD
+
+	description := "Commercial top level domain"
+	updateOpts := tlds.UpdateOpts{
+		Description: &description,
+	}
+
+	tld, err := tlds.Update(dnsClient, "5fc0e7e4-5ef5-4d5f-a1c0-6a2b1ee7f10d", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a TLD
+
+	err := tlds.Delete(dnsClient, "5fc0e7e4-5ef5-4d5f-a1c0-6a2b1ee7f10d").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package tlds
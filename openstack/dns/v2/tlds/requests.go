@@ -0,0 +1,136 @@
+package tlds
+
+import (
+	"net/http"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add parameters to the List request.
+type ListOptsBuilder interface {
+	ToTLDListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the TLD attributes you want to see returned. Marker and Limit are used
+// for pagination.
+// https://developer.openstack.org/api-ref/dns/
+type ListOpts struct {
+	// Integer value for the limit of values to return.
+	Limit int `q:"limit"`
+
+	// UUID of the TLD at which you want to set a marker.
+	Marker string `q:"marker"`
+
+	Name        string `q:"name"`
+	Description string `q:"description"`
+	SortDir     string `q:"sort_dir"`
+	SortKey     string `q:"sort_key"`
+}
+
+// ToTLDListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToTLDListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List implements a TLD List request.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := baseURL(client)
+	if opts != nil {
+		query, err := opts.ToTLDListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return TLDPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get returns information about a TLD, given its ID.
+func Get(client *gophercloud.ServiceClient, tldID string) (r GetResult) {
+	resp, err := client.Get(resourceURL(client, tldID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional attributes to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToTLDCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies the attributes used to create a TLD.
+type CreateOpts struct {
+	// Name of the TLD, such as "com".
+	Name string `json:"name" required:"true"`
+
+	// Description of the TLD.
+	Description string `json:"description,omitempty"`
+}
+
+// ToTLDCreateMap formats an CreateOpts structure into a request body.
+func (opts CreateOpts) ToTLDCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create implements a TLD create request.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToTLDCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(baseURL(client), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{http.StatusCreated},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional attributes to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToTLDUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies the attributes to update a TLD.
+type UpdateOpts struct {
+	// Name of the TLD.
+	Name string `json:"name,omitempty"`
+
+	// Description of the TLD.
+	Description *string `json:"description,omitempty"`
+}
+
+// ToTLDUpdateMap formats an UpdateOpts structure into a request body.
+func (opts UpdateOpts) ToTLDUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update implements a TLD update request.
+func Update(client *gophercloud.ServiceClient, tldID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToTLDUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Patch(resourceURL(client, tldID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{http.StatusOK},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete implements a TLD delete request.
+func Delete(client *gophercloud.ServiceClient, tldID string) (r DeleteResult) {
+	resp, err := client.Delete(resourceURL(client, tldID), &gophercloud.RequestOpts{
+		OkCodes: []int{http.StatusNoContent},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,111 @@
+package tlds
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult, CreateResult or UpdateResult as a TLD.
+// An error is returned if the original call or the extraction failed.
+func (r commonResult) Extract() (*TLD, error) {
+	var s *TLD
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// CreateResult is the result of a Create request. Call its Extract method
+// to interpret the result as a TLD.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult is the result of a Get request. Call its Extract method
+// to interpret the result as a TLD.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult is the result of an Update request. Call its Extract method
+// to interpret the result as a TLD.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the result of a Delete request. Call its ExtractErr method
+// to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// TLDPage is a single page of TLD results.
+type TLDPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if the page contains no results.
+func (r TLDPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	s, err := ExtractTLDs(r)
+	return len(s) == 0, err
+}
+
+// ExtractTLDs extracts a slice of TLDs from a List result.
+func ExtractTLDs(r pagination.Page) ([]TLD, error) {
+	var s struct {
+		TLDs []TLD `json:"tlds"`
+	}
+	err := (r.(TLDPage)).ExtractInto(&s)
+	return s.TLDs, err
+}
+
+// TLD represents a top level domain under which the zones of the projects
+// can be created.
+type TLD struct {
+	// ID uniquely identifies this TLD amongst all other TLDs.
+	ID string `json:"id"`
+
+	// Name is the name of the TLD.
+	Name string `json:"name"`
+
+	// Description for this TLD.
+	Description string `json:"description"`
+
+	// CreatedAt is the date when the TLD was created.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the date when the last change was made to the TLD.
+	UpdatedAt time.Time `json:"-"`
+
+	// Links includes HTTP references to the itself, useful for passing along
+	// to other APIs that might want a TLD reference.
+	Links map[string]interface{} `json:"links"`
+}
+
+func (r *TLD) UnmarshalJSON(b []byte) error {
+	type tmp TLD
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = TLD(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return err
+}
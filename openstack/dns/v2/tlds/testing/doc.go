@@ -0,0 +1,2 @@
+// tlds unit tests
+package testing
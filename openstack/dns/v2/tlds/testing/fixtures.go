@@ -0,0 +1,181 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/tlds"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput is a sample response to a List call.
+const ListOutput = `
+{
+    "tlds": [
+        {
+            "id": "5fc0e7e4-5ef5-4d5f-a1c0-6a2b1ee7f10d",
+            "name": "com",
+            "description": "Commercial TLD",
+            "created_at": "2023-04-12T08:38:58.000000",
+            "updated_at": null,
+            "links": {
+                "self": "https://127.0.0.1:9001/v2/tlds/5fc0e7e4-5ef5-4d5f-a1c0-6a2b1ee7f10d"
+            }
+        },
+        {
+            "id": "b8b8f5a2-7a68-4f7c-9d39-1e4a2e3b7e44",
+            "name": "org",
+            "description": "Organization TLD",
+            "created_at": "2023-04-12T09:38:58.000000",
+            "updated_at": "2023-04-12T10:38:58.000000",
+            "links": {
+                "self": "https://127.0.0.1:9001/v2/tlds/b8b8f5a2-7a68-4f7c-9d39-1e4a2e3b7e44"
+            }
+        }
+    ],
+    "links": {
+        "self": "https://127.0.0.1:9001/v2/tlds"
+    },
+    "metadata": {
+        "total_count": 2
+    }
+}
+`
+
+// GetOutput is a sample response to a Get call.
+const GetOutput = `
+{
+    "id": "5fc0e7e4-5ef5-4d5f-a1c0-6a2b1ee7f10d",
+    "name": "com",
+    "description": "Commercial TLD",
+    "created_at": "2023-04-12T08:38:58.000000",
+    "updated_at": null,
+    "links": {
+        "self": "https://127.0.0.1:9001/v2/tlds/5fc0e7e4-5ef5-4d5f-a1c0-6a2b1ee7f10d"
+    }
+}
+`
+
+// FirstTLD is the first result in ListOutput
+var FirstTLDCreatedAt, _ = time.Parse(gophercloud.RFC3339MilliNoZ, "2023-04-12T08:38:58.000000")
+var FirstTLD = tlds.TLD{
+	ID:          "5fc0e7e4-5ef5-4d5f-a1c0-6a2b1ee7f10d",
+	Name:        "com",
+	Description: "Commercial TLD",
+	CreatedAt:   FirstTLDCreatedAt,
+	Links: map[string]interface{}{
+		"self": "https://127.0.0.1:9001/v2/tlds/5fc0e7e4-5ef5-4d5f-a1c0-6a2b1ee7f10d",
+	},
+}
+
+// SecondTLD is the second result in ListOutput
+var SecondTLDCreatedAt, _ = time.Parse(gophercloud.RFC3339MilliNoZ, "2023-04-12T09:38:58.000000")
+var SecondTLDUpdatedAt, _ = time.Parse(gophercloud.RFC3339MilliNoZ, "2023-04-12T10:38:58.000000")
+var SecondTLD = tlds.TLD{
+	ID:          "b8b8f5a2-7a68-4f7c-9d39-1e4a2e3b7e44",
+	Name:        "org",
+	Description: "Organization TLD",
+	CreatedAt:   SecondTLDCreatedAt,
+	UpdatedAt:   SecondTLDUpdatedAt,
+	Links: map[string]interface{}{
+		"self": "https://127.0.0.1:9001/v2/tlds/b8b8f5a2-7a68-4f7c-9d39-1e4a2e3b7e44",
+	},
+}
+
+// ExpectedTLDsSlice is the slice of results that should be parsed
+// from ListOutput, in the expected order.
+var ExpectedTLDsSlice = []tlds.TLD{FirstTLD, SecondTLD}
+
+// HandleListSuccessfully configures the test server to respond to a List request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/tlds",
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, ListOutput)
+		})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/tlds/"+FirstTLD.ID,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, GetOutput)
+		})
+}
+
+// CreateTLDRequest is a sample request to create a TLD.
+const CreateTLDRequest = `
+{
+    "name": "com",
+    "description": "Commercial TLD"
+}
+`
+
+// HandleCreateSuccessfully configures the test server to respond to a Create request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/tlds",
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "POST")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			th.TestJSONRequest(t, r, CreateTLDRequest)
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, GetOutput)
+		})
+}
+
+// UpdateTLDRequest is a sample request to update a TLD.
+const UpdateTLDRequest = `
+{
+    "description": "Updated Description"
+}
+`
+
+// UpdatedTLDResponse is a sample response to update a TLD.
+const UpdatedTLDResponse = `
+{
+    "id": "5fc0e7e4-5ef5-4d5f-a1c0-6a2b1ee7f10d",
+    "name": "com",
+    "description": "Updated Description",
+    "created_at": "2023-04-12T08:38:58.000000",
+    "updated_at": "2023-04-12T11:38:58.000000",
+    "links": {
+        "self": "https://127.0.0.1:9001/v2/tlds/5fc0e7e4-5ef5-4d5f-a1c0-6a2b1ee7f10d"
+    }
+}
+`
+
+// HandleUpdateSuccessfully configures the test server to respond to an Update request.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/tlds/"+FirstTLD.ID,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "PATCH")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			th.TestJSONRequest(t, r, UpdateTLDRequest)
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, UpdatedTLDResponse)
+		})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a Delete request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/tlds/"+FirstTLD.ID,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "DELETE")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+}
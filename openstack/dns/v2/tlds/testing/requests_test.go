@@ -0,0 +1,102 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/tlds"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	count := 0
+	err := tlds.List(client.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := tlds.ExtractTLDs(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, ExpectedTLDsSlice, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestListOpts(t *testing.T) {
+	listOpts := tlds.ListOpts{
+		Name:    "com",
+		Limit:   10,
+		SortKey: "name",
+		SortDir: "asc",
+	}
+	query, err := listOpts.ToTLDListQuery()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "?limit=10&name=com&sort_dir=asc&sort_key=name", query)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := tlds.Get(client.ServiceClient(), FirstTLD.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstTLD, actual)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := tlds.CreateOpts{
+		Name:        "com",
+		Description: "Commercial TLD",
+	}
+
+	actual, err := tlds.Create(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstTLD, actual)
+}
+
+func TestCreateMissingName(t *testing.T) {
+	res := tlds.Create(client.ServiceClient(), tlds.CreateOpts{})
+	if res.Err == nil {
+		t.Fatal("Expected error when the name is missing")
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	description := "Updated Description"
+	updateOpts := tlds.UpdateOpts{
+		Description: &description,
+	}
+
+	updatedAt, _ := time.Parse(gophercloud.RFC3339MilliNoZ, "2023-04-12T11:38:58.000000")
+	expected := FirstTLD
+	expected.Description = description
+	expected.UpdatedAt = updatedAt
+
+	actual, err := tlds.Update(client.ServiceClient(), FirstTLD.ID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &expected, actual)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	err := tlds.Delete(client.ServiceClient(), FirstTLD.ID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,13 @@
+package tlds
+
+import "github.com/gophercloud/gophercloud"
+
+const resourcePath = "tlds"
+
+func baseURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, tldID string) string {
+	return c.ServiceURL(resourcePath, tldID)
+}
@@ -0,0 +1,59 @@
+/*
+Package tsigkeys provides information and interaction with the TSIG key API
+resource for the OpenStack DNS service. TSIG keys authenticate the zone
+transfers of a pool or of a single zone.
+
+Example to List TSIG Keys
+
+	listOpts := tsigkeys.ListOpts{
+		Scope: tsigkeys.ScopePool,
+	}
+
+	allPages, err := tsigkeys.List(dnsClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allKeys, err := tsigkeys.ExtractTSIGKeys(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, key := range allKeys {
+		fmt.Printf("%+v\n", key)
+	}
+
+Example to Create a TSIG Key
+
+	createOpts := tsigkeys.CreateOpts{
+		Name:       "transfer-key",
+		Algorithm:  "hmac-sha256",
+		Secret:     "SomeSecretKey",
+		Scope:      tsigkeys.ScopePool,
+		ResourceID: "794ccc2c-d751-44fe-b57f-8894c9f5c842",
+	}
+
+	key, err := tsigkeys.Create(dnsClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a TSIG Key
+
+	updateOpts := tsigkeys.UpdateOpts{
+		Secret: "AnotherSecretKey",
+	}
+
+	key, err := tsigkeys.Update(dnsClient, "8add45a8-5c23-4b6a-9a5d-d8e1d4b7f9ea", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a TSIG Key
+
+	err := tsigkeys.Delete(dnsClient, "8add45a8-5c23-4b6a-9a5d-d8e1d4b7f9ea").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package tsigkeys
@@ -0,0 +1,154 @@
+package tsigkeys
+
+import (
+	"net/http"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Scope is the kind of resource a TSIG key is attached to.
+type Scope string
+
+const (
+	ScopePool Scope = "POOL"
+	ScopeZone Scope = "ZONE"
+)
+
+// ListOptsBuilder allows extensions to add parameters to the List request.
+type ListOptsBuilder interface {
+	ToTSIGKeyListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the TSIG key attributes you want to see returned. Marker and Limit are used
+// for pagination.
+// https://developer.openstack.org/api-ref/dns/
+type ListOpts struct {
+	// Integer value for the limit of values to return.
+	Limit int `q:"limit"`
+
+	// UUID of the TSIG key at which you want to set a marker.
+	Marker string `q:"marker"`
+
+	Name      string `q:"name"`
+	Algorithm string `q:"algorithm"`
+	Scope     Scope  `q:"scope"`
+	SortDir   string `q:"sort_dir"`
+	SortKey   string `q:"sort_key"`
+}
+
+// ToTSIGKeyListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToTSIGKeyListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List implements a TSIG key List request.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := baseURL(client)
+	if opts != nil {
+		query, err := opts.ToTSIGKeyListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return TSIGKeyPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get returns information about a TSIG key, given its ID.
+func Get(client *gophercloud.ServiceClient, tsigkeyID string) (r GetResult) {
+	resp, err := client.Get(resourceURL(client, tsigkeyID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional attributes to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToTSIGKeyCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies the attributes used to create a TSIG key.
+type CreateOpts struct {
+	// Name of the TSIG key.
+	Name string `json:"name" required:"true"`
+
+	// Algorithm of the TSIG key, such as "hmac-sha256".
+	Algorithm string `json:"algorithm" required:"true"`
+
+	// Secret is the base64 encoded shared secret.
+	Secret string `json:"secret" required:"true"`
+
+	// Scope tells whether ResourceID is a pool or a zone.
+	Scope Scope `json:"scope" required:"true"`
+
+	// ResourceID is the ID of the pool or zone the key is attached to.
+	ResourceID string `json:"resource_id" required:"true"`
+}
+
+// ToTSIGKeyCreateMap formats an CreateOpts structure into a request body.
+func (opts CreateOpts) ToTSIGKeyCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create implements a TSIG key create request.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToTSIGKeyCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(baseURL(client), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{http.StatusCreated},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional attributes to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToTSIGKeyUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies the attributes to update a TSIG key.
+type UpdateOpts struct {
+	Name       string `json:"name,omitempty"`
+	Algorithm  string `json:"algorithm,omitempty"`
+	Secret     string `json:"secret,omitempty"`
+	Scope      Scope  `json:"scope,omitempty"`
+	ResourceID string `json:"resource_id,omitempty"`
+}
+
+// ToTSIGKeyUpdateMap formats an UpdateOpts structure into a request body.
+func (opts UpdateOpts) ToTSIGKeyUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update implements a TSIG key update request.
+func Update(client *gophercloud.ServiceClient, tsigkeyID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToTSIGKeyUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Patch(resourceURL(client, tsigkeyID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{http.StatusOK},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete implements a TSIG key delete request.
+func Delete(client *gophercloud.ServiceClient, tsigkeyID string) (r DeleteResult) {
+	resp, err := client.Delete(resourceURL(client, tsigkeyID), &gophercloud.RequestOpts{
+		OkCodes: []int{http.StatusNoContent},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
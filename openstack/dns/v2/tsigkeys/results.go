@@ -0,0 +1,120 @@
+package tsigkeys
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult, CreateResult or UpdateResult as a TSIGKey.
+// An error is returned if the original call or the extraction failed.
+func (r commonResult) Extract() (*TSIGKey, error) {
+	var s *TSIGKey
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// CreateResult is the result of a Create request. Call its Extract method
+// to interpret the result as a TSIGKey.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult is the result of a Get request. Call its Extract method
+// to interpret the result as a TSIGKey.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult is the result of an Update request. Call its Extract method
+// to interpret the result as a TSIGKey.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the result of a Delete request. Call its ExtractErr method
+// to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// TSIGKeyPage is a single page of TSIGKey results.
+type TSIGKeyPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if the page contains no results.
+func (r TSIGKeyPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	s, err := ExtractTSIGKeys(r)
+	return len(s) == 0, err
+}
+
+// ExtractTSIGKeys extracts a slice of TSIGKeys from a List result.
+func ExtractTSIGKeys(r pagination.Page) ([]TSIGKey, error) {
+	var s struct {
+		TSIGKeys []TSIGKey `json:"tsigkeys"`
+	}
+	err := (r.(TSIGKeyPage)).ExtractInto(&s)
+	return s.TSIGKeys, err
+}
+
+// TSIGKey represents a shared secret used to authenticate the zone transfers
+// between Designate and the DNS servers.
+type TSIGKey struct {
+	// ID uniquely identifies this key amongst all other TSIG keys.
+	ID string `json:"id"`
+
+	// Name is the name of the key.
+	Name string `json:"name"`
+
+	// Algorithm is the HMAC algorithm of the key.
+	Algorithm string `json:"algorithm"`
+
+	// Secret is the base64 encoded shared secret.
+	Secret string `json:"secret"`
+
+	// Scope tells whether ResourceID is a pool or a zone.
+	Scope Scope `json:"scope"`
+
+	// ResourceID is the ID of the pool or zone the key is attached to.
+	ResourceID string `json:"resource_id"`
+
+	// CreatedAt is the date when the key was created.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the date when the last change was made to the key.
+	UpdatedAt time.Time `json:"-"`
+
+	// Links includes HTTP references to the itself, useful for passing along
+	// to other APIs that might want a TSIG key reference.
+	Links map[string]interface{} `json:"links"`
+}
+
+func (r *TSIGKey) UnmarshalJSON(b []byte) error {
+	type tmp TSIGKey
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = TSIGKey(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return err
+}
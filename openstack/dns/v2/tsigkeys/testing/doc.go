@@ -0,0 +1,2 @@
+// tsigkeys unit tests
+package testing
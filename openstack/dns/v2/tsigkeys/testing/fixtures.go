@@ -0,0 +1,199 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/tsigkeys"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput is a sample response to a List call.
+const ListOutput = `
+{
+    "tsigkeys": [
+        {
+            "id": "8add45a8-5c23-4b6a-9a5d-d8e1d4b7f9ea",
+            "name": "transfer-key",
+            "algorithm": "hmac-sha256",
+            "secret": "SomeSecretKey",
+            "scope": "POOL",
+            "resource_id": "794ccc2c-d751-44fe-b57f-8894c9f5c842",
+            "created_at": "2023-04-12T08:38:58.000000",
+            "updated_at": null,
+            "links": {
+                "self": "https://127.0.0.1:9001/v2/tsigkeys/8add45a8-5c23-4b6a-9a5d-d8e1d4b7f9ea"
+            }
+        },
+        {
+            "id": "1c4f9f4a-3d1a-4b37-9e6b-8f8b49d5f2b0",
+            "name": "zone-key",
+            "algorithm": "hmac-sha512",
+            "secret": "AnotherSecretKey",
+            "scope": "ZONE",
+            "resource_id": "a6a8515c-5d80-48c0-955b-fde631b59791",
+            "created_at": "2023-04-12T09:38:58.000000",
+            "updated_at": "2023-04-12T10:38:58.000000",
+            "links": {
+                "self": "https://127.0.0.1:9001/v2/tsigkeys/1c4f9f4a-3d1a-4b37-9e6b-8f8b49d5f2b0"
+            }
+        }
+    ],
+    "links": {
+        "self": "https://127.0.0.1:9001/v2/tsigkeys"
+    }
+}
+`
+
+// GetOutput is a sample response to a Get call.
+const GetOutput = `
+{
+    "id": "8add45a8-5c23-4b6a-9a5d-d8e1d4b7f9ea",
+    "name": "transfer-key",
+    "algorithm": "hmac-sha256",
+    "secret": "SomeSecretKey",
+    "scope": "POOL",
+    "resource_id": "794ccc2c-d751-44fe-b57f-8894c9f5c842",
+    "created_at": "2023-04-12T08:38:58.000000",
+    "updated_at": null,
+    "links": {
+        "self": "https://127.0.0.1:9001/v2/tsigkeys/8add45a8-5c23-4b6a-9a5d-d8e1d4b7f9ea"
+    }
+}
+`
+
+// FirstTSIGKey is the first result in ListOutput
+var FirstTSIGKeyCreatedAt, _ = time.Parse(gophercloud.RFC3339MilliNoZ, "2023-04-12T08:38:58.000000")
+var FirstTSIGKey = tsigkeys.TSIGKey{
+	ID:         "8add45a8-5c23-4b6a-9a5d-d8e1d4b7f9ea",
+	Name:       "transfer-key",
+	Algorithm:  "hmac-sha256",
+	Secret:     "SomeSecretKey",
+	Scope:      tsigkeys.ScopePool,
+	ResourceID: "794ccc2c-d751-44fe-b57f-8894c9f5c842",
+	CreatedAt:  FirstTSIGKeyCreatedAt,
+	Links: map[string]interface{}{
+		"self": "https://127.0.0.1:9001/v2/tsigkeys/8add45a8-5c23-4b6a-9a5d-d8e1d4b7f9ea",
+	},
+}
+
+// SecondTSIGKey is the second result in ListOutput
+var SecondTSIGKeyCreatedAt, _ = time.Parse(gophercloud.RFC3339MilliNoZ, "2023-04-12T09:38:58.000000")
+var SecondTSIGKeyUpdatedAt, _ = time.Parse(gophercloud.RFC3339MilliNoZ, "2023-04-12T10:38:58.000000")
+var SecondTSIGKey = tsigkeys.TSIGKey{
+	ID:         "1c4f9f4a-3d1a-4b37-9e6b-8f8b49d5f2b0",
+	Name:       "zone-key",
+	Algorithm:  "hmac-sha512",
+	Secret:     "AnotherSecretKey",
+	Scope:      tsigkeys.ScopeZone,
+	ResourceID: "a6a8515c-5d80-48c0-955b-fde631b59791",
+	CreatedAt:  SecondTSIGKeyCreatedAt,
+	UpdatedAt:  SecondTSIGKeyUpdatedAt,
+	Links: map[string]interface{}{
+		"self": "https://127.0.0.1:9001/v2/tsigkeys/1c4f9f4a-3d1a-4b37-9e6b-8f8b49d5f2b0",
+	},
+}
+
+// ExpectedTSIGKeysSlice is the slice of results that should be parsed
+// from ListOutput, in the expected order.
+var ExpectedTSIGKeysSlice = []tsigkeys.TSIGKey{FirstTSIGKey, SecondTSIGKey}
+
+// HandleListSuccessfully configures the test server to respond to a List request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/tsigkeys",
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, ListOutput)
+		})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/tsigkeys/"+FirstTSIGKey.ID,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, GetOutput)
+		})
+}
+
+// CreateTSIGKeyRequest is a sample request to create a TSIG key.
+const CreateTSIGKeyRequest = `
+{
+    "name": "transfer-key",
+    "algorithm": "hmac-sha256",
+    "secret": "SomeSecretKey",
+    "scope": "POOL",
+    "resource_id": "794ccc2c-d751-44fe-b57f-8894c9f5c842"
+}
+`
+
+// HandleCreateSuccessfully configures the test server to respond to a Create request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/tsigkeys",
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "POST")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			th.TestJSONRequest(t, r, CreateTSIGKeyRequest)
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, GetOutput)
+		})
+}
+
+// UpdateTSIGKeyRequest is a sample request to update a TSIG key.
+const UpdateTSIGKeyRequest = `
+{
+    "secret": "RotatedSecretKey"
+}
+`
+
+// UpdatedTSIGKeyResponse is a sample response to update a TSIG key.
+const UpdatedTSIGKeyResponse = `
+{
+    "id": "8add45a8-5c23-4b6a-9a5d-d8e1d4b7f9ea",
+    "name": "transfer-key",
+    "algorithm": "hmac-sha256",
+    "secret": "RotatedSecretKey",
+    "scope": "POOL",
+    "resource_id": "794ccc2c-d751-44fe-b57f-8894c9f5c842",
+    "created_at": "2023-04-12T08:38:58.000000",
+    "updated_at": "2023-04-12T11:38:58.000000",
+    "links": {
+        "self": "https://127.0.0.1:9001/v2/tsigkeys/8add45a8-5c23-4b6a-9a5d-d8e1d4b7f9ea"
+    }
+}
+`
+
+// HandleUpdateSuccessfully configures the test server to respond to an Update request.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/tsigkeys/"+FirstTSIGKey.ID,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "PATCH")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			th.TestJSONRequest(t, r, UpdateTSIGKeyRequest)
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, UpdatedTSIGKeyResponse)
+		})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a Delete request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/tsigkeys/"+FirstTSIGKey.ID,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "DELETE")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+}
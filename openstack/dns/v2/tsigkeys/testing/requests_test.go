@@ -0,0 +1,85 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/tsigkeys"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	count := 0
+	err := tsigkeys.List(client.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := tsigkeys.ExtractTSIGKeys(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, ExpectedTSIGKeysSlice, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := tsigkeys.Get(client.ServiceClient(), FirstTSIGKey.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstTSIGKey, actual)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := tsigkeys.CreateOpts{
+		Name:       "transfer-key",
+		Algorithm:  "hmac-sha256",
+		Secret:     "SomeSecretKey",
+		Scope:      tsigkeys.ScopePool,
+		ResourceID: "794ccc2c-d751-44fe-b57f-8894c9f5c842",
+	}
+
+	actual, err := tsigkeys.Create(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstTSIGKey, actual)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	updateOpts := tsigkeys.UpdateOpts{
+		Secret: "RotatedSecretKey",
+	}
+
+	updatedAt, _ := time.Parse(gophercloud.RFC3339MilliNoZ, "2023-04-12T11:38:58.000000")
+	expected := FirstTSIGKey
+	expected.Secret = "RotatedSecretKey"
+	expected.UpdatedAt = updatedAt
+
+	actual, err := tsigkeys.Update(client.ServiceClient(), FirstTSIGKey.ID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &expected, actual)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	err := tsigkeys.Delete(client.ServiceClient(), FirstTSIGKey.ID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,13 @@
+package tsigkeys
+
+import "github.com/gophercloud/gophercloud"
+
+const resourcePath = "tsigkeys"
+
+func baseURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, tsigkeyID string) string {
+	return c.ServiceURL(resourcePath, tsigkeyID)
+}
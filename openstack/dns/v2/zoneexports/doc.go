@@ -0,0 +1,52 @@
+/*
+Package zoneexports provides the ability to export the zones of the OpenStack
+DNS service to zone files.
+
+Example to Export a Zone
+
+	zoneExport, err := zoneexports.Create(dnsClient, "6625198b-d67d-47dc-8d29-f90bd60f3ac4").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Download a Completed Export
+
+	zoneExport, err := zoneexports.Get(dnsClient, "8ec17fe1-d1f9-41b4-aa98-4eeb4c27b720").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	if zoneExport.Status == "COMPLETE" {
+		zoneFile, err := zoneexports.Download(dnsClient, zoneExport.ID).Extract()
+		if err != nil {
+			panic(err)
+		}
+		defer zoneFile.Close()
+
+		io.Copy(os.Stdout, zoneFile)
+	}
+
+Example to List Zone Exports
+
+	allPages, err := zoneexports.List(dnsClient, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allExports, err := zoneexports.ExtractZoneExports(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, zoneExport := range allExports {
+		fmt.Printf("%+v\n", zoneExport)
+	}
+
+Example to Delete a Zone Export
+
+	err := zoneexports.Delete(dnsClient, "8ec17fe1-d1f9-41b4-aa98-4eeb4c27b720").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package zoneexports
@@ -0,0 +1,82 @@
+package zoneexports
+
+import (
+	"net/http"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add parameters to the List request.
+type ListOptsBuilder interface {
+	ToZoneExportListQuery() (string, error)
+}
+
+// ListOpts allows the paging of zone export collections through the API.
+// Marker and Limit are used for pagination.
+// https://developer.openstack.org/api-ref/dns/
+type ListOpts struct {
+	// Integer value for the limit of values to return.
+	Limit int `q:"limit"`
+
+	// UUID of the zone export at which you want to set a marker.
+	Marker string `q:"marker"`
+}
+
+// ToZoneExportListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToZoneExportListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List implements a zone export List request.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := baseURL(client)
+	if opts != nil {
+		query, err := opts.ToZoneExportListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ZoneExportPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get returns information about a zone export, given its ID.
+func Get(client *gophercloud.ServiceClient, exportID string) (r GetResult) {
+	resp, err := client.Get(resourceURL(client, exportID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Create starts the export of a zone. The export runs asynchronously: poll
+// it with Get until its status is COMPLETE, then call Download.
+func Create(client *gophercloud.ServiceClient, zoneID string) (r CreateResult) {
+	resp, err := client.Post(createURL(client, zoneID), map[string]interface{}{}, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{http.StatusAccepted},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete removes a zone export.
+func Delete(client *gophercloud.ServiceClient, exportID string) (r DeleteResult) {
+	resp, err := client.Delete(resourceURL(client, exportID), &gophercloud.RequestOpts{
+		OkCodes: []int{http.StatusNoContent},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Download retrieves the zone file of a completed export.
+func Download(client *gophercloud.ServiceClient, exportID string) (r DownloadResult) {
+	resp, err := client.Get(downloadURL(client, exportID), nil, &gophercloud.RequestOpts{
+		MoreHeaders:      map[string]string{"Accept": "text/dns"},
+		OkCodes:          []int{http.StatusOK},
+		KeepResponseBody: true,
+	})
+	r.Body, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,132 @@
+package zoneexports
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult or CreateResult as a ZoneExport.
+// An error is returned if the original call or the extraction failed.
+func (r commonResult) Extract() (*ZoneExport, error) {
+	var s *ZoneExport
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// CreateResult is the result of a Create request. Call its Extract method
+// to interpret the result as a ZoneExport.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult is the result of a Get request. Call its Extract method
+// to interpret the result as a ZoneExport.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult is the result of a Delete request. Call its ExtractErr method
+// to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// DownloadResult is the result of a Download request. Call its Extract
+// method to read the zone file.
+type DownloadResult struct {
+	gophercloud.Result
+	Body io.ReadCloser
+}
+
+// Extract returns the zone file. The caller must close it.
+func (r DownloadResult) Extract() (io.ReadCloser, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	return r.Body, nil
+}
+
+// ZoneExportPage is a single page of ZoneExport results.
+type ZoneExportPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if the page contains no results.
+func (r ZoneExportPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	s, err := ExtractZoneExports(r)
+	return len(s) == 0, err
+}
+
+// ExtractZoneExports extracts a slice of ZoneExports from a List result.
+func ExtractZoneExports(r pagination.Page) ([]ZoneExport, error) {
+	var s struct {
+		ZoneExports []ZoneExport `json:"exports"`
+	}
+	err := (r.(ZoneExportPage)).ExtractInto(&s)
+	return s.ZoneExports, err
+}
+
+// ZoneExport represents the asynchronous export of a zone to a zone file.
+type ZoneExport struct {
+	// ID uniquely identifies this export amongst all other exports.
+	ID string `json:"id"`
+
+	// Status is the status of the export: PENDING, COMPLETE or ERROR.
+	Status string `json:"status"`
+
+	// Message describes the outcome of the export.
+	Message string `json:"message"`
+
+	// ZoneID is the ID of the exported zone.
+	ZoneID string `json:"zone_id"`
+
+	// ProjectID is the ID of the project that owns the export.
+	ProjectID string `json:"project_id"`
+
+	// Location is where the zone file can be retrieved once the export
+	// completed.
+	Location string `json:"location"`
+
+	// Version of the resource.
+	Version int `json:"version"`
+
+	// CreatedAt is the date when the export was created.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the date when the last change was made to the export.
+	UpdatedAt time.Time `json:"-"`
+
+	// Links includes HTTP references to the itself and to the zone file.
+	Links map[string]interface{} `json:"links"`
+}
+
+func (r *ZoneExport) UnmarshalJSON(b []byte) error {
+	type tmp ZoneExport
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = ZoneExport(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return err
+}
@@ -0,0 +1,2 @@
+// zoneexports unit tests
+package testing
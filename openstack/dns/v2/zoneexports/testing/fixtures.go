@@ -0,0 +1,199 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/zoneexports"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ZoneID is the exported zone used in the tests.
+const ZoneID = "6625198b-d67d-47dc-8d29-f90bd60f3ac4"
+
+// ListOutput is a sample response to a List call.
+const ListOutput = `
+{
+    "exports": [
+        {
+            "id": "8ec17fe1-d1f9-41b4-aa98-4eeb4c27b720",
+            "status": "COMPLETE",
+            "message": null,
+            "zone_id": "6625198b-d67d-47dc-8d29-f90bd60f3ac4",
+            "project_id": "4335d1f0-f793-11e2-b778-0800200c9a66",
+            "location": "designate://v2/zones/tasks/exports/8ec17fe1-d1f9-41b4-aa98-4eeb4c27b720/export",
+            "version": 2,
+            "created_at": "2023-04-12T08:38:58.000000",
+            "updated_at": "2023-04-12T08:39:01.000000",
+            "links": {
+                "self": "https://127.0.0.1:9001/v2/zones/tasks/exports/8ec17fe1-d1f9-41b4-aa98-4eeb4c27b720",
+                "export": "https://127.0.0.1:9001/v2/zones/tasks/exports/8ec17fe1-d1f9-41b4-aa98-4eeb4c27b720/export"
+            }
+        },
+        {
+            "id": "1f9c3c6b-1f7e-4c0e-8e3b-6a8b2c5e7d9f",
+            "status": "PENDING",
+            "message": null,
+            "zone_id": "6625198b-d67d-47dc-8d29-f90bd60f3ac4",
+            "project_id": "4335d1f0-f793-11e2-b778-0800200c9a66",
+            "location": null,
+            "version": 1,
+            "created_at": "2023-04-12T09:38:58.000000",
+            "updated_at": null,
+            "links": {
+                "self": "https://127.0.0.1:9001/v2/zones/tasks/exports/1f9c3c6b-1f7e-4c0e-8e3b-6a8b2c5e7d9f"
+            }
+        }
+    ],
+    "links": {
+        "self": "https://127.0.0.1:9001/v2/zones/tasks/exports"
+    }
+}
+`
+
+// GetOutput is a sample response to a Get call.
+const GetOutput = `
+{
+    "id": "8ec17fe1-d1f9-41b4-aa98-4eeb4c27b720",
+    "status": "COMPLETE",
+    "message": null,
+    "zone_id": "6625198b-d67d-47dc-8d29-f90bd60f3ac4",
+    "project_id": "4335d1f0-f793-11e2-b778-0800200c9a66",
+    "location": "designate://v2/zones/tasks/exports/8ec17fe1-d1f9-41b4-aa98-4eeb4c27b720/export",
+    "version": 2,
+    "created_at": "2023-04-12T08:38:58.000000",
+    "updated_at": "2023-04-12T08:39:01.000000",
+    "links": {
+        "self": "https://127.0.0.1:9001/v2/zones/tasks/exports/8ec17fe1-d1f9-41b4-aa98-4eeb4c27b720",
+        "export": "https://127.0.0.1:9001/v2/zones/tasks/exports/8ec17fe1-d1f9-41b4-aa98-4eeb4c27b720/export"
+    }
+}
+`
+
+// FirstZoneExport is the first result in ListOutput
+var FirstZoneExportCreatedAt, _ = time.Parse(gophercloud.RFC3339MilliNoZ, "2023-04-12T08:38:58.000000")
+var FirstZoneExportUpdatedAt, _ = time.Parse(gophercloud.RFC3339MilliNoZ, "2023-04-12T08:39:01.000000")
+var FirstZoneExport = zoneexports.ZoneExport{
+	ID:        "8ec17fe1-d1f9-41b4-aa98-4eeb4c27b720",
+	Status:    "COMPLETE",
+	ZoneID:    ZoneID,
+	ProjectID: "4335d1f0-f793-11e2-b778-0800200c9a66",
+	Location:  "designate://v2/zones/tasks/exports/8ec17fe1-d1f9-41b4-aa98-4eeb4c27b720/export",
+	Version:   2,
+	CreatedAt: FirstZoneExportCreatedAt,
+	UpdatedAt: FirstZoneExportUpdatedAt,
+	Links: map[string]interface{}{
+		"self":   "https://127.0.0.1:9001/v2/zones/tasks/exports/8ec17fe1-d1f9-41b4-aa98-4eeb4c27b720",
+		"export": "https://127.0.0.1:9001/v2/zones/tasks/exports/8ec17fe1-d1f9-41b4-aa98-4eeb4c27b720/export",
+	},
+}
+
+// SecondZoneExport is the second result in ListOutput
+var SecondZoneExportCreatedAt, _ = time.Parse(gophercloud.RFC3339MilliNoZ, "2023-04-12T09:38:58.000000")
+var SecondZoneExport = zoneexports.ZoneExport{
+	ID:        "1f9c3c6b-1f7e-4c0e-8e3b-6a8b2c5e7d9f",
+	Status:    "PENDING",
+	ZoneID:    ZoneID,
+	ProjectID: "4335d1f0-f793-11e2-b778-0800200c9a66",
+	Version:   1,
+	CreatedAt: SecondZoneExportCreatedAt,
+	Links: map[string]interface{}{
+		"self": "https://127.0.0.1:9001/v2/zones/tasks/exports/1f9c3c6b-1f7e-4c0e-8e3b-6a8b2c5e7d9f",
+	},
+}
+
+// ExpectedZoneExportsSlice is the slice of results that should be parsed
+// from ListOutput, in the expected order.
+var ExpectedZoneExportsSlice = []zoneexports.ZoneExport{FirstZoneExport, SecondZoneExport}
+
+// HandleListSuccessfully configures the test server to respond to a List request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/zones/tasks/exports",
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, ListOutput)
+		})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/zones/tasks/exports/"+FirstZoneExport.ID,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, GetOutput)
+		})
+}
+
+// CreateOutput is a sample response to a Create call.
+const CreateOutput = `
+{
+    "id": "1f9c3c6b-1f7e-4c0e-8e3b-6a8b2c5e7d9f",
+    "status": "PENDING",
+    "message": null,
+    "zone_id": "6625198b-d67d-47dc-8d29-f90bd60f3ac4",
+    "project_id": "4335d1f0-f793-11e2-b778-0800200c9a66",
+    "location": null,
+    "version": 1,
+    "created_at": "2023-04-12T09:38:58.000000",
+    "updated_at": null,
+    "links": {
+        "self": "https://127.0.0.1:9001/v2/zones/tasks/exports/1f9c3c6b-1f7e-4c0e-8e3b-6a8b2c5e7d9f"
+    }
+}
+`
+
+// HandleCreateSuccessfully configures the test server to respond to a Create request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/zones/"+ZoneID+"/tasks/export",
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "POST")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			th.TestJSONRequest(t, r, `{}`)
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusAccepted)
+			fmt.Fprintf(w, CreateOutput)
+		})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a Delete request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/zones/tasks/exports/"+FirstZoneExport.ID,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "DELETE")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+}
+
+// ZoneFile is a sample response to a Download call.
+const ZoneFile = `$ORIGIN example.org.
+$TTL 3600
+
+example.org.  IN SOA ns1.example.org. admin.example.org. 1 3600 600 86400 3600
+example.org.  IN NS  ns1.example.org.
+www.example.org.  IN A  192.0.2.10
+`
+
+// HandleDownloadSuccessfully configures the test server to respond to a Download request.
+func HandleDownloadSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/zones/tasks/exports/"+FirstZoneExport.ID+"/export",
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			th.TestHeader(t, r, "Accept", "text/dns")
+
+			w.Header().Add("Content-Type", "text/dns")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, ZoneFile)
+		})
+}
@@ -0,0 +1,71 @@
+package testing
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/zoneexports"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	count := 0
+	err := zoneexports.List(client.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := zoneexports.ExtractZoneExports(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, ExpectedZoneExportsSlice, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := zoneexports.Get(client.ServiceClient(), FirstZoneExport.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstZoneExport, actual)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	actual, err := zoneexports.Create(client.ServiceClient(), ZoneID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &SecondZoneExport, actual)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	err := zoneexports.Delete(client.ServiceClient(), FirstZoneExport.ID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestDownload(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDownloadSuccessfully(t)
+
+	body, err := zoneexports.Download(client.ServiceClient(), FirstZoneExport.ID).Extract()
+	th.AssertNoErr(t, err)
+	defer body.Close()
+
+	b, err := ioutil.ReadAll(body)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, ZoneFile, string(b))
+}
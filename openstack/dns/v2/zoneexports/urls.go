@@ -0,0 +1,19 @@
+package zoneexports
+
+import "github.com/gophercloud/gophercloud"
+
+func createURL(c *gophercloud.ServiceClient, zoneID string) string {
+	return c.ServiceURL("zones", zoneID, "tasks", "export")
+}
+
+func baseURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("zones", "tasks", "exports")
+}
+
+func resourceURL(c *gophercloud.ServiceClient, exportID string) string {
+	return c.ServiceURL("zones", "tasks", "exports", exportID)
+}
+
+func downloadURL(c *gophercloud.ServiceClient, exportID string) string {
+	return c.ServiceURL("zones", "tasks", "exports", exportID, "export")
+}
@@ -0,0 +1,43 @@
+/*
+Package zonefile converts between zone files in the RFC 1035 master file
+format, as used by BIND, and recordsets of the OpenStack DNS service.
+
+Parse returns the records of a zone file grouped into recordsets.RecordSet
+values, with absolute names, ready to be compared with the output of
+recordsets.ListByZone. Write does the opposite.
+
+Example to Parse a Zone File
+
+	f, err := os.Open("example.org.zone")
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	rrsets, err := zonefile.Parse(f, "example.org.")
+	if err != nil {
+		panic(err)
+	}
+
+	for _, rrset := range rrsets {
+		fmt.Println(rrset.Name, rrset.Type, rrset.Records)
+	}
+
+Example to Write the Recordsets of a Zone as a Zone File
+
+	allPages, err := recordsets.ListByZone(dnsClient, "2150b1bf-dee2-4221-9d85-11f7886fb15f", nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allRRs, err := recordsets.ExtractRecordSets(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	err = zonefile.Write(os.Stdout, "example.org.", allRRs)
+	if err != nil {
+		panic(err)
+	}
+*/
+package zonefile
@@ -0,0 +1,19 @@
+package zonefile
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ErrSyntax is returned by Parse when the zone file is malformed or uses a
+// feature which is not supported, such as $INCLUDE.
+type ErrSyntax struct {
+	gophercloud.BaseError
+	Line   int
+	Reason string
+}
+
+func (e ErrSyntax) Error() string {
+	return fmt.Sprintf("Zone file line %d: %s", e.Line, e.Reason)
+}
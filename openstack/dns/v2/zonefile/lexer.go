@@ -0,0 +1,141 @@
+package zonefile
+
+import (
+	"strings"
+	"unicode"
+)
+
+// token is a field of a zone file entry. Quoted strings keep their quotes so
+// that TXT records are stored the way Designate expects them.
+type token struct {
+	text   string
+	quoted bool
+}
+
+// entry is a logical line of a zone file: a directive or a resource record,
+// possibly spanning several lines within parentheses.
+type entry struct {
+	line int
+
+	// blankOwner is true when the entry starts with a blank, in which case
+	// the owner of the previous record applies.
+	blankOwner bool
+
+	tokens []token
+}
+
+// lex splits a zone file into entries, dropping comments and blank lines.
+func lex(data string) ([]entry, error) {
+	var (
+		entries []entry
+		current entry
+		field   strings.Builder
+		inField bool
+		quoted  bool
+		depth   int
+		line    = 1
+	)
+
+	startLine := true
+	current.line = line
+
+	flushField := func() {
+		if inField || quoted {
+			current.tokens = append(current.tokens, token{text: field.String(), quoted: quoted})
+		}
+		field.Reset()
+		inField = false
+		quoted = false
+	}
+	flushEntry := func() {
+		if len(current.tokens) > 0 {
+			entries = append(entries, current)
+		}
+		current = entry{line: line}
+	}
+
+	runes := []rune(data)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		if quoted {
+			switch r {
+			case '\\':
+				field.WriteRune(r)
+				if i+1 < len(runes) {
+					i++
+					field.WriteRune(runes[i])
+				}
+			case '"':
+				field.WriteRune(r)
+				flushField()
+			case '\n':
+				return nil, ErrSyntax{Line: line, Reason: "unterminated quoted string"}
+			default:
+				field.WriteRune(r)
+			}
+			continue
+		}
+
+		if startLine {
+			startLine = false
+			if depth == 0 && len(current.tokens) == 0 && (r == ' ' || r == '\t') {
+				current.blankOwner = true
+			}
+		}
+
+		switch {
+		case r == '\n':
+			flushField()
+			if depth == 0 {
+				flushEntry()
+			}
+			line++
+			if depth == 0 {
+				current.line = line
+			}
+			startLine = true
+		case r == ';':
+			flushField()
+			for i+1 < len(runes) && runes[i+1] != '\n' {
+				i++
+			}
+		case r == '"':
+			flushField()
+			quoted = true
+			field.WriteRune(r)
+		case r == '(':
+			flushField()
+			depth++
+		case r == ')':
+			flushField()
+			if depth == 0 {
+				return nil, ErrSyntax{Line: line, Reason: "unbalanced parenthesis"}
+			}
+			depth--
+		case r == '\\':
+			inField = true
+			field.WriteRune(r)
+			if i+1 < len(runes) {
+				i++
+				field.WriteRune(runes[i])
+			}
+		case unicode.IsSpace(r):
+			flushField()
+		default:
+			inField = true
+			field.WriteRune(r)
+		}
+	}
+
+	if quoted {
+		return nil, ErrSyntax{Line: line, Reason: "unterminated quoted string"}
+	}
+	if depth > 0 {
+		return nil, ErrSyntax{Line: line, Reason: "unbalanced parenthesis"}
+	}
+	flushField()
+	flushEntry()
+
+	return entries, nil
+}
@@ -0,0 +1,337 @@
+package zonefile
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/recordsets"
+)
+
+// rdataNames gives, for each record type, the positions of the fields of its
+// data which are domain names and must be made absolute.
+var rdataNames = map[string][]int{
+	"CNAME": {0},
+	"DNAME": {0},
+	"NS":    {0},
+	"PTR":   {0},
+	"MX":    {1},
+	"SRV":   {3},
+	"SOA":   {0, 1},
+}
+
+// rdataFields gives the number of fields of the data of the record types
+// whose format is fixed.
+var rdataFields = map[string]int{
+	"A":     1,
+	"AAAA":  1,
+	"CNAME": 1,
+	"DNAME": 1,
+	"NS":    1,
+	"PTR":   1,
+	"MX":    2,
+	"SRV":   4,
+	"SOA":   7,
+	"CAA":   3,
+	"SSHFP": 3,
+}
+
+var classes = map[string]bool{
+	"IN": true,
+	"CH": true,
+	"HS": true,
+	"CS": true,
+}
+
+// Parse reads a zone file in the RFC 1035 master file format and returns its
+// records grouped into recordsets, in the order they first appear.
+//
+// Owner names and the domain names in the data of the records are made
+// absolute, relative to origin or to the last $ORIGIN directive. origin may be
+// empty when the file starts with $ORIGIN. A record without a TTL gets the
+// one of its recordset, of the $TTL directive or, failing that, the last TTL
+// stated; the TTL of the recordset is 0, meaning the default TTL of the zone,
+// when there is none.
+//
+// Only the IN class is supported, and the $INCLUDE and $GENERATE directives
+// are rejected. The records of a recordset can not state different TTLs.
+func Parse(r io.Reader, origin string) ([]recordsets.RecordSet, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := lex(string(data))
+	if err != nil {
+		return nil, err
+	}
+
+	if origin != "" && !strings.HasSuffix(origin, ".") {
+		err := gophercloud.ErrInvalidInput{Value: origin}
+		err.Argument = "origin"
+		return nil, err
+	}
+
+	p := parser{
+		origin: canonical(origin),
+		index:  make(map[string]int),
+	}
+
+	for _, e := range entries {
+		if err := p.parseEntry(e); err != nil {
+			return nil, err
+		}
+	}
+
+	return p.rrsets, nil
+}
+
+type parser struct {
+	origin     string
+	defaultTTL int
+	hasDefault bool
+	lastTTL    int
+	hasLast    bool
+	lastOwner  string
+
+	rrsets []recordsets.RecordSet
+	lines  []int
+	index  map[string]int
+}
+
+func (p *parser) parseEntry(e entry) error {
+	first := e.tokens[0].text
+	if !e.blankOwner && !e.tokens[0].quoted && strings.HasPrefix(first, "$") {
+		return p.parseDirective(e)
+	}
+
+	tokens := e.tokens
+	var owner string
+	if e.blankOwner {
+		if p.lastOwner == "" {
+			return ErrSyntax{Line: e.line, Reason: "record without an owner name"}
+		}
+		owner = p.lastOwner
+	} else {
+		name, err := p.qualify(tokens[0].text, e.line)
+		if err != nil {
+			return err
+		}
+		owner = name
+		tokens = tokens[1:]
+	}
+	p.lastOwner = owner
+
+	ttl, hasTTL, rrtype := 0, false, ""
+	for len(tokens) > 0 && rrtype == "" {
+		t := tokens[0].text
+		tokens = tokens[1:]
+		switch {
+		case classes[strings.ToUpper(t)]:
+			if strings.ToUpper(t) != "IN" {
+				return ErrSyntax{Line: e.line, Reason: fmt.Sprintf("class %s is not supported", t)}
+			}
+		case !hasTTL && isTTL(t):
+			v, err := parseTTL(t)
+			if err != nil {
+				return ErrSyntax{Line: e.line, Reason: err.Error()}
+			}
+			ttl, hasTTL = v, true
+		default:
+			rrtype = strings.ToUpper(t)
+		}
+	}
+	if rrtype == "" {
+		return ErrSyntax{Line: e.line, Reason: "record without a type"}
+	}
+	if len(tokens) == 0 {
+		return ErrSyntax{Line: e.line, Reason: fmt.Sprintf("%s record without data", rrtype)}
+	}
+
+	switch {
+	case hasTTL:
+		p.lastTTL, p.hasLast = ttl, true
+	case p.hasDefault:
+		ttl = p.defaultTTL
+	case p.hasLast:
+		ttl = p.lastTTL
+	}
+
+	record, err := p.rdata(rrtype, tokens, e.line)
+	if err != nil {
+		return err
+	}
+
+	return p.add(owner, rrtype, ttl, hasTTL, record, e.line)
+}
+
+func (p *parser) parseDirective(e entry) error {
+	directive := strings.ToUpper(e.tokens[0].text)
+	args := e.tokens[1:]
+
+	switch directive {
+	case "$ORIGIN":
+		if len(args) != 1 {
+			return ErrSyntax{Line: e.line, Reason: "$ORIGIN expects one argument"}
+		}
+		origin, err := p.qualify(args[0].text, e.line)
+		if err != nil {
+			return err
+		}
+		p.origin = origin
+	case "$TTL":
+		if len(args) != 1 {
+			return ErrSyntax{Line: e.line, Reason: "$TTL expects one argument"}
+		}
+		ttl, err := parseTTL(args[0].text)
+		if err != nil {
+			return ErrSyntax{Line: e.line, Reason: err.Error()}
+		}
+		p.defaultTTL, p.hasDefault = ttl, true
+	default:
+		return ErrSyntax{Line: e.line, Reason: fmt.Sprintf("directive %s is not supported", e.tokens[0].text)}
+	}
+	return nil
+}
+
+func (p *parser) rdata(rrtype string, tokens []token, line int) (string, error) {
+	if n, ok := rdataFields[rrtype]; ok && len(tokens) != n {
+		return "", ErrSyntax{Line: line, Reason: fmt.Sprintf("%s record expects %d fields, got %d", rrtype, n, len(tokens))}
+	}
+
+	fields := make([]string, len(tokens))
+	for i, t := range tokens {
+		fields[i] = t.text
+	}
+
+	for _, i := range rdataNames[rrtype] {
+		name, err := p.qualify(fields[i], line)
+		if err != nil {
+			return "", err
+		}
+		fields[i] = name
+	}
+
+	switch rrtype {
+	case "A":
+		if ip := net.ParseIP(fields[0]); ip == nil || ip.To4() == nil {
+			return "", ErrSyntax{Line: line, Reason: fmt.Sprintf("invalid IPv4 address %q", fields[0])}
+		}
+	case "AAAA":
+		if ip := net.ParseIP(fields[0]); ip == nil || ip.To4() != nil {
+			return "", ErrSyntax{Line: line, Reason: fmt.Sprintf("invalid IPv6 address %q", fields[0])}
+		}
+	case "SOA":
+		for i := 2; i < 7; i++ {
+			v, err := parseTTL(fields[i])
+			if err != nil {
+				return "", ErrSyntax{Line: line, Reason: err.Error()}
+			}
+			fields[i] = strconv.Itoa(v)
+		}
+	}
+
+	return strings.Join(fields, " "), nil
+}
+
+func (p *parser) add(name, rrtype string, ttl int, explicitTTL bool, record string, line int) error {
+	key := name + " " + rrtype
+	i, ok := p.index[key]
+	if !ok {
+		p.index[key] = len(p.rrsets)
+		p.rrsets = append(p.rrsets, recordsets.RecordSet{
+			Name:    name,
+			Type:    rrtype,
+			TTL:     ttl,
+			Records: []string{record},
+		})
+		p.lines = append(p.lines, line)
+		return nil
+	}
+
+	// As BIND does, a record without a TTL of its own takes the TTL of its
+	// recordset.
+	rrset := &p.rrsets[i]
+	if explicitTTL && rrset.TTL != ttl {
+		return ErrSyntax{Line: line, Reason: fmt.Sprintf("TTL %d of %s %s differs from TTL %d on line %d", ttl, name, rrtype, rrset.TTL, p.lines[i])}
+	}
+	for _, r := range rrset.Records {
+		if r == record {
+			return nil
+		}
+	}
+	rrset.Records = append(rrset.Records, record)
+	return nil
+}
+
+// qualify makes a domain name absolute.
+func (p *parser) qualify(name string, line int) (string, error) {
+	switch {
+	case name == "@":
+		if p.origin == "" {
+			return "", ErrSyntax{Line: line, Reason: "@ used without an origin"}
+		}
+		return p.origin, nil
+	case strings.HasSuffix(name, ".") && !strings.HasSuffix(name, "\\."):
+		return canonical(name), nil
+	case p.origin == "":
+		return "", ErrSyntax{Line: line, Reason: fmt.Sprintf("relative name %q used without an origin", name)}
+	case p.origin == ".":
+		return canonical(name) + ".", nil
+	default:
+		return canonical(name) + "." + p.origin, nil
+	}
+}
+
+func canonical(name string) string {
+	return strings.ToLower(name)
+}
+
+func isTTL(s string) bool {
+	return s != "" && s[0] >= '0' && s[0] <= '9'
+}
+
+// parseTTL parses a TTL in seconds or in the BIND format, such as "1h30m".
+func parseTTL(s string) (int, error) {
+	if v, err := strconv.Atoi(s); err == nil {
+		if v < 0 {
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+		return v, nil
+	}
+
+	total, current, hasDigit := 0, 0, false
+	for _, r := range strings.ToLower(s) {
+		if r >= '0' && r <= '9' {
+			current = current*10 + int(r-'0')
+			hasDigit = true
+			continue
+		}
+		if !hasDigit {
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+		switch r {
+		case 's':
+		case 'm':
+			current *= 60
+		case 'h':
+			current *= 3600
+		case 'd':
+			current *= 86400
+		case 'w':
+			current *= 604800
+		default:
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+		total += current
+		current, hasDigit = 0, false
+	}
+	if hasDigit {
+		return 0, fmt.Errorf("invalid TTL %q", s)
+	}
+	return total, nil
+}
@@ -0,0 +1,2 @@
+// zonefile unit tests
+package testing
@@ -0,0 +1,108 @@
+package testing
+
+import (
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/recordsets"
+)
+
+// ZoneFile is a sample zone file using relative names, implicit owners,
+// parentheses, comments and TTL units.
+const ZoneFile = `$ORIGIN example.org.
+$TTL 1h
+@       IN  SOA ns1 hostmaster (
+                2023041201 ; serial
+                1h         ; refresh
+                15m        ; retry
+                1w         ; expire
+                300 )      ; minimum
+        IN  NS  ns1
+        IN  NS  ns2.example.net.
+        IN  MX  10 mail
+        IN  TXT "v=spf1 mx -all"
+ns1         A   192.0.2.1
+www     300 IN  A   192.0.2.10
+            IN  A   192.0.2.11
+www         AAAA 2001:db8::10
+ftp         CNAME www ; alias
+_sip._tcp   SRV 10 60 5060 sip
+sub         NS  ns.sub
+`
+
+// ParsedZone is the result of the parsing of ZoneFile.
+var ParsedZone = []recordsets.RecordSet{
+	{
+		Name:    "example.org.",
+		Type:    "SOA",
+		TTL:     3600,
+		Records: []string{"ns1.example.org. hostmaster.example.org. 2023041201 3600 900 604800 300"},
+	},
+	{
+		Name:    "example.org.",
+		Type:    "NS",
+		TTL:     3600,
+		Records: []string{"ns1.example.org.", "ns2.example.net."},
+	},
+	{
+		Name:    "example.org.",
+		Type:    "MX",
+		TTL:     3600,
+		Records: []string{"10 mail.example.org."},
+	},
+	{
+		Name:    "example.org.",
+		Type:    "TXT",
+		TTL:     3600,
+		Records: []string{`"v=spf1 mx -all"`},
+	},
+	{
+		Name:    "ns1.example.org.",
+		Type:    "A",
+		TTL:     3600,
+		Records: []string{"192.0.2.1"},
+	},
+	{
+		Name:    "www.example.org.",
+		Type:    "A",
+		TTL:     300,
+		Records: []string{"192.0.2.10", "192.0.2.11"},
+	},
+	{
+		Name:    "www.example.org.",
+		Type:    "AAAA",
+		TTL:     3600,
+		Records: []string{"2001:db8::10"},
+	},
+	{
+		Name:    "ftp.example.org.",
+		Type:    "CNAME",
+		TTL:     3600,
+		Records: []string{"www.example.org."},
+	},
+	{
+		Name:    "_sip._tcp.example.org.",
+		Type:    "SRV",
+		TTL:     3600,
+		Records: []string{"10 60 5060 sip.example.org."},
+	},
+	{
+		Name:    "sub.example.org.",
+		Type:    "NS",
+		TTL:     3600,
+		Records: []string{"ns.sub.example.org."},
+	},
+}
+
+// WrittenZone is the result of writing ParsedZone.
+const WrittenZone = `$ORIGIN example.org.
+@	3600	IN	SOA	ns1.example.org. hostmaster.example.org. 2023041201 3600 900 604800 300
+@	3600	IN	NS	ns1.example.org.
+@	3600	IN	NS	ns2.example.net.
+@	3600	IN	MX	10 mail.example.org.
+@	3600	IN	TXT	"v=spf1 mx -all"
+_sip._tcp	3600	IN	SRV	10 60 5060 sip.example.org.
+ftp	3600	IN	CNAME	www.example.org.
+ns1	3600	IN	A	192.0.2.1
+sub	3600	IN	NS	ns.sub.example.org.
+www	300	IN	A	192.0.2.10
+www	300	IN	A	192.0.2.11
+www	3600	IN	AAAA	2001:db8::10
+`
@@ -0,0 +1,110 @@
+package testing
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/recordsets"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/zonefile"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestParse(t *testing.T) {
+	actual, err := zonefile.Parse(strings.NewReader(ZoneFile), "")
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ParsedZone, actual)
+}
+
+func TestParseWithOrigin(t *testing.T) {
+	data := "www 60 A 192.0.2.10\n@ 60 TXT \"hello; world\" \"second\"\n"
+
+	actual, err := zonefile.Parse(strings.NewReader(data), "Example.ORG.")
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []recordsets.RecordSet{
+		{Name: "www.example.org.", Type: "A", TTL: 60, Records: []string{"192.0.2.10"}},
+		{Name: "example.org.", Type: "TXT", TTL: 60, Records: []string{`"hello; world" "second"`}},
+	}, actual)
+}
+
+func TestParseWithoutTTL(t *testing.T) {
+	actual, err := zonefile.Parse(strings.NewReader("www A 192.0.2.10\n"), "example.org.")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 0, actual[0].TTL)
+}
+
+func TestParseLastTTL(t *testing.T) {
+	data := "www 60 A 192.0.2.10\nftp A 192.0.2.11\n"
+
+	actual, err := zonefile.Parse(strings.NewReader(data), "example.org.")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 60, actual[1].TTL)
+}
+
+func TestParseErrors(t *testing.T) {
+	cases := map[string]string{
+		"unbalanced parenthesis":  "@ SOA ns1 hostmaster ( 1 2 3 4 5\n",
+		"unterminated string":     "@ TXT \"hello\n",
+		"include":                 "$INCLUDE other.zone\n",
+		"unsupported class":       "www CH A 192.0.2.10\n",
+		"missing owner":           "  A 192.0.2.10\n",
+		"invalid IPv4":            "www A 2001:db8::1\n",
+		"invalid IPv6":            "www AAAA 192.0.2.1\n",
+		"wrong number of fields":  "@ MX mail\n",
+		"invalid TTL":             "www 1x A 192.0.2.10\n",
+		"different TTLs":          "www 60 A 192.0.2.10\nwww 120 A 192.0.2.11\n",
+		"missing data":            "www A\n",
+		"relative without origin": "$ORIGIN www\n",
+	}
+
+	for name, data := range cases {
+		origin := "example.org."
+		if name == "relative without origin" {
+			origin = ""
+		}
+		_, err := zonefile.Parse(strings.NewReader(data), origin)
+		if _, ok := err.(zonefile.ErrSyntax); !ok {
+			t.Errorf("%s: expected ErrSyntax, got %v", name, err)
+		}
+	}
+}
+
+func TestParseErrorLine(t *testing.T) {
+	data := "$ORIGIN example.org.\n\n; comment\nwww A 192.0.2.10\nftp A nope\n"
+
+	_, err := zonefile.Parse(strings.NewReader(data), "")
+	th.AssertEquals(t, 5, err.(zonefile.ErrSyntax).Line)
+}
+
+func TestParseInvalidOrigin(t *testing.T) {
+	_, err := zonefile.Parse(strings.NewReader(""), "example.org")
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("Expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	var b bytes.Buffer
+	err := zonefile.Write(&b, "example.org.", ParsedZone)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, WrittenZone, b.String())
+}
+
+func TestRoundTrip(t *testing.T) {
+	var b bytes.Buffer
+	err := zonefile.Write(&b, "example.org.", ParsedZone)
+	th.AssertNoErr(t, err)
+
+	actual, err := zonefile.Parse(&b, "")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, len(ParsedZone), len(actual))
+
+	byKey := make(map[string]recordsets.RecordSet)
+	for _, rrset := range actual {
+		byKey[rrset.Name+" "+rrset.Type] = rrset
+	}
+	for _, expected := range ParsedZone {
+		th.CheckDeepEquals(t, expected, byKey[expected.Name+" "+expected.Type])
+	}
+}
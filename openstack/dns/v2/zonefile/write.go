@@ -0,0 +1,82 @@
+package zonefile
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/recordsets"
+)
+
+// Write writes recordsets as a zone file in the RFC 1035 master file format.
+//
+// The file starts with an $ORIGIN directive, and the owner names within
+// origin are written relative to it. The SOA recordset comes first, followed
+// by the NS recordset of the apex and by the other recordsets sorted by name
+// and type. A recordset whose TTL is 0 is written without a TTL.
+func Write(w io.Writer, origin string, rrsets []recordsets.RecordSet) error {
+	origin = canonical(origin)
+	if !strings.HasSuffix(origin, ".") {
+		err := gophercloud.ErrInvalidInput{Value: origin}
+		err.Argument = "origin"
+		return err
+	}
+
+	sorted := make([]recordsets.RecordSet, len(rrsets))
+	copy(sorted, rrsets)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		ri, rj := rank(sorted[i], origin), rank(sorted[j], origin)
+		if ri != rj {
+			return ri < rj
+		}
+		ni, nj := canonical(sorted[i].Name), canonical(sorted[j].Name)
+		if ni != nj {
+			return ni < nj
+		}
+		return sorted[i].Type < sorted[j].Type
+	})
+
+	b := bufio.NewWriter(w)
+	fmt.Fprintf(b, "$ORIGIN %s\n", origin)
+	for _, rrset := range sorted {
+		owner := relative(canonical(rrset.Name), origin)
+		ttl := ""
+		if rrset.TTL > 0 {
+			ttl = strconv.Itoa(rrset.TTL)
+		}
+		for _, record := range rrset.Records {
+			fmt.Fprintf(b, "%s\t%s\tIN\t%s\t%s\n", owner, ttl, strings.ToUpper(rrset.Type), record)
+		}
+	}
+	return b.Flush()
+}
+
+// rank orders the SOA recordset first and the NS recordset of the apex
+// second.
+func rank(rrset recordsets.RecordSet, origin string) int {
+	apex := canonical(rrset.Name) == origin
+	switch {
+	case apex && rrset.Type == "SOA":
+		return 0
+	case apex && rrset.Type == "NS":
+		return 1
+	case apex:
+		return 2
+	default:
+		return 3
+	}
+}
+
+func relative(name, origin string) string {
+	if name == origin {
+		return "@"
+	}
+	if origin != "." && strings.HasSuffix(name, "."+origin) {
+		return strings.TrimSuffix(name, "."+origin)
+	}
+	return name
+}
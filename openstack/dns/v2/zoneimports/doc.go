@@ -0,0 +1,52 @@
+/*
+Package zoneimports provides the ability to create zones of the OpenStack DNS
+service from zone files.
+
+Example to Import a Zone
+
+	zoneFile, err := os.Open("example.org.zone")
+	if err != nil {
+		panic(err)
+	}
+	defer zoneFile.Close()
+
+	zoneImport, err := zoneimports.Create(dnsClient, zoneFile).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Get a Zone Import
+
+	zoneImport, err := zoneimports.Get(dnsClient, "074e805e-fe87-4cbb-b10b-21a06e215d41").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	if zoneImport.Status == "COMPLETE" {
+		fmt.Println("Imported zone", zoneImport.ZoneID)
+	}
+
+Example to List Zone Imports
+
+	allPages, err := zoneimports.List(dnsClient, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allImports, err := zoneimports.ExtractZoneImports(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, zoneImport := range allImports {
+		fmt.Printf("%+v\n", zoneImport)
+	}
+
+Example to Delete a Zone Import
+
+	err := zoneimports.Delete(dnsClient, "074e805e-fe87-4cbb-b10b-21a06e215d41").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package zoneimports
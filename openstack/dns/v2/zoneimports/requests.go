@@ -0,0 +1,74 @@
+package zoneimports
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add parameters to the List request.
+type ListOptsBuilder interface {
+	ToZoneImportListQuery() (string, error)
+}
+
+// ListOpts allows the paging of zone import collections through the API.
+// Marker and Limit are used for pagination.
+// https://developer.openstack.org/api-ref/dns/
+type ListOpts struct {
+	// Integer value for the limit of values to return.
+	Limit int `q:"limit"`
+
+	// UUID of the zone import at which you want to set a marker.
+	Marker string `q:"marker"`
+}
+
+// ToZoneImportListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToZoneImportListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List implements a zone import List request.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := baseURL(client)
+	if opts != nil {
+		query, err := opts.ToZoneImportListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ZoneImportPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get returns information about a zone import, given its ID.
+func Get(client *gophercloud.ServiceClient, importID string) (r GetResult) {
+	resp, err := client.Get(resourceURL(client, importID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Create imports a zone from a zone file in the RFC 1035 master file format.
+// The import runs asynchronously: poll it with Get until its status leaves
+// PENDING, then the ZoneID of the import points to the created zone.
+func Create(client *gophercloud.ServiceClient, zoneFile io.Reader) (r CreateResult) {
+	resp, err := client.Post(baseURL(client), zoneFile, &r.Body, &gophercloud.RequestOpts{
+		MoreHeaders: map[string]string{"Content-Type": "text/dns"},
+		OkCodes:     []int{http.StatusAccepted},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete removes a zone import. The imported zone is kept.
+func Delete(client *gophercloud.ServiceClient, importID string) (r DeleteResult) {
+	resp, err := client.Delete(resourceURL(client, importID), &gophercloud.RequestOpts{
+		OkCodes: []int{http.StatusNoContent},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,112 @@
+package zoneimports
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult or CreateResult as a ZoneImport.
+// An error is returned if the original call or the extraction failed.
+func (r commonResult) Extract() (*ZoneImport, error) {
+	var s *ZoneImport
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// CreateResult is the result of a Create request. Call its Extract method
+// to interpret the result as a ZoneImport.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult is the result of a Get request. Call its Extract method
+// to interpret the result as a ZoneImport.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult is the result of a Delete request. Call its ExtractErr method
+// to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ZoneImportPage is a single page of ZoneImport results.
+type ZoneImportPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if the page contains no results.
+func (r ZoneImportPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	s, err := ExtractZoneImports(r)
+	return len(s) == 0, err
+}
+
+// ExtractZoneImports extracts a slice of ZoneImports from a List result.
+func ExtractZoneImports(r pagination.Page) ([]ZoneImport, error) {
+	var s struct {
+		ZoneImports []ZoneImport `json:"imports"`
+	}
+	err := (r.(ZoneImportPage)).ExtractInto(&s)
+	return s.ZoneImports, err
+}
+
+// ZoneImport represents the asynchronous import of a zone file.
+type ZoneImport struct {
+	// ID uniquely identifies this import amongst all other imports.
+	ID string `json:"id"`
+
+	// Status is the status of the import: PENDING, COMPLETE or ERROR.
+	Status string `json:"status"`
+
+	// Message describes the outcome of the import.
+	Message string `json:"message"`
+
+	// ZoneID is the ID of the imported zone, once the import completed.
+	ZoneID string `json:"zone_id"`
+
+	// ProjectID is the ID of the project that owns the import.
+	ProjectID string `json:"project_id"`
+
+	// Version of the resource.
+	Version int `json:"version"`
+
+	// CreatedAt is the date when the import was created.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the date when the last change was made to the import.
+	UpdatedAt time.Time `json:"-"`
+
+	// Links includes HTTP references to the itself and to the imported zone.
+	Links map[string]interface{} `json:"links"`
+}
+
+func (r *ZoneImport) UnmarshalJSON(b []byte) error {
+	type tmp ZoneImport
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = ZoneImport(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return err
+}
@@ -0,0 +1,2 @@
+// zoneimports unit tests
+package testing
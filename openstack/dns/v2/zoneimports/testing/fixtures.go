@@ -0,0 +1,196 @@
+package testing
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/zoneimports"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput is a sample response to a List call.
+const ListOutput = `
+{
+    "imports": [
+        {
+            "id": "074e805e-fe87-4cbb-b10b-21a06e215d41",
+            "status": "COMPLETE",
+            "message": "example.org. imported",
+            "zone_id": "6625198b-d67d-47dc-8d29-f90bd60f3ac4",
+            "project_id": "4335d1f0-f793-11e2-b778-0800200c9a66",
+            "version": 2,
+            "created_at": "2023-04-12T08:38:58.000000",
+            "updated_at": "2023-04-12T08:39:01.000000",
+            "links": {
+                "self": "https://127.0.0.1:9001/v2/zones/tasks/imports/074e805e-fe87-4cbb-b10b-21a06e215d41",
+                "href": "https://127.0.0.1:9001/v2/zones/6625198b-d67d-47dc-8d29-f90bd60f3ac4"
+            }
+        },
+        {
+            "id": "e8ebd6a8-b8d2-4f0c-9f3a-1c3b2b8e9f10",
+            "status": "ERROR",
+            "message": "An undefined error occurred",
+            "zone_id": null,
+            "project_id": "4335d1f0-f793-11e2-b778-0800200c9a66",
+            "version": 2,
+            "created_at": "2023-04-12T09:38:58.000000",
+            "updated_at": "2023-04-12T09:39:01.000000",
+            "links": {
+                "self": "https://127.0.0.1:9001/v2/zones/tasks/imports/e8ebd6a8-b8d2-4f0c-9f3a-1c3b2b8e9f10"
+            }
+        }
+    ],
+    "links": {
+        "self": "https://127.0.0.1:9001/v2/zones/tasks/imports"
+    }
+}
+`
+
+// GetOutput is a sample response to a Get call.
+const GetOutput = `
+{
+    "id": "074e805e-fe87-4cbb-b10b-21a06e215d41",
+    "status": "COMPLETE",
+    "message": "example.org. imported",
+    "zone_id": "6625198b-d67d-47dc-8d29-f90bd60f3ac4",
+    "project_id": "4335d1f0-f793-11e2-b778-0800200c9a66",
+    "version": 2,
+    "created_at": "2023-04-12T08:38:58.000000",
+    "updated_at": "2023-04-12T08:39:01.000000",
+    "links": {
+        "self": "https://127.0.0.1:9001/v2/zones/tasks/imports/074e805e-fe87-4cbb-b10b-21a06e215d41",
+        "href": "https://127.0.0.1:9001/v2/zones/6625198b-d67d-47dc-8d29-f90bd60f3ac4"
+    }
+}
+`
+
+// FirstZoneImport is the first result in ListOutput
+var FirstZoneImportCreatedAt, _ = time.Parse(gophercloud.RFC3339MilliNoZ, "2023-04-12T08:38:58.000000")
+var FirstZoneImportUpdatedAt, _ = time.Parse(gophercloud.RFC3339MilliNoZ, "2023-04-12T08:39:01.000000")
+var FirstZoneImport = zoneimports.ZoneImport{
+	ID:        "074e805e-fe87-4cbb-b10b-21a06e215d41",
+	Status:    "COMPLETE",
+	Message:   "example.org. imported",
+	ZoneID:    "6625198b-d67d-47dc-8d29-f90bd60f3ac4",
+	ProjectID: "4335d1f0-f793-11e2-b778-0800200c9a66",
+	Version:   2,
+	CreatedAt: FirstZoneImportCreatedAt,
+	UpdatedAt: FirstZoneImportUpdatedAt,
+	Links: map[string]interface{}{
+		"self": "https://127.0.0.1:9001/v2/zones/tasks/imports/074e805e-fe87-4cbb-b10b-21a06e215d41",
+		"href": "https://127.0.0.1:9001/v2/zones/6625198b-d67d-47dc-8d29-f90bd60f3ac4",
+	},
+}
+
+// SecondZoneImport is the second result in ListOutput
+var SecondZoneImportCreatedAt, _ = time.Parse(gophercloud.RFC3339MilliNoZ, "2023-04-12T09:38:58.000000")
+var SecondZoneImportUpdatedAt, _ = time.Parse(gophercloud.RFC3339MilliNoZ, "2023-04-12T09:39:01.000000")
+var SecondZoneImport = zoneimports.ZoneImport{
+	ID:        "e8ebd6a8-b8d2-4f0c-9f3a-1c3b2b8e9f10",
+	Status:    "ERROR",
+	Message:   "An undefined error occurred",
+	ProjectID: "4335d1f0-f793-11e2-b778-0800200c9a66",
+	Version:   2,
+	CreatedAt: SecondZoneImportCreatedAt,
+	UpdatedAt: SecondZoneImportUpdatedAt,
+	Links: map[string]interface{}{
+		"self": "https://127.0.0.1:9001/v2/zones/tasks/imports/e8ebd6a8-b8d2-4f0c-9f3a-1c3b2b8e9f10",
+	},
+}
+
+// ExpectedZoneImportsSlice is the slice of results that should be parsed
+// from ListOutput, in the expected order.
+var ExpectedZoneImportsSlice = []zoneimports.ZoneImport{FirstZoneImport, SecondZoneImport}
+
+// HandleListSuccessfully configures the test server to respond to a List request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/zones/tasks/imports",
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, ListOutput)
+		})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/zones/tasks/imports/"+FirstZoneImport.ID,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, GetOutput)
+		})
+}
+
+// ZoneFile is a sample zone file to import.
+const ZoneFile = `$ORIGIN example.org.
+$TTL 3600
+example.org.  IN SOA ns1.example.org. admin.example.org. 1 3600 600 86400 3600
+example.org.  IN NS  ns1.example.org.
+www           IN A   192.0.2.10
+`
+
+// CreateOutput is a sample response to a Create call.
+const CreateOutput = `
+{
+    "id": "074e805e-fe87-4cbb-b10b-21a06e215d41",
+    "status": "PENDING",
+    "message": null,
+    "zone_id": null,
+    "project_id": "4335d1f0-f793-11e2-b778-0800200c9a66",
+    "version": 1,
+    "created_at": "2023-04-12T08:38:58.000000",
+    "updated_at": null,
+    "links": {
+        "self": "https://127.0.0.1:9001/v2/zones/tasks/imports/074e805e-fe87-4cbb-b10b-21a06e215d41"
+    }
+}
+`
+
+// CreatedZoneImport is the result of CreateOutput.
+var CreatedZoneImport = zoneimports.ZoneImport{
+	ID:        "074e805e-fe87-4cbb-b10b-21a06e215d41",
+	Status:    "PENDING",
+	ProjectID: "4335d1f0-f793-11e2-b778-0800200c9a66",
+	Version:   1,
+	CreatedAt: FirstZoneImportCreatedAt,
+	Links: map[string]interface{}{
+		"self": "https://127.0.0.1:9001/v2/zones/tasks/imports/074e805e-fe87-4cbb-b10b-21a06e215d41",
+	},
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a Create request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/zones/tasks/imports",
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "POST")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			th.TestHeader(t, r, "Content-Type", "text/dns")
+
+			b, err := ioutil.ReadAll(r.Body)
+			th.AssertNoErr(t, err)
+			th.CheckEquals(t, ZoneFile, string(b))
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusAccepted)
+			fmt.Fprintf(w, CreateOutput)
+		})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a Delete request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/zones/tasks/imports/"+FirstZoneImport.ID,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "DELETE")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+}
@@ -0,0 +1,57 @@
+package testing
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/zoneimports"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	count := 0
+	err := zoneimports.List(client.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := zoneimports.ExtractZoneImports(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, ExpectedZoneImportsSlice, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := zoneimports.Get(client.ServiceClient(), FirstZoneImport.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstZoneImport, actual)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	actual, err := zoneimports.Create(client.ServiceClient(), strings.NewReader(ZoneFile)).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &CreatedZoneImport, actual)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	err := zoneimports.Delete(client.ServiceClient(), FirstZoneImport.ID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,11 @@
+package zoneimports
+
+import "github.com/gophercloud/gophercloud"
+
+func baseURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("zones", "tasks", "imports")
+}
+
+func resourceURL(c *gophercloud.ServiceClient, importID string) string {
+	return c.ServiceURL("zones", "tasks", "imports", importID)
+}
@@ -0,0 +1,43 @@
+/*
+Package cache enables management of the Imageservice image cache. It
+requires the Imageservice API version 2.14 or newer.
+
+Example to List Cached and Queued Images
+
+	imageCache, err := cache.List(imagesClient).Extract()
+	if err != nil {
+	  panic(err)
+	}
+
+	for _, image := range imageCache.CachedImages {
+	  fmt.Printf("%+v\n", image)
+	}
+
+	fmt.Printf("%v\n", imageCache.QueuedImages)
+
+Example to Queue an Image for Caching
+
+	err := cache.Queue(imagesClient, "da3b75d9-3f4a-40e7-8a2c-bfab23927dea").ExtractErr()
+	if err != nil {
+	  panic(err)
+	}
+
+Example to Delete an Image from the Cache
+
+	err := cache.Delete(imagesClient, "da3b75d9-3f4a-40e7-8a2c-bfab23927dea").ExtractErr()
+	if err != nil {
+	  panic(err)
+	}
+
+Example to Clear the Queue
+
+	clearOpts := cache.ClearOpts{
+	  Target: cache.ClearTargetQueue,
+	}
+
+	err := cache.Clear(imagesClient, clearOpts).ExtractErr()
+	if err != nil {
+	  panic(err)
+	}
+*/
+package cache
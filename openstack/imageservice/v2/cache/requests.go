@@ -0,0 +1,84 @@
+package cache
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// ClearTarget represents the part of the cache, which is cleared.
+type ClearTarget string
+
+const (
+	// ClearTargetAll clears both the cached and the queued images.
+	ClearTargetAll ClearTarget = ""
+
+	// ClearTargetCache clears the cached images only.
+	ClearTargetCache ClearTarget = "cache"
+
+	// ClearTargetQueue clears the queued images only.
+	ClearTargetQueue ClearTarget = "queue"
+)
+
+// List retrieves the cached images and the images queued for caching.
+func List(c *gophercloud.ServiceClient) (r ListResult) {
+	resp, err := c.Get(listURL(c), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Queue queues an image for caching.
+func Queue(c *gophercloud.ServiceClient, imageID string) (r QueueResult) {
+	resp, err := c.Put(resourceURL(c, imageID), nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete removes an image from the cache or from the queue.
+func Delete(c *gophercloud.ServiceClient, imageID string) (r DeleteResult) {
+	resp, err := c.Delete(resourceURL(c, imageID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ClearOptsBuilder allows extensions to add additional parameters to the
+// Clear request.
+type ClearOptsBuilder interface {
+	ToCacheClearHeaders() (map[string]string, error)
+}
+
+// ClearOpts specifies the part of the cache, which is cleared.
+type ClearOpts struct {
+	// Target is the part of the cache to clear. If empty, both the cached and
+	// the queued images are cleared.
+	Target ClearTarget `h:"x-image-cache-clear-target"`
+}
+
+// ToCacheClearHeaders formats a ClearOpts into a map of headers.
+func (opts ClearOpts) ToCacheClearHeaders() (map[string]string, error) {
+	return gophercloud.BuildHeaders(opts)
+}
+
+// Clear removes all the images from the cache and/or from the queue.
+func Clear(c *gophercloud.ServiceClient, opts ClearOptsBuilder) (r ClearResult) {
+	h := make(map[string]string)
+	if opts != nil {
+		headers, err := opts.ToCacheClearHeaders()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		for k, v := range headers {
+			h[k] = v
+		}
+	}
+
+	resp, err := c.Delete(listURL(c), &gophercloud.RequestOpts{
+		MoreHeaders: h,
+		OkCodes:     []int{204},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,95 @@
+package cache
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// Cache represents the contents of the image cache.
+type Cache struct {
+	// CachedImages is a list of the cached images.
+	CachedImages []CachedImage `json:"cached_images"`
+
+	// QueuedImages is a list of identifiers of the images queued for caching.
+	QueuedImages []string `json:"queued_images"`
+}
+
+// CachedImage represents a single cached image.
+type CachedImage struct {
+	// ImageID is the identifier of the cached image.
+	ImageID string `json:"image_id"`
+
+	// Hits is the number of cache hits of the image.
+	Hits int `json:"hits"`
+
+	// LastAccessed is the time, when the cached image was last accessed.
+	LastAccessed time.Time `json:"-"`
+
+	// LastModified is the time, when the cached image was last modified.
+	LastModified time.Time `json:"-"`
+
+	// Size is the size of the cached image in bytes.
+	Size int64 `json:"size"`
+}
+
+// UnmarshalJSON converts the UNIX timestamps of a CachedImage.
+func (r *CachedImage) UnmarshalJSON(b []byte) error {
+	type tmp CachedImage
+	var s struct {
+		tmp
+		LastAccessed float64 `json:"last_accessed"`
+		LastModified float64 `json:"last_modified"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = CachedImage(s.tmp)
+
+	if s.LastAccessed > 0 {
+		r.LastAccessed = unixTime(s.LastAccessed)
+	}
+	if s.LastModified > 0 {
+		r.LastModified = unixTime(s.LastModified)
+	}
+
+	return nil
+}
+
+func unixTime(t float64) time.Time {
+	sec := int64(t)
+	return time.Unix(sec, int64((t-float64(sec))*float64(time.Second))).UTC()
+}
+
+// ListResult represents the result of a List operation. Call its Extract
+// method to interpret it as a Cache.
+type ListResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ListResult as a Cache.
+func (r ListResult) Extract() (*Cache, error) {
+	var s *Cache
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// QueueResult represents the result of a Queue operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type QueueResult struct {
+	gophercloud.ErrResult
+}
+
+// DeleteResult represents the result of a Delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ClearResult represents the result of a Clear operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type ClearResult struct {
+	gophercloud.ErrResult
+}
@@ -0,0 +1,39 @@
+package testing
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/cache"
+)
+
+// ListResult represents raw server response on a List request.
+const ListResult = `
+{
+    "cached_images": [
+        {
+            "image_id": "da3b75d9-3f4a-40e7-8a2c-bfab23927dea",
+            "hits": 3,
+            "last_accessed": 1593565920.5,
+            "last_modified": 1593565800,
+            "size": 12716032
+        }
+    ],
+    "queued_images": [
+        "cc5e3c7b-8e54-4bc5-9e4b-1f9b7d9fa0a1"
+    ]
+}
+`
+
+// ExpectedCache is the expected representation of the ListResult.
+var ExpectedCache = cache.Cache{
+	CachedImages: []cache.CachedImage{
+		{
+			ImageID:      "da3b75d9-3f4a-40e7-8a2c-bfab23927dea",
+			Hits:         3,
+			LastAccessed: time.Date(2020, 7, 1, 1, 12, 0, 500000000, time.UTC),
+			LastModified: time.Date(2020, 7, 1, 1, 10, 0, 0, time.UTC),
+			Size:         12716032,
+		},
+	},
+	QueuedImages: []string{"cc5e3c7b-8e54-4bc5-9e4b-1f9b7d9fa0a1"},
+}
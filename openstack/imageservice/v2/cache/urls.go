@@ -0,0 +1,13 @@
+package cache
+
+import "github.com/gophercloud/gophercloud"
+
+const resourcePath = "cache"
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, imageID string) string {
+	return c.ServiceURL(resourcePath, imageID)
+}
@@ -0,0 +1,45 @@
+/*
+Package imageformat detects the disk format of an image before it is
+uploaded to the Imageservice. It reports the virtual size of the disk and
+whether the image references data outside of itself, such as qcow2 backing
+files or external data files, which must not be uploaded.
+
+The qcow2, vmdk, vhd, vhdx and iso formats are detected from the image
+header. Other images are reported as raw, which also covers the ami, ari
+and aki disk formats.
+
+Example to Inspect an Image and Upload it
+
+	f, err := os.Open("cirros-0.4.0-x86_64-disk.img")
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	info, data, err := imageformat.Inspect(f)
+	if err != nil {
+		panic(err)
+	}
+
+	createOpts := images.CreateOpts{
+		Name:            "cirros",
+		ContainerFormat: "bare",
+	}
+
+	// Apply rejects unsafe images and sets createOpts.DiskFormat
+	err = info.Apply(&createOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	image, err := images.Create(imagesClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = imagedata.Upload(imagesClient, image.ID, data).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package imageformat
@@ -0,0 +1,32 @@
+package imageformat
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ErrUnsafeImage is the error when an image references data outside of
+// itself, e.g. a qcow2 backing file or an external data file, which could
+// expose the host files to the instance.
+type ErrUnsafeImage struct {
+	gophercloud.BaseError
+	Format string
+	Reason string
+}
+
+func (e ErrUnsafeImage) Error() string {
+	return fmt.Sprintf("Unsafe %s image: %s", e.Format, e.Reason)
+}
+
+// ErrFormatMismatch is the error when the requested disk format doesn't
+// match the detected one.
+type ErrFormatMismatch struct {
+	gophercloud.BaseError
+	Requested string
+	Detected  string
+}
+
+func (e ErrFormatMismatch) Error() string {
+	return fmt.Sprintf("The requested %q disk format doesn't match the detected %q format", e.Requested, e.Detected)
+}
@@ -0,0 +1,321 @@
+package imageformat
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"regexp"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
+)
+
+// Disk formats, which can be detected by Inspect. The values match the
+// Imageservice disk_format values.
+const (
+	FormatQCOW2 = "qcow2"
+	FormatRaw   = "raw"
+	FormatVMDK  = "vmdk"
+	FormatVHD   = "vhd"
+	FormatVHDX  = "vhdx"
+	FormatISO   = "iso"
+)
+
+// HeaderSize is the number of bytes read by Inspect from the beginning of
+// the image. It covers the ISO volume descriptors and the VHDX metadata
+// region of images created by the common tools.
+var HeaderSize = 4 << 20
+
+const (
+	qcow2IncompatDataFile   = 1 << 2
+	qcow2ExtEnd             = 0x00000000
+	qcow2ExtDataFile        = 0x44415441
+	qcow2ExtBackingFormat   = 0xe2792aca
+	qcow2V2HeaderLength     = 72
+	vhdDiskTypeDifferencing = 4
+	vhdxRegionTableOffset   = 0x30000
+	isoDescriptorOffset     = 0x8000
+)
+
+var (
+	qcow2Magic = []byte("QFI\xfb")
+	vmdkMagic  = []byte("KDMV")
+	vmdkText   = []byte("# Disk DescriptorFile")
+	vhdMagic   = []byte("conectix")
+	vhdxMagic  = []byte("vhdxfile")
+	isoMagic   = []byte("CD001")
+
+	vmdkCreateType = regexp.MustCompile(`(?m)^createType\s*=\s*"([^"]*)"`)
+
+	// vmdkSafeCreateTypes are the VMDK subformats, which keep all the data
+	// in a single file.
+	vmdkSafeCreateTypes = map[string]bool{
+		"monolithicSparse": true,
+		"streamOptimized":  true,
+	}
+
+	// The VHDX GUIDs in their on-disk byte order.
+	vhdxMetadataRegion  = []byte{0x06, 0xa2, 0x7c, 0x8b, 0x90, 0x47, 0x9a, 0x4b, 0xb8, 0xfe, 0x57, 0x5f, 0x05, 0x0f, 0x88, 0x6e}
+	vhdxVirtualDiskSize = []byte{0x24, 0x42, 0xa5, 0x2f, 0x1b, 0xcd, 0x76, 0x48, 0xb2, 0x11, 0x5d, 0xbe, 0xd8, 0x3b, 0xf4, 0xb8}
+	vhdxParentLocator   = []byte{0x2d, 0x5f, 0xd3, 0xa8, 0x0b, 0xb3, 0x4d, 0x45, 0xab, 0xf7, 0xd3, 0xd8, 0x48, 0x34, 0xab, 0x0c}
+)
+
+// Info describes an inspected image.
+type Info struct {
+	// Format is the detected disk format. Images, which don't match any of
+	// the known formats, are reported as raw.
+	Format string
+
+	// VirtualSize is the size of the disk in bytes as seen by the instance.
+	// It is zero, when it is unknown, e.g. for raw images.
+	VirtualSize int64
+
+	// BackingFile is whether the image depends on a backing or parent image,
+	// e.g. a qcow2 backing file or a differencing VHD.
+	BackingFile bool
+
+	// BackingFileName is the name of the backing file, if it is stored in
+	// the inspected header.
+	BackingFileName string
+
+	// DataFile is whether the image data is stored in an external file, e.g.
+	// a qcow2 external data file or a VMDK descriptor referencing extents.
+	DataFile bool
+
+	// Subformat is the format variant, e.g. the VMDK createType.
+	Subformat string
+}
+
+// Inspect reads the header of an image and detects its format. The returned
+// reader replays the inspected header followed by the rest of the data, so
+// it must be used instead of r, e.g. in imagedata.Upload.
+func Inspect(r io.Reader) (*Info, io.Reader, error) {
+	header := make([]byte, HeaderSize)
+	n, err := io.ReadFull(r, header)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil, nil, err
+	}
+	header = header[:n]
+
+	info := InspectHeader(header)
+	return info, io.MultiReader(bytes.NewReader(header), r), nil
+}
+
+// InspectHeader detects the format of an image from its header. The header
+// should contain at least HeaderSize bytes, unless the image is smaller.
+func InspectHeader(header []byte) *Info {
+	switch {
+	case bytes.HasPrefix(header, qcow2Magic):
+		return inspectQCOW2(header)
+	case bytes.HasPrefix(header, vmdkMagic):
+		return inspectVMDK(header)
+	case bytes.HasPrefix(header, vmdkText):
+		return inspectVMDKDescriptor(header)
+	case bytes.HasPrefix(header, vhdMagic):
+		return inspectVHD(header)
+	case bytes.HasPrefix(header, vhdxMagic):
+		return inspectVHDX(header)
+	case len(header) >= isoDescriptorOffset+6 && bytes.Equal(header[isoDescriptorOffset+1:isoDescriptorOffset+6], isoMagic):
+		return inspectISO(header)
+	}
+
+	return &Info{Format: FormatRaw}
+}
+
+// Check returns an ErrUnsafeImage error, when the image references data
+// outside of itself.
+func (i Info) Check() error {
+	switch {
+	case i.BackingFile:
+		return ErrUnsafeImage{Format: i.Format, Reason: "the image has a backing file"}
+	case i.DataFile:
+		return ErrUnsafeImage{Format: i.Format, Reason: "the image has an external data file"}
+	case i.Format == FormatVMDK && !vmdkSafeCreateTypes[i.Subformat]:
+		return ErrUnsafeImage{Format: i.Format, Reason: "unsupported createType " + i.Subformat}
+	}
+	return nil
+}
+
+// Apply checks that the image is safe and sets the disk format of opts. When
+// the disk format is already set, it must match the detected one. The ami,
+// ari and aki disk formats are accepted for raw images.
+func (i Info) Apply(opts *images.CreateOpts) error {
+	if err := i.Check(); err != nil {
+		return err
+	}
+
+	switch opts.DiskFormat {
+	case "":
+		opts.DiskFormat = i.Format
+	case i.Format:
+	case "ami", "ari", "aki":
+		if i.Format != FormatRaw {
+			return ErrFormatMismatch{Requested: opts.DiskFormat, Detected: i.Format}
+		}
+	default:
+		return ErrFormatMismatch{Requested: opts.DiskFormat, Detected: i.Format}
+	}
+
+	return nil
+}
+
+func inspectQCOW2(h []byte) *Info {
+	info := &Info{Format: FormatQCOW2}
+	if len(h) < qcow2V2HeaderLength {
+		return info
+	}
+
+	version := binary.BigEndian.Uint32(h[4:])
+	backingOffset := binary.BigEndian.Uint64(h[8:])
+	backingSize := binary.BigEndian.Uint32(h[16:])
+	info.VirtualSize = int64(binary.BigEndian.Uint64(h[24:]))
+
+	if backingOffset != 0 {
+		info.BackingFile = true
+		if backingOffset <= uint64(len(h)) && uint64(backingSize) <= uint64(len(h))-backingOffset {
+			info.BackingFileName = string(h[backingOffset : backingOffset+uint64(backingSize)])
+		}
+	}
+
+	headerLength := uint64(qcow2V2HeaderLength)
+	if version >= 3 && len(h) >= 104 {
+		if binary.BigEndian.Uint64(h[72:])&qcow2IncompatDataFile != 0 {
+			info.DataFile = true
+		}
+		headerLength = uint64(binary.BigEndian.Uint32(h[100:]))
+	}
+
+	// Walk the header extensions, which can reference an external data
+	// file even when the incompatible feature bit is not set.
+	for offset := headerLength; offset+8 <= uint64(len(h)); {
+		extType := binary.BigEndian.Uint32(h[offset:])
+		extLength := uint64(binary.BigEndian.Uint32(h[offset+4:]))
+		switch extType {
+		case qcow2ExtEnd:
+			return info
+		case qcow2ExtDataFile:
+			info.DataFile = true
+		case qcow2ExtBackingFormat:
+			if info.BackingFile && extLength <= uint64(len(h))-offset-8 {
+				info.Subformat = string(h[offset+8 : offset+8+extLength])
+			}
+		}
+		offset += 8 + (extLength+7)/8*8
+	}
+
+	return info
+}
+
+func inspectVMDK(h []byte) *Info {
+	info := &Info{Format: FormatVMDK}
+	if len(h) < 44 {
+		return info
+	}
+
+	info.VirtualSize = int64(binary.LittleEndian.Uint64(h[12:])) * 512
+
+	// The descriptor offset and size are in sectors. They are compared to
+	// the header length before being converted to bytes, as hostile values
+	// would overflow.
+	descSectors := binary.LittleEndian.Uint64(h[28:])
+	sizeSectors := binary.LittleEndian.Uint64(h[36:])
+	if descSectors > 0 && descSectors <= (uint64(len(h))-1)/512 {
+		descOffset := descSectors * 512
+		end := uint64(len(h))
+		if sizeSectors <= (end-descOffset)/512 {
+			end = descOffset + sizeSectors*512
+		}
+		if m := vmdkCreateType.FindSubmatch(h[descOffset:end]); m != nil {
+			info.Subformat = string(m[1])
+		}
+	}
+
+	return info
+}
+
+func inspectVMDKDescriptor(h []byte) *Info {
+	info := &Info{Format: FormatVMDK, DataFile: true}
+	if m := vmdkCreateType.FindSubmatch(h); m != nil {
+		info.Subformat = string(m[1])
+	}
+	return info
+}
+
+func inspectVHD(h []byte) *Info {
+	info := &Info{Format: FormatVHD}
+	if len(h) < 64 {
+		return info
+	}
+
+	info.VirtualSize = int64(binary.BigEndian.Uint64(h[48:]))
+	if binary.BigEndian.Uint32(h[60:]) == vhdDiskTypeDifferencing {
+		info.BackingFile = true
+	}
+	return info
+}
+
+func inspectVHDX(h []byte) *Info {
+	info := &Info{Format: FormatVHDX}
+
+	region := vhdxRegionTableOffset
+	if len(h) < region+16 || !bytes.Equal(h[region:region+4], []byte("regi")) {
+		return info
+	}
+
+	var metadataOffset uint64
+	count := int(binary.LittleEndian.Uint32(h[region+8:]))
+	for i := 0; i < count; i++ {
+		entry := region + 16 + i*32
+		if len(h) < entry+32 {
+			return info
+		}
+		if bytes.Equal(h[entry:entry+16], vhdxMetadataRegion) {
+			metadataOffset = binary.LittleEndian.Uint64(h[entry+16:])
+			break
+		}
+	}
+
+	// The offsets come from the image and are only compared to the length
+	// of the metadata region left in the header, as sums could overflow.
+	if metadataOffset == 0 || metadataOffset > uint64(len(h)) {
+		return info
+	}
+	metadata := h[metadataOffset:]
+	if len(metadata) < 32 || !bytes.Equal(metadata[:8], []byte("metadata")) {
+		return info
+	}
+
+	count = int(binary.LittleEndian.Uint16(metadata[10:]))
+	for i := 0; i < count; i++ {
+		entry := 32 + i*32
+		if len(metadata)-32 < entry {
+			return info
+		}
+		itemID := metadata[entry : entry+16]
+		itemOffset := uint64(binary.LittleEndian.Uint32(metadata[entry+16:]))
+		switch {
+		case bytes.Equal(itemID, vhdxVirtualDiskSize):
+			if itemOffset <= uint64(len(metadata)) && uint64(len(metadata))-itemOffset >= 8 {
+				info.VirtualSize = int64(binary.LittleEndian.Uint64(metadata[itemOffset:]))
+			}
+		case bytes.Equal(itemID, vhdxParentLocator):
+			info.BackingFile = true
+		}
+	}
+
+	return info
+}
+
+func inspectISO(h []byte) *Info {
+	info := &Info{Format: FormatISO}
+
+	// The primary volume descriptor has the type 1.
+	pvd := isoDescriptorOffset
+	if len(h) < pvd+130 || h[pvd] != 1 {
+		return info
+	}
+
+	blocks := int64(binary.LittleEndian.Uint32(h[pvd+80:]))
+	blockSize := int64(binary.LittleEndian.Uint16(h[pvd+128:]))
+	info.VirtualSize = blocks * blockSize
+	return info
+}
@@ -0,0 +1,2 @@
+// imageformat unit tests
+package testing
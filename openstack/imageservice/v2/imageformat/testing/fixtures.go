@@ -0,0 +1,154 @@
+package testing
+
+import (
+	"encoding/binary"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/imageformat"
+)
+
+// QCOW2Header returns a qcow2 version 3 header of a 1 GiB image with the
+// optional backing file and incompatible features.
+func QCOW2Header(backingFile string, incompatibleFeatures uint64) []byte {
+	h := make([]byte, 4096)
+	copy(h, "QFI\xfb")
+	binary.BigEndian.PutUint32(h[4:], 3)
+	if backingFile != "" {
+		binary.BigEndian.PutUint64(h[8:], 512)
+		binary.BigEndian.PutUint32(h[16:], uint32(len(backingFile)))
+		copy(h[512:], backingFile)
+	}
+	binary.BigEndian.PutUint32(h[20:], 16)
+	binary.BigEndian.PutUint64(h[24:], 1<<30)
+	binary.BigEndian.PutUint64(h[72:], incompatibleFeatures)
+	binary.BigEndian.PutUint32(h[100:], 104)
+	return h
+}
+
+// QCOW2DataFileHeader returns a qcow2 header with an external data file
+// header extension.
+func QCOW2DataFileHeader() []byte {
+	h := QCOW2Header("", 0)
+	binary.BigEndian.PutUint32(h[104:], 0x44415441)
+	binary.BigEndian.PutUint32(h[108:], 9)
+	copy(h[112:], "/dev/sda1")
+	return h
+}
+
+// VMDKHeader returns a sparse VMDK header of a 2 GiB image with an embedded
+// descriptor of the createType.
+func VMDKHeader(createType string) []byte {
+	h := make([]byte, 4096)
+	copy(h, "KDMV")
+	binary.LittleEndian.PutUint32(h[4:], 1)
+	binary.LittleEndian.PutUint64(h[12:], (2<<30)/512)
+	binary.LittleEndian.PutUint64(h[28:], 1)
+	binary.LittleEndian.PutUint64(h[36:], 2)
+	copy(h[512:], "# Disk DescriptorFile\nversion=1\ncreateType=\""+createType+"\"\n")
+	return h
+}
+
+// VHDHeader returns a VHD footer copy of a 512 MiB image of the disk type.
+func VHDHeader(diskType uint32) []byte {
+	h := make([]byte, 512)
+	copy(h, "conectix")
+	binary.BigEndian.PutUint64(h[48:], 512<<20)
+	binary.BigEndian.PutUint32(h[60:], diskType)
+	return h
+}
+
+// VHDXHeader returns a VHDX header of a 4 GiB image.
+func VHDXHeader() []byte {
+	const region = 0x30000
+	const metadata = 0x40000
+
+	h := make([]byte, metadata+0x10000)
+	copy(h, "vhdxfile")
+
+	copy(h[region:], "regi")
+	binary.LittleEndian.PutUint32(h[region+8:], 1)
+	copy(h[region+16:], []byte{0x06, 0xa2, 0x7c, 0x8b, 0x90, 0x47, 0x9a, 0x4b, 0xb8, 0xfe, 0x57, 0x5f, 0x05, 0x0f, 0x88, 0x6e})
+	binary.LittleEndian.PutUint64(h[region+32:], metadata)
+
+	copy(h[metadata:], "metadata")
+	binary.LittleEndian.PutUint16(h[metadata+10:], 1)
+	copy(h[metadata+32:], []byte{0x24, 0x42, 0xa5, 0x2f, 0x1b, 0xcd, 0x76, 0x48, 0xb2, 0x11, 0x5d, 0xbe, 0xd8, 0x3b, 0xf4, 0xb8})
+	binary.LittleEndian.PutUint32(h[metadata+48:], 0x1000)
+	binary.LittleEndian.PutUint64(h[metadata+0x1000:], 4<<30)
+	return h
+}
+
+// ISOHeader returns an ISO 9660 header of a 700 MiB image.
+func ISOHeader() []byte {
+	const pvd = 0x8000
+
+	h := make([]byte, pvd+2048)
+	h[pvd] = 1
+	copy(h[pvd+1:], "CD001")
+	binary.LittleEndian.PutUint32(h[pvd+80:], (700<<20)/2048)
+	binary.LittleEndian.PutUint16(h[pvd+128:], 2048)
+	return h
+}
+
+// withUint64 returns a copy of h with v written at offset in the byte order.
+func withUint64(h []byte, order binary.ByteOrder, offset int, v uint64) []byte {
+	c := append([]byte(nil), h...)
+	order.PutUint64(c[offset:], v)
+	return c
+}
+
+// withUint32 returns a copy of h with v written at offset in the byte order.
+func withUint32(h []byte, order binary.ByteOrder, offset int, v uint32) []byte {
+	c := append([]byte(nil), h...)
+	order.PutUint32(c[offset:], v)
+	return c
+}
+
+// MaliciousHeaders are headers with offsets and sizes crafted to overflow
+// the bounds checks of the inspector, mapped to the expected result.
+var MaliciousHeaders = []struct {
+	Name     string
+	Header   []byte
+	Expected imageformat.Info
+}{
+	{
+		Name:     "qcow2 backing file offset near 2^64",
+		Header:   withUint64(QCOW2Header("base.qcow2", 0), binary.BigEndian, 8, ^uint64(0)-4),
+		Expected: imageformat.Info{Format: "qcow2", VirtualSize: 1 << 30, BackingFile: true},
+	},
+	{
+		Name:     "qcow2 backing file size past the header",
+		Header:   withUint32(QCOW2Header("base.qcow2", 0), binary.BigEndian, 16, ^uint32(0)),
+		Expected: imageformat.Info{Format: "qcow2", VirtualSize: 1 << 30, BackingFile: true},
+	},
+	{
+		Name: "qcow2 backing format extension length past the header",
+		Header: withUint32(withUint32(QCOW2Header("base.qcow2", 0), binary.BigEndian, 104, 0xe2792aca),
+			binary.BigEndian, 108, ^uint32(0)),
+		Expected: imageformat.Info{Format: "qcow2", VirtualSize: 1 << 30, BackingFile: true, BackingFileName: "base.qcow2"},
+	},
+	{
+		Name:     "vmdk descriptor offset wrapping to the header",
+		Header:   withUint64(VMDKHeader("monolithicFlat"), binary.LittleEndian, 28, 1<<55+1),
+		Expected: imageformat.Info{Format: "vmdk", VirtualSize: 2 << 30},
+	},
+	{
+		Name:     "vmdk descriptor size wrapping the end",
+		Header:   withUint64(VMDKHeader("monolithicFlat"), binary.LittleEndian, 36, 1<<55-1),
+		Expected: imageformat.Info{Format: "vmdk", VirtualSize: 2 << 30, Subformat: "monolithicFlat"},
+	},
+	{
+		Name:     "vhdx metadata offset near 2^64",
+		Header:   withUint64(VHDXHeader(), binary.LittleEndian, 0x30000+32, ^uint64(0)-16),
+		Expected: imageformat.Info{Format: "vhdx"},
+	},
+	{
+		Name:     "vhdx metadata item offset past the header",
+		Header:   withUint32(VHDXHeader(), binary.LittleEndian, 0x40000+48, ^uint32(0)),
+		Expected: imageformat.Info{Format: "vhdx"},
+	},
+	{
+		Name:     "vhdx metadata entry count past the header",
+		Header:   withUint32(VHDXHeader(), binary.LittleEndian, 0x40000+8, 0xffff<<16),
+		Expected: imageformat.Info{Format: "vhdx", VirtualSize: 4 << 30},
+	},
+}
@@ -0,0 +1,143 @@
+package testing
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/imageformat"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestInspectHeader(t *testing.T) {
+	testCases := []struct {
+		name     string
+		header   []byte
+		expected imageformat.Info
+		safe     bool
+	}{
+		{
+			name:     "qcow2",
+			header:   QCOW2Header("", 0),
+			expected: imageformat.Info{Format: "qcow2", VirtualSize: 1 << 30},
+			safe:     true,
+		},
+		{
+			name:     "qcow2 with backing file",
+			header:   QCOW2Header("/etc/shadow", 0),
+			expected: imageformat.Info{Format: "qcow2", VirtualSize: 1 << 30, BackingFile: true, BackingFileName: "/etc/shadow"},
+		},
+		{
+			name:     "qcow2 with data file feature",
+			header:   QCOW2Header("", 1<<2),
+			expected: imageformat.Info{Format: "qcow2", VirtualSize: 1 << 30, DataFile: true},
+		},
+		{
+			name:     "qcow2 with data file extension",
+			header:   QCOW2DataFileHeader(),
+			expected: imageformat.Info{Format: "qcow2", VirtualSize: 1 << 30, DataFile: true},
+		},
+		{
+			name:     "vmdk",
+			header:   VMDKHeader("monolithicSparse"),
+			expected: imageformat.Info{Format: "vmdk", VirtualSize: 2 << 30, Subformat: "monolithicSparse"},
+			safe:     true,
+		},
+		{
+			name:     "vmdk with flat extents",
+			header:   VMDKHeader("monolithicFlat"),
+			expected: imageformat.Info{Format: "vmdk", VirtualSize: 2 << 30, Subformat: "monolithicFlat"},
+		},
+		{
+			name:     "vmdk descriptor",
+			header:   []byte("# Disk DescriptorFile\ncreateType=\"vmfs\"\nRW 100 VMFS \"/dev/sda\"\n"),
+			expected: imageformat.Info{Format: "vmdk", DataFile: true, Subformat: "vmfs"},
+		},
+		{
+			name:     "vhd",
+			header:   VHDHeader(3),
+			expected: imageformat.Info{Format: "vhd", VirtualSize: 512 << 20},
+			safe:     true,
+		},
+		{
+			name:     "differencing vhd",
+			header:   VHDHeader(4),
+			expected: imageformat.Info{Format: "vhd", VirtualSize: 512 << 20, BackingFile: true},
+		},
+		{
+			name:     "vhdx",
+			header:   VHDXHeader(),
+			expected: imageformat.Info{Format: "vhdx", VirtualSize: 4 << 30},
+			safe:     true,
+		},
+		{
+			name:     "iso",
+			header:   ISOHeader(),
+			expected: imageformat.Info{Format: "iso", VirtualSize: 700 << 20},
+			safe:     true,
+		},
+		{
+			name:     "raw",
+			header:   make([]byte, 1024),
+			expected: imageformat.Info{Format: "raw"},
+			safe:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			info := imageformat.InspectHeader(tc.header)
+			th.AssertDeepEquals(t, tc.expected, *info)
+
+			err := info.Check()
+			if tc.safe {
+				th.AssertNoErr(t, err)
+			} else {
+				th.CheckErr(t, err, &imageformat.ErrUnsafeImage{})
+			}
+		})
+	}
+}
+
+func TestInspectHeaderMalicious(t *testing.T) {
+	for _, tc := range MaliciousHeaders {
+		t.Run(tc.Name, func(t *testing.T) {
+			info := imageformat.InspectHeader(tc.Header)
+			th.AssertDeepEquals(t, tc.Expected, *info)
+		})
+	}
+}
+
+func TestInspect(t *testing.T) {
+	data := append(QCOW2Header("", 0), []byte("cluster data")...)
+
+	info, r, err := imageformat.Inspect(bytes.NewReader(data))
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "qcow2", info.Format)
+
+	replayed, err := io.ReadAll(r)
+	th.AssertNoErr(t, err)
+	th.AssertByteArrayEquals(t, data, replayed)
+}
+
+func TestApply(t *testing.T) {
+	info := imageformat.InspectHeader(QCOW2Header("", 0))
+
+	opts := images.CreateOpts{Name: "cirros"}
+	th.AssertNoErr(t, info.Apply(&opts))
+	th.AssertEquals(t, "qcow2", opts.DiskFormat)
+
+	opts = images.CreateOpts{Name: "cirros", DiskFormat: "raw"}
+	th.CheckErr(t, info.Apply(&opts), &imageformat.ErrFormatMismatch{})
+
+	raw := imageformat.InspectHeader(make([]byte, 512))
+	opts = images.CreateOpts{Name: "kernel", DiskFormat: "aki", ContainerFormat: "aki"}
+	th.AssertNoErr(t, raw.Apply(&opts))
+	th.AssertEquals(t, "aki", opts.DiskFormat)
+
+	unsafe := imageformat.InspectHeader(QCOW2Header("base.qcow2", 0))
+	opts = images.CreateOpts{Name: "cirros"}
+	th.CheckErr(t, unsafe.Apply(&opts), &imageformat.ErrUnsafeImage{})
+	th.AssertEquals(t, "", opts.DiskFormat)
+}
@@ -11,6 +11,9 @@ const (
 
 	// WebDownloadMethod represents web-download Import API method.
 	WebDownloadMethod ImportMethod = "web-download"
+
+	// CopyImageMethod represents copy-image Import API method.
+	CopyImageMethod ImportMethod = "copy-image"
 )
 
 // Get retrieves Import API information data.
@@ -28,7 +31,19 @@ type CreateOptsBuilder interface {
 // CreateOpts specifies parameters of a new image import.
 type CreateOpts struct {
 	Name ImportMethod `json:"name"`
-	URI  string       `json:"uri"`
+	URI  string       `json:"uri,omitempty"`
+
+	// Stores is a list of store identifiers, to which the image data is
+	// imported. It requires the multi-store support in the Image service.
+	Stores []string `json:"-"`
+
+	// AllStores specifies whether the image data is imported to all the
+	// available stores.
+	AllStores *bool `json:"-"`
+
+	// AllStoresMustSucceed specifies whether the import fails, when the image
+	// data cannot be imported to one of the stores.
+	AllStoresMustSucceed *bool `json:"-"`
 }
 
 // ToImportCreateMap constructs a request body from CreateOpts.
@@ -37,7 +52,18 @@ func (opts CreateOpts) ToImportCreateMap() (map[string]interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
-	return map[string]interface{}{"method": b}, nil
+
+	body := map[string]interface{}{"method": b}
+	if len(opts.Stores) > 0 {
+		body["stores"] = opts.Stores
+	}
+	if opts.AllStores != nil {
+		body["all_stores"] = *opts.AllStores
+	}
+	if opts.AllStoresMustSucceed != nil {
+		body["all_stores_must_succeed"] = *opts.AllStoresMustSucceed
+	}
+	return body, nil
 }
 
 // Create requests the creation of a new image import on the server.
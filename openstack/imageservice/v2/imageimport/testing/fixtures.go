@@ -23,3 +23,15 @@ const ImportCreateRequest = `
     }
 }
 `
+
+// ImportCreateCopyRequest represents a request to copy image data into
+// additional stores.
+const ImportCreateCopyRequest = `
+{
+    "method": {
+        "name": "copy-image"
+    },
+    "stores": ["ceph", "file"],
+    "all_stores_must_succeed": false
+}
+`
@@ -0,0 +1,64 @@
+/*
+Package importer creates Imageservice images and imports their data in a
+single call. It picks the import method advertised by the Imageservice,
+streams the data with progress reporting, verifies the checksums and waits
+until the image becomes active.
+
+Example to Create an Image from a Local File
+
+	f, err := os.Open("cirros-0.4.0-x86_64-disk.img")
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		panic(err)
+	}
+
+	createOpts := importer.CreateOpts{
+		Image: images.CreateOpts{
+			Name:            "cirros",
+			ContainerFormat: "bare",
+			DiskFormat:      "qcow2",
+		},
+		Data:   f,
+		Size:   fi.Size(),
+		Stores: []string{"ceph", "file"},
+		Progress: func(transferred, total int64) {
+			fmt.Printf("%d/%d bytes sent\n", transferred, total)
+		},
+	}
+
+	image, err := importer.Create(imagesClient, createOpts)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create an Image from a Remote Location
+
+	createOpts := importer.CreateOpts{
+		Image: images.CreateOpts{
+			Name:            "cirros",
+			ContainerFormat: "bare",
+			DiskFormat:      "qcow2",
+		},
+		URI: "http://download.cirros-cloud.net/0.4.0/cirros-0.4.0-x86_64-disk.img",
+	}
+
+	image, err := importer.Create(imagesClient, createOpts)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Copy an Image to Additional Stores
+
+	image, err := importer.Copy(imagesClient, "da3b75d9-3f4a-40e7-8a2c-bfab23927dea", importer.CopyOpts{
+		Stores: []string{"ceph", "file"},
+	})
+	if err, ok := err.(importer.ErrImportFailed); ok {
+		fmt.Printf("Failed stores: %v\n", err.FailedStores)
+	}
+*/
+package importer
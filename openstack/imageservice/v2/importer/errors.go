@@ -0,0 +1,59 @@
+package importer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/imageimport"
+)
+
+// ErrImportMethodNotSupported is the error when the requested import method
+// is not advertised by the Imageservice.
+type ErrImportMethodNotSupported struct {
+	gophercloud.BaseError
+	Method imageimport.ImportMethod
+}
+
+func (e ErrImportMethodNotSupported) Error() string {
+	return fmt.Sprintf("The %q import method is not supported by the Imageservice", e.Method)
+}
+
+// ErrChecksumMismatch is the error when the checksum reported by the
+// Imageservice doesn't match the checksum of the uploaded data.
+type ErrChecksumMismatch struct {
+	gophercloud.BaseError
+	ImageID  string
+	Algo     string
+	Expected string
+	Actual   string
+}
+
+func (e ErrChecksumMismatch) Error() string {
+	return fmt.Sprintf("The %s checksum of the image %s is %q, expected %q", e.Algo, e.ImageID, e.Actual, e.Expected)
+}
+
+// ErrImportFailed is the error when the image data could not be imported.
+// FailedStores contains the stores reported in the os_glance_failed_import
+// image property, and Message contains the message of the last failed
+// import task, if any.
+type ErrImportFailed struct {
+	gophercloud.BaseError
+	ImageID      string
+	Status       string
+	FailedStores []string
+	Message      string
+}
+
+func (e ErrImportFailed) Error() string {
+	msg := fmt.Sprintf("Import of the image %s failed", e.ImageID)
+	if len(e.FailedStores) > 0 {
+		msg += fmt.Sprintf(" for stores %s", strings.Join(e.FailedStores, ", "))
+	} else if e.Status != "" {
+		msg += fmt.Sprintf(" with status %q", e.Status)
+	}
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	return msg
+}
@@ -0,0 +1,476 @@
+package importer
+
+import (
+	"crypto/md5"
+	"crypto/sha512"
+	"encoding/hex"
+	"hash"
+	"io"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/imagedata"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/imageformat"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/imageimport"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/tasks"
+)
+
+const (
+	// defaultTimeout is the default number of seconds to wait for an image
+	// to become active.
+	defaultTimeout = 3600
+
+	failedImportProperty    = "os_glance_failed_import"
+	importingStoresProperty = "os_glance_importing_to_stores"
+	hashAlgoProperty        = "os_hash_algo"
+	hashValueProperty       = "os_hash_value"
+)
+
+// ProgressFunc is called every time a chunk of the image data has been sent
+// to the Imageservice. Total is zero, when the size of the data is unknown.
+type ProgressFunc func(transferred, total int64)
+
+// CreateOpts specifies how a new image is created and how its data is
+// imported.
+type CreateOpts struct {
+	// (REQUIRED) Image contains the options used to create the image record.
+	Image images.CreateOptsBuilder
+
+	// Method is the import method. If empty, it is picked from the methods
+	// advertised by the Imageservice: web-download is used when URI is set,
+	// glance-direct when Data is set. When glance-direct is not advertised,
+	// Data is uploaded directly using imagedata.Upload.
+	Method imageimport.ImportMethod
+
+	// Data is the image data used by the glance-direct method or by the
+	// direct upload.
+	Data io.Reader
+
+	// Size is the size of Data in bytes. It is only used to report the
+	// progress.
+	Size int64
+
+	// URI is the location of the image data used by the web-download method.
+	URI string
+
+	// Stores is a list of store identifiers, to which the image data is
+	// imported.
+	Stores []string
+
+	// AllStores specifies whether the image data is imported to all the
+	// available stores.
+	AllStores *bool
+
+	// AllStoresMustSucceed specifies whether the import fails, when the image
+	// data cannot be imported to one of the stores.
+	AllStoresMustSucceed *bool
+
+	// InspectFormat enables the detection of the disk format of Data. Unsafe
+	// images, e.g. qcow2 images with backing files, are rejected before the
+	// image is created. When Image is an images.CreateOpts, its DiskFormat is
+	// set to the detected format, unless it is already set.
+	InspectFormat bool
+
+	// Progress is called while Data is being sent.
+	Progress ProgressFunc
+
+	// HashValue is the expected sha512 os_hash_value of the image. When Data
+	// is set, the checksums of the sent data are always verified.
+	HashValue string
+
+	// Timeout is the number of seconds to wait for the image to become
+	// active. Defaults to one hour.
+	Timeout int
+}
+
+// Create creates an image, sends or imports its data using the requested or
+// the best advertised import method, and waits until the image becomes
+// active. The checksums reported by the Imageservice are verified against
+// the sent data.
+//
+// When the image is active, but the import failed for some of the stores,
+// the image is returned together with an ErrImportFailed error. The created
+// image is also returned together with any other error, which occurred after
+// its creation, so that it can be cleaned up.
+func Create(client *gophercloud.ServiceClient, opts CreateOpts) (*images.Image, error) {
+	if opts.Image == nil {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "importer.CreateOpts.Image"
+		return nil, err
+	}
+	if opts.Data == nil && opts.URI == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "importer.CreateOpts.Data/importer.CreateOpts.URI"
+		return nil, err
+	}
+
+	if opts.Data != nil && opts.InspectFormat {
+		info, data, err := imageformat.Inspect(opts.Data)
+		if err != nil {
+			return nil, err
+		}
+		opts.Data = data
+
+		if createOpts, ok := opts.Image.(images.CreateOpts); ok {
+			if err := info.Apply(&createOpts); err != nil {
+				return nil, err
+			}
+			opts.Image = createOpts
+		} else if err := info.Check(); err != nil {
+			return nil, err
+		}
+	}
+
+	method, err := chooseMethod(client, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	image, err := images.Create(client, opts.Image).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	var sums *checksums
+	if opts.Data != nil {
+		sums = newChecksums()
+		data := &progressReader{
+			reader:   io.TeeReader(opts.Data, sums),
+			total:    opts.Size,
+			progress: opts.Progress,
+		}
+
+		if method == "" {
+			err = imagedata.Upload(client, image.ID, data).ExtractErr()
+		} else {
+			err = imagedata.Stage(client, image.ID, data).ExtractErr()
+		}
+		if err != nil {
+			return image, err
+		}
+	}
+
+	if method != "" {
+		importOpts := imageimport.CreateOpts{
+			Name:                 method,
+			URI:                  opts.URI,
+			Stores:               opts.Stores,
+			AllStores:            opts.AllStores,
+			AllStoresMustSucceed: opts.AllStoresMustSucceed,
+		}
+		err = imageimport.Create(client, image.ID, importOpts).ExtractErr()
+		if err != nil {
+			return image, err
+		}
+	}
+
+	active, err := WaitForImport(client, image.ID, opts.Timeout)
+	if active == nil {
+		return image, err
+	}
+	image = active
+
+	if sums != nil {
+		if verr := sums.verify(image); verr != nil {
+			return image, verr
+		}
+	}
+	if opts.HashValue != "" {
+		if verr := verifyHashValue(image, opts.HashValue); verr != nil {
+			return image, verr
+		}
+	}
+
+	return image, err
+}
+
+// CopyOpts specifies the stores, to which the data of an existing image is
+// copied.
+type CopyOpts struct {
+	// Stores is a list of store identifiers, to which the image data is
+	// copied.
+	Stores []string
+
+	// AllStores specifies whether the image data is copied to all the
+	// available stores.
+	AllStores *bool
+
+	// AllStoresMustSucceed specifies whether the copy fails, when the image
+	// data cannot be copied to one of the stores.
+	AllStoresMustSucceed *bool
+
+	// Timeout is the number of seconds to wait for the copy to finish.
+	// Defaults to one hour.
+	Timeout int
+}
+
+// Copy copies the data of an active image to additional stores using the
+// copy-image import method and waits until the copy finishes.
+func Copy(client *gophercloud.ServiceClient, imageID string, opts CopyOpts) (*images.Image, error) {
+	if len(opts.Stores) == 0 && (opts.AllStores == nil || !*opts.AllStores) {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "importer.CopyOpts.Stores"
+		return nil, err
+	}
+
+	err := checkMethod(client, imageimport.CopyImageMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	// The image may have tasks of earlier imports, which must not be
+	// mistaken for the task of the copy.
+	previous := taskIDs(client, imageID)
+
+	importOpts := imageimport.CreateOpts{
+		Name:                 imageimport.CopyImageMethod,
+		Stores:               opts.Stores,
+		AllStores:            opts.AllStores,
+		AllStoresMustSucceed: opts.AllStoresMustSucceed,
+	}
+	err = imageimport.Create(client, imageID, importOpts).ExtractErr()
+	if err != nil {
+		return nil, err
+	}
+
+	return waitForImport(client, imageID, opts.Timeout, previous)
+}
+
+// WaitForImport polls an image until it becomes active and no store import
+// is in progress anymore. It will do this for at most the number of seconds
+// specified, or one hour if secs is zero.
+//
+// When the import failed, an ErrImportFailed error is returned. This includes
+// a glance-direct or web-download import, which returned the image to the
+// queued status with a failed import task. The error contains the stores
+// reported in the os_glance_failed_import property and the message of the
+// failed import task. The image is returned as well, when it is active and
+// only some stores failed.
+//
+// All the tasks of the image are considered, so a failed task of an earlier
+// import of the same image may be reported. Create and Copy only consider the
+// tasks of their own import.
+func WaitForImport(client *gophercloud.ServiceClient, imageID string, secs int) (*images.Image, error) {
+	return waitForImport(client, imageID, secs, nil)
+}
+
+// waitForImport implements WaitForImport, ignoring the image tasks whose ID
+// is in previous.
+func waitForImport(client *gophercloud.ServiceClient, imageID string, secs int, previous map[string]bool) (*images.Image, error) {
+	if secs == 0 {
+		secs = defaultTimeout
+	}
+
+	var image *images.Image
+	err := gophercloud.WaitFor(secs, func() (bool, error) {
+		current, err := images.Get(client, imageID).Extract()
+		if err != nil {
+			return false, err
+		}
+		image = current
+
+		switch current.Status {
+		case images.ImageStatusKilled, images.ImageStatusDeleted, images.ImageStatusPendingDelete:
+			return false, importFailed(client, current, previous)
+		case images.ImageStatusActive:
+			return len(stringList(current.Properties[importingStoresProperty])) == 0, nil
+		case images.ImageStatusQueued:
+			// A failed glance-direct or web-download import returns the
+			// image to queued instead of killing it.
+			if len(stringList(current.Properties[failedImportProperty])) > 0 {
+				return false, importFailed(client, current, previous)
+			}
+			if task := newestTask(client, imageID, previous); task != nil && task.Status == string(tasks.TaskStatusFailure) {
+				return false, importFailed(client, current, previous)
+			}
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(stringList(image.Properties[failedImportProperty])) > 0 {
+		return image, importFailed(client, image, previous)
+	}
+
+	return image, nil
+}
+
+// importFailed builds an ErrImportFailed from the image properties and the
+// message of the newest image task, when it failed.
+func importFailed(client *gophercloud.ServiceClient, image *images.Image, previous map[string]bool) error {
+	err := ErrImportFailed{
+		ImageID:      image.ID,
+		Status:       string(image.Status),
+		FailedStores: stringList(image.Properties[failedImportProperty]),
+	}
+
+	if task := newestTask(client, image.ID, previous); task != nil && task.Status == string(tasks.TaskStatusFailure) {
+		err.Message = task.Message
+	}
+
+	return err
+}
+
+// newestTask returns the most recently created task of an image, ignoring
+// the tasks whose ID is in previous. The image tasks API does not sort the
+// tasks. It returns nil when there is no such task, or when the optional
+// image tasks API is not available.
+func newestTask(client *gophercloud.ServiceClient, imageID string, previous map[string]bool) *tasks.Task {
+	imageTasks, err := tasks.ListByImage(client, imageID).Extract()
+	if err != nil {
+		return nil
+	}
+
+	var newest *tasks.Task
+	for i := range imageTasks {
+		if previous[imageTasks[i].ID] {
+			continue
+		}
+		if newest == nil || !imageTasks[i].CreatedAt.Before(newest.CreatedAt) {
+			newest = &imageTasks[i]
+		}
+	}
+	return newest
+}
+
+// taskIDs returns the IDs of the current tasks of an image. The image tasks
+// are compared by ID rather than by creation time, as the clocks of the
+// client and the Imageservice may differ.
+func taskIDs(client *gophercloud.ServiceClient, imageID string) map[string]bool {
+	ids := make(map[string]bool)
+
+	// The image tasks API is optional, so its errors are ignored.
+	imageTasks, _ := tasks.ListByImage(client, imageID).Extract()
+	for _, task := range imageTasks {
+		ids[task.ID] = true
+	}
+	return ids
+}
+
+// chooseMethod returns the import method used to create the image. An empty
+// method means a direct upload using imagedata.Upload.
+func chooseMethod(client *gophercloud.ServiceClient, opts CreateOpts) (imageimport.ImportMethod, error) {
+	if opts.Method != "" {
+		return opts.Method, checkMethod(client, opts.Method)
+	}
+
+	if opts.URI != "" {
+		return imageimport.WebDownloadMethod, checkMethod(client, imageimport.WebDownloadMethod)
+	}
+
+	methods, err := importMethods(client)
+	if err != nil {
+		return "", err
+	}
+	if methods[imageimport.GlanceDirectMethod] {
+		return imageimport.GlanceDirectMethod, nil
+	}
+	if len(opts.Stores) > 0 || opts.AllStores != nil {
+		return "", ErrImportMethodNotSupported{Method: imageimport.GlanceDirectMethod}
+	}
+
+	return "", nil
+}
+
+// checkMethod returns an error, when the method is not advertised by the
+// Imageservice.
+func checkMethod(client *gophercloud.ServiceClient, method imageimport.ImportMethod) error {
+	methods, err := importMethods(client)
+	if err != nil {
+		return err
+	}
+	if !methods[method] {
+		return ErrImportMethodNotSupported{Method: method}
+	}
+	return nil
+}
+
+func importMethods(client *gophercloud.ServiceClient) (map[imageimport.ImportMethod]bool, error) {
+	info, err := imageimport.Get(client).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	methods := make(map[imageimport.ImportMethod]bool, len(info.ImportMethods.Value))
+	for _, v := range info.ImportMethods.Value {
+		methods[imageimport.ImportMethod(v)] = true
+	}
+	return methods, nil
+}
+
+// stringList converts a comma separated image property into a slice.
+func stringList(v interface{}) []string {
+	s, _ := v.(string)
+	var list []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+func verifyHashValue(image *images.Image, expected string) error {
+	algo, _ := image.Properties[hashAlgoProperty].(string)
+	actual, _ := image.Properties[hashValueProperty].(string)
+	if actual != expected {
+		return ErrChecksumMismatch{ImageID: image.ID, Algo: algo, Expected: expected, Actual: actual}
+	}
+	return nil
+}
+
+// checksums calculates the md5 checksum and the sha512 os_hash_value of the
+// sent data.
+type checksums struct {
+	md5    hash.Hash
+	sha512 hash.Hash
+}
+
+func newChecksums() *checksums {
+	return &checksums{md5: md5.New(), sha512: sha512.New()}
+}
+
+func (c *checksums) Write(p []byte) (int, error) {
+	c.md5.Write(p)
+	return c.sha512.Write(p)
+}
+
+// verify compares the calculated checksums with the ones reported by the
+// Imageservice. Checksums, which aren't reported, are skipped.
+func (c *checksums) verify(image *images.Image) error {
+	if sum := hex.EncodeToString(c.md5.Sum(nil)); image.Checksum != "" && image.Checksum != sum {
+		return ErrChecksumMismatch{ImageID: image.ID, Algo: "md5", Expected: sum, Actual: image.Checksum}
+	}
+
+	if algo, _ := image.Properties[hashAlgoProperty].(string); algo == "sha512" {
+		if sum := hex.EncodeToString(c.sha512.Sum(nil)); image.Properties[hashValueProperty] != sum {
+			return verifyHashValue(image, sum)
+		}
+	}
+
+	return nil
+}
+
+// progressReader reports the number of bytes read from the underlying
+// reader.
+type progressReader struct {
+	reader      io.Reader
+	total       int64
+	transferred int64
+	progress    ProgressFunc
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		r.transferred += int64(n)
+		if r.progress != nil {
+			r.progress(r.transferred, r.total)
+		}
+	}
+	return n, err
+}
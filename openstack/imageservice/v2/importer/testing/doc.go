@@ -0,0 +1,2 @@
+// importer unit tests
+package testing
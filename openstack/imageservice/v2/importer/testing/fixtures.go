@@ -0,0 +1,314 @@
+package testing
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fakeclient "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ImageID is the identifier of the image used in the tests.
+const ImageID = "da3b75d9-3f4a-40e7-8a2c-bfab23927dea"
+
+// ImageData is the image data sent in the tests.
+const ImageData = "gophercloud image data"
+
+// ImportInfoResult represents raw server response on an import info request.
+const ImportInfoResult = `
+{
+    "import-methods": {
+        "description": "Import methods available.",
+        "type": "array",
+        "value": [
+            "glance-direct",
+            "copy-image"
+        ]
+    }
+}
+`
+
+// ImageCreateRequest represents a request to create an image.
+const ImageCreateRequest = `
+{
+    "name": "cirros",
+    "container_format": "bare",
+    "disk_format": "qcow2"
+}
+`
+
+// ImageQueuedResult represents raw server response on a request to create
+// an image.
+const ImageQueuedResult = `
+{
+    "id": "da3b75d9-3f4a-40e7-8a2c-bfab23927dea",
+    "name": "cirros",
+    "status": "queued",
+    "container_format": "bare",
+    "disk_format": "qcow2",
+    "visibility": "private"
+}
+`
+
+// ImageActiveResult represents raw server response on a request to get an
+// active image.
+const ImageActiveResult = `
+{
+    "id": "da3b75d9-3f4a-40e7-8a2c-bfab23927dea",
+    "name": "cirros",
+    "status": "active",
+    "container_format": "bare",
+    "disk_format": "qcow2",
+    "visibility": "private",
+    "size": 22,
+    "checksum": "65c174e132a5ac03696c3210231f997b",
+    "os_hash_algo": "sha512",
+    "os_hash_value": "819e1337d5032fb0785e2acee4d75975c93f18278e5aa549e96b70420ecea94df01c56043a6f48f4e416ff646a23580de496a0271427b243ab1c1d4468c84159",
+    "stores": "file",
+    "os_glance_importing_to_stores": "",
+    "os_glance_failed_import": ""
+}
+`
+
+// ImageFailedStoreResult represents raw server response on a request to get
+// an image, which could not be copied to one of the stores.
+const ImageFailedStoreResult = `
+{
+    "id": "da3b75d9-3f4a-40e7-8a2c-bfab23927dea",
+    "name": "cirros",
+    "status": "active",
+    "container_format": "bare",
+    "disk_format": "qcow2",
+    "visibility": "private",
+    "stores": "file",
+    "os_glance_importing_to_stores": "",
+    "os_glance_failed_import": "ceph"
+}
+`
+
+// ImageImportingResult represents raw server response on a request to get an
+// image, which is being imported.
+const ImageImportingResult = `
+{
+    "id": "da3b75d9-3f4a-40e7-8a2c-bfab23927dea",
+    "name": "cirros",
+    "status": "importing",
+    "container_format": "bare",
+    "disk_format": "qcow2",
+    "visibility": "private",
+    "os_glance_importing_to_stores": "file",
+    "os_glance_failed_import": ""
+}
+`
+
+// ImageImportFailedResult represents raw server response on a request to get
+// an image, whose import failed and which went back to the queued status.
+const ImageImportFailedResult = `
+{
+    "id": "da3b75d9-3f4a-40e7-8a2c-bfab23927dea",
+    "name": "cirros",
+    "status": "queued",
+    "container_format": "bare",
+    "disk_format": "qcow2",
+    "visibility": "private",
+    "os_glance_importing_to_stores": "",
+    "os_glance_failed_import": ""
+}
+`
+
+// ImageTasksResult represents raw server response on a request to list the
+// tasks of an image.
+const ImageTasksResult = `
+{
+    "tasks": [
+        {
+            "id": "ee22890e-8948-4ea6-9668-831f973c84f5",
+            "image_id": "da3b75d9-3f4a-40e7-8a2c-bfab23927dea",
+            "type": "api_image_import",
+            "status": "failure",
+            "message": "Ceph cluster is not reachable"
+        }
+    ]
+}
+`
+
+// ImageTasksEmptyResult represents raw server response on a request to list
+// the tasks of an image, which has none.
+const ImageTasksEmptyResult = `
+{
+    "tasks": []
+}
+`
+
+// ImageTasksUnsortedResult represents raw server response on a request to
+// list the tasks of an image, whose newest task is not the last one.
+const ImageTasksUnsortedResult = `
+{
+    "tasks": [
+        {
+            "id": "ee22890e-8948-4ea6-9668-831f973c84f5",
+            "image_id": "da3b75d9-3f4a-40e7-8a2c-bfab23927dea",
+            "type": "api_image_import",
+            "status": "failure",
+            "message": "Ceph cluster is not reachable",
+            "created_at": "2024-03-02T10:00:00Z"
+        },
+        {
+            "id": "4c1e1b4e-2d1f-4b5e-9a0d-7d8e2f7e5c3a",
+            "image_id": "da3b75d9-3f4a-40e7-8a2c-bfab23927dea",
+            "type": "api_image_import",
+            "status": "failure",
+            "message": "Remote server returned 404",
+            "created_at": "2024-03-01T10:00:00Z"
+        }
+    ]
+}
+`
+
+// ImageTasksPreviousResult represents raw server response on a request to
+// list the tasks of an image, with the failed task of a previous import.
+const ImageTasksPreviousResult = `
+{
+    "tasks": [
+        {
+            "id": "4c1e1b4e-2d1f-4b5e-9a0d-7d8e2f7e5c3a",
+            "image_id": "da3b75d9-3f4a-40e7-8a2c-bfab23927dea",
+            "type": "api_image_import",
+            "status": "failure",
+            "message": "Remote server returned 404",
+            "created_at": "2024-03-01T10:00:00Z"
+        }
+    ]
+}
+`
+
+// ImportCopyRequest represents a request to copy an image to a store.
+const ImportCopyRequest = `
+{
+    "method": {
+        "name": "copy-image"
+    },
+    "stores": ["ceph"]
+}
+`
+
+// ImportGlanceDirectRequest represents a request to import a staged image.
+const ImportGlanceDirectRequest = `
+{
+    "method": {
+        "name": "glance-direct"
+    }
+}
+`
+
+// HandleImportInfoSuccessfully sets up the test server to respond to an
+// import info request.
+func HandleImportInfoSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/info/import", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ImportInfoResult)
+	})
+}
+
+// HandleImageCreateSuccessfully sets up the test server to respond to an
+// image create request.
+func HandleImageCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/images", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestJSONRequest(t, r, ImageCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, ImageQueuedResult)
+	})
+}
+
+// HandleImageStageSuccessfully sets up the test server to respond to an
+// image stage request.
+func HandleImageStageSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/images/"+ImageID+"/stage", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/octet-stream")
+
+		b, err := io.ReadAll(r.Body)
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, ImageData, string(b))
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleImageImportSuccessfully sets up the test server to respond to an
+// image import request.
+func HandleImageImportSuccessfully(t *testing.T, request string) {
+	th.Mux.HandleFunc("/images/"+ImageID+"/import", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestJSONRequest(t, r, request)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+// HandleImageGetSuccessfully sets up the test server to respond to an image
+// get request.
+func HandleImageGetSuccessfully(t *testing.T, response string) {
+	th.Mux.HandleFunc("/images/"+ImageID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, response)
+	})
+}
+
+// HandleImageGetSequence sets up the test server to respond to successive
+// image get requests with the responses in order. The last response is
+// repeated.
+func HandleImageGetSequence(t *testing.T, responses ...string) {
+	calls := 0
+	th.Mux.HandleFunc("/images/"+ImageID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		response := responses[len(responses)-1]
+		if calls < len(responses) {
+			response = responses[calls]
+		}
+		calls++
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, response)
+	})
+}
+
+// HandleImageTasksSequence sets up the test server to respond to successive
+// image tasks requests with the responses in order. The last response is
+// repeated.
+func HandleImageTasksSequence(t *testing.T, responses ...string) {
+	calls := 0
+	th.Mux.HandleFunc("/images/"+ImageID+"/tasks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		response := responses[len(responses)-1]
+		if calls < len(responses) {
+			response = responses[calls]
+		}
+		calls++
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, response)
+	})
+}
@@ -0,0 +1,158 @@
+package testing
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/imageformat"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/imageimport"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/importer"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fakeclient "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestCreateGlanceDirect(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleImportInfoSuccessfully(t)
+	HandleImageCreateSuccessfully(t)
+	HandleImageStageSuccessfully(t)
+	HandleImageImportSuccessfully(t, ImportGlanceDirectRequest)
+	HandleImageGetSuccessfully(t, ImageActiveResult)
+
+	var transferred, total int64
+	image, err := importer.Create(fakeclient.ServiceClient(), importer.CreateOpts{
+		Image: images.CreateOpts{
+			Name:            "cirros",
+			ContainerFormat: "bare",
+			DiskFormat:      "qcow2",
+		},
+		Data: strings.NewReader(ImageData),
+		Size: int64(len(ImageData)),
+		Progress: func(t, s int64) {
+			transferred, total = t, s
+		},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, images.ImageStatusActive, image.Status)
+	th.AssertEquals(t, int64(len(ImageData)), transferred)
+	th.AssertEquals(t, int64(len(ImageData)), total)
+}
+
+func TestCreateChecksumMismatch(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleImportInfoSuccessfully(t)
+	HandleImageCreateSuccessfully(t)
+	HandleImageImportSuccessfully(t, ImportGlanceDirectRequest)
+	HandleImageGetSuccessfully(t, ImageActiveResult)
+	HandleImageStageSuccessfully(t)
+
+	_, err := importer.Create(fakeclient.ServiceClient(), importer.CreateOpts{
+		Image: images.CreateOpts{
+			Name:            "cirros",
+			ContainerFormat: "bare",
+			DiskFormat:      "qcow2",
+		},
+		Data:      strings.NewReader(ImageData),
+		HashValue: "0123456789abcdef",
+	})
+	th.CheckErr(t, err, &importer.ErrChecksumMismatch{})
+}
+
+func TestCreateMethodNotSupported(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleImportInfoSuccessfully(t)
+
+	_, err := importer.Create(fakeclient.ServiceClient(), importer.CreateOpts{
+		Image: images.CreateOpts{
+			Name: "cirros",
+		},
+		URI: "http://download.cirros-cloud.net/0.4.0/cirros-0.4.0-x86_64-disk.img",
+	})
+
+	var expected importer.ErrImportMethodNotSupported
+	th.CheckErr(t, err, &expected)
+	th.AssertEquals(t, imageimport.WebDownloadMethod, expected.Method)
+}
+
+func TestCopyFailedStore(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleImportInfoSuccessfully(t)
+	HandleImageImportSuccessfully(t, ImportCopyRequest)
+	HandleImageGetSuccessfully(t, ImageFailedStoreResult)
+	HandleImageTasksSequence(t, ImageTasksEmptyResult, ImageTasksResult)
+
+	image, err := importer.Copy(fakeclient.ServiceClient(), ImageID, importer.CopyOpts{
+		Stores: []string{"ceph"},
+	})
+	th.AssertEquals(t, ImageID, image.ID)
+
+	var expected importer.ErrImportFailed
+	th.CheckErr(t, err, &expected)
+	th.AssertDeepEquals(t, []string{"ceph"}, expected.FailedStores)
+	th.AssertEquals(t, "Ceph cluster is not reachable", expected.Message)
+}
+
+// The task of the copy is not listed, so the failed task of the previous
+// import must not be reported.
+func TestCopyIgnoresPreviousTasks(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleImportInfoSuccessfully(t)
+	HandleImageImportSuccessfully(t, ImportCopyRequest)
+	HandleImageGetSuccessfully(t, ImageFailedStoreResult)
+	HandleImageTasksSequence(t, ImageTasksPreviousResult)
+
+	_, err := importer.Copy(fakeclient.ServiceClient(), ImageID, importer.CopyOpts{
+		Stores: []string{"ceph"},
+	})
+
+	var expected importer.ErrImportFailed
+	th.CheckErr(t, err, &expected)
+	th.AssertDeepEquals(t, []string{"ceph"}, expected.FailedStores)
+	th.AssertEquals(t, "", expected.Message)
+}
+
+func TestWaitForImportQueuedAfterFailure(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleImageGetSequence(t, ImageImportingResult, ImageImportFailedResult)
+	HandleImageTasksSequence(t, ImageTasksUnsortedResult)
+
+	_, err := importer.WaitForImport(fakeclient.ServiceClient(), ImageID, 10)
+
+	var expected importer.ErrImportFailed
+	th.CheckErr(t, err, &expected)
+	th.AssertEquals(t, "queued", expected.Status)
+	th.AssertEquals(t, "Ceph cluster is not reachable", expected.Message)
+}
+
+func TestCreateRejectsUnsafeImage(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	header := make([]byte, 512)
+	copy(header, "conectix")
+	header[63] = 4
+
+	_, err := importer.Create(fakeclient.ServiceClient(), importer.CreateOpts{
+		Image: images.CreateOpts{
+			Name:            "cirros",
+			ContainerFormat: "bare",
+		},
+		Data:          bytes.NewReader(header),
+		InspectFormat: true,
+	})
+	th.CheckErr(t, err, &imageformat.ErrUnsafeImage{})
+}
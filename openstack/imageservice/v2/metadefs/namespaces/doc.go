@@ -0,0 +1,81 @@
+/*
+Package namespaces enables management of the Imageservice metadata
+definition namespaces. A namespace contains the properties, objects and tags,
+which describe the metadata of the associated resource types.
+
+Example to List Namespaces
+
+	listOpts := namespaces.ListOpts{
+	  ResourceTypes: []string{"OS::Glance::Image"},
+	}
+
+	allPages, err := namespaces.List(imagesClient, listOpts).AllPages()
+	if err != nil {
+	  panic(err)
+	}
+
+	allNamespaces, err := namespaces.ExtractNamespaces(allPages)
+	if err != nil {
+	  panic(err)
+	}
+
+	for _, namespace := range allNamespaces {
+	  fmt.Printf("%+v\n", namespace)
+	}
+
+Example to Get a Namespace with the Properties Prefixed for a Resource Type
+
+	getOpts := namespaces.GetOpts{
+	  ResourceType: "OS::Glance::Image",
+	}
+
+	namespace, err := namespaces.Get(imagesClient, "OS::Compute::Watchdog", getOpts).Extract()
+	if err != nil {
+	  panic(err)
+	}
+
+Example to Create a Namespace
+
+	createOpts := namespaces.CreateOpts{
+	  Namespace:   "MyCompany::Compute",
+	  DisplayName: "My Company Compute Options",
+	  ResourceTypeAssociations: []resourcetypes.AssociateOpts{
+	    {
+	      Name:   "OS::Glance::Image",
+	      Prefix: "mycompany_",
+	    },
+	  },
+	  Properties: map[string]properties.CreateOpts{
+	    "backup_policy": {
+	      Title: "Backup Policy",
+	      Type:  properties.TypeString,
+	      Enum:  []string{"none", "daily", "weekly"},
+	    },
+	  },
+	}
+
+	namespace, err := namespaces.Create(imagesClient, createOpts).Extract()
+	if err != nil {
+	  panic(err)
+	}
+
+Example to Update a Namespace
+
+	updateOpts := namespaces.UpdateOpts{
+	  Namespace:   "MyCompany::Compute",
+	  DisplayName: "My Company Compute Settings",
+	}
+
+	namespace, err := namespaces.Update(imagesClient, "MyCompany::Compute", updateOpts).Extract()
+	if err != nil {
+	  panic(err)
+	}
+
+Example to Delete a Namespace
+
+	err := namespaces.Delete(imagesClient, "MyCompany::Compute").ExtractErr()
+	if err != nil {
+	  panic(err)
+	}
+*/
+package namespaces
@@ -0,0 +1,269 @@
+package namespaces
+
+import (
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/metadefs/objects"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/metadefs/properties"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/metadefs/resourcetypes"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Visibility represents the visibility of a namespace.
+type Visibility string
+
+const (
+	// VisibilityPublic makes the namespace visible to all the projects.
+	VisibilityPublic Visibility = "public"
+
+	// VisibilityPrivate makes the namespace visible to its owner only.
+	VisibilityPrivate Visibility = "private"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToNamespaceListQuery() (string, error)
+}
+
+// ListOpts allows the filtering, sorting and pagination of the namespaces.
+type ListOpts struct {
+	// Limit is the maximum number of namespaces to return.
+	Limit int `q:"limit"`
+
+	// Marker is the name of the last namespace of the previous page.
+	Marker string `q:"marker"`
+
+	// ResourceTypes filters the namespaces associated with any of the
+	// resource types.
+	ResourceTypes []string
+
+	// Visibility filters the namespaces by their visibility.
+	Visibility Visibility `q:"visibility"`
+
+	// SortKey sorts the namespaces by "namespace" or "created_at".
+	SortKey string `q:"sort_key"`
+
+	// SortDir sorts the namespaces in "asc" or "desc" order.
+	SortDir string `q:"sort_dir"`
+}
+
+// ToNamespaceListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToNamespaceListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+
+	if len(opts.ResourceTypes) > 0 {
+		params := q.Query()
+		params.Add("resource_types", strings.Join(opts.ResourceTypes, ","))
+		q.RawQuery = params.Encode()
+	}
+
+	return q.String(), nil
+}
+
+// List retrieves the namespaces.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToNamespaceListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return NamespacePage{
+			serviceURL:     c.ServiceURL(),
+			LinkedPageBase: pagination.LinkedPageBase{PageResult: r},
+		}
+	})
+}
+
+// GetOptsBuilder allows extensions to add additional parameters to the Get
+// request.
+type GetOptsBuilder interface {
+	ToNamespaceGetQuery() (string, error)
+}
+
+// GetOpts specifies the representation of a namespace.
+type GetOpts struct {
+	// ResourceType prefixes the property names with the prefix of the
+	// resource type association.
+	ResourceType string `q:"resource_type"`
+}
+
+// ToNamespaceGetQuery formats a GetOpts into a query string.
+func (opts GetOpts) ToNamespaceGetQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// Get retrieves a specific namespace together with its properties, objects
+// and tags.
+func Get(c *gophercloud.ServiceClient, namespace string, opts GetOptsBuilder) (r GetResult) {
+	url := resourceURL(c, namespace)
+	if opts != nil {
+		query, err := opts.ToNamespaceGetQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+	resp, err := c.Get(url, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToNamespaceCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters of a new namespace.
+type CreateOpts struct {
+	// Namespace is the unique name of the namespace.
+	Namespace string `json:"namespace" required:"true"`
+
+	// DisplayName is the human-readable name of the namespace.
+	DisplayName string `json:"display_name,omitempty"`
+
+	// Description is the description of the namespace.
+	Description string `json:"description,omitempty"`
+
+	// Visibility is the visibility of the namespace.
+	Visibility Visibility `json:"visibility,omitempty"`
+
+	// Protected is whether the namespace is protected from deletion.
+	Protected *bool `json:"protected,omitempty"`
+
+	// ResourceTypeAssociations is a list of the resource types associated
+	// with the namespace.
+	ResourceTypeAssociations []resourcetypes.AssociateOpts `json:"resource_type_associations,omitempty"`
+
+	// Properties contains the definitions of the namespace properties keyed
+	// by their names. The Name field of the definitions is ignored.
+	Properties map[string]properties.CreateOpts `json:"-"`
+
+	// Objects is a list of the namespace objects.
+	Objects []objects.CreateOpts `json:"-"`
+
+	// Tags is a list of the namespace tag names.
+	Tags []string `json:"-"`
+}
+
+// ToNamespaceCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToNamespaceCreateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(opts.Properties) > 0 {
+		props := make(map[string]interface{}, len(opts.Properties))
+		for name, prop := range opts.Properties {
+			prop.Name = name
+			p, err := prop.ToPropertyCreateMap()
+			if err != nil {
+				return nil, err
+			}
+			delete(p, "name")
+			props[name] = p
+		}
+		b["properties"] = props
+	}
+
+	if len(opts.Objects) > 0 {
+		objs := make([]interface{}, 0, len(opts.Objects))
+		for _, obj := range opts.Objects {
+			o, err := obj.ToObjectCreateMap()
+			if err != nil {
+				return nil, err
+			}
+			objs = append(objs, o)
+		}
+		b["objects"] = objs
+	}
+
+	if len(opts.Tags) > 0 {
+		tags := make([]map[string]string, 0, len(opts.Tags))
+		for _, name := range opts.Tags {
+			tags = append(tags, map[string]string{"name": name})
+		}
+		b["tags"] = tags
+	}
+
+	return b, nil
+}
+
+// Create requests the creation of a new namespace.
+func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToNamespaceCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(listURL(c), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToNamespaceUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies the new attributes of a namespace. The Imageservice
+// replaces all the attributes, so all the fields must be set. The namespace
+// is renamed, when Namespace differs from the current name.
+type UpdateOpts struct {
+	// Namespace is the unique name of the namespace.
+	Namespace string `json:"namespace" required:"true"`
+
+	// DisplayName is the human-readable name of the namespace.
+	DisplayName string `json:"display_name,omitempty"`
+
+	// Description is the description of the namespace.
+	Description string `json:"description,omitempty"`
+
+	// Visibility is the visibility of the namespace.
+	Visibility Visibility `json:"visibility,omitempty"`
+
+	// Protected is whether the namespace is protected from deletion.
+	Protected *bool `json:"protected,omitempty"`
+}
+
+// ToNamespaceUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToNamespaceUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update replaces the attributes of a namespace.
+func Update(c *gophercloud.ServiceClient, namespace string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToNamespaceUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(resourceURL(c, namespace), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete removes a namespace together with its properties, objects and
+// tags.
+func Delete(c *gophercloud.ServiceClient, namespace string) (r DeleteResult) {
+	resp, err := c.Delete(resourceURL(c, namespace), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
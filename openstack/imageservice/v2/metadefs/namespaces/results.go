@@ -0,0 +1,158 @@
+package namespaces
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/metadefs/objects"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/metadefs/properties"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/metadefs/resourcetypes"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/metadefs/tags"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Namespace represents a metadata definition namespace.
+type Namespace struct {
+	// Namespace is the unique name of the namespace.
+	Namespace string `json:"namespace"`
+
+	// DisplayName is the human-readable name of the namespace.
+	DisplayName string `json:"display_name"`
+
+	// Description is the description of the namespace.
+	Description string `json:"description"`
+
+	// Visibility is the visibility of the namespace.
+	Visibility Visibility `json:"visibility"`
+
+	// Protected is whether the namespace is protected from deletion.
+	Protected bool `json:"protected"`
+
+	// Owner is the identifier of the project, which owns the namespace.
+	Owner string `json:"owner"`
+
+	// ResourceTypeAssociations is a list of the resource types associated
+	// with the namespace.
+	ResourceTypeAssociations []resourcetypes.Association `json:"resource_type_associations"`
+
+	// Properties contains the definitions of the namespace properties keyed
+	// by their names. It is only returned by Get.
+	Properties map[string]properties.Property `json:"properties"`
+
+	// Objects is a list of the namespace objects. It is only returned by Get.
+	Objects []objects.Object `json:"objects"`
+
+	// Tags is a list of the namespace tags. It is only returned by Get.
+	Tags []tags.Tag `json:"tags"`
+
+	// Schema is the path to the JSON-schema that represent the namespace.
+	Schema string `json:"schema"`
+
+	// Self contains URI for the namespace.
+	Self string `json:"self"`
+
+	// CreatedAt is the date when the namespace has been created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is the date when the last change has been made to the
+	// namespace.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// UnmarshalJSON sets the names of the namespace properties.
+func (r *Namespace) UnmarshalJSON(b []byte) error {
+	type tmp Namespace
+	var s tmp
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Namespace(s)
+
+	for name, prop := range r.Properties {
+		prop.Name = name
+		r.Properties[name] = prop
+	}
+
+	return nil
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any commonResult as a Namespace.
+func (r commonResult) Extract() (*Namespace, error) {
+	var s *Namespace
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// GetResult represents the result of a Get operation. Call its Extract
+// method to interpret it as a Namespace.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult represents the result of a Create operation. Call its Extract
+// method to interpret it as a Namespace.
+type CreateResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an Update operation. Call its
+// Extract method to interpret it as a Namespace.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a Delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// NamespacePage represents the results of a List request.
+type NamespacePage struct {
+	serviceURL string
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if a NamespacePage contains no Namespaces results.
+func (r NamespacePage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	namespaces, err := ExtractNamespaces(r)
+	return len(namespaces) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to
+// the next page of results.
+func (r NamespacePage) NextPageURL() (string, error) {
+	var s struct {
+		Next string `json:"next"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+
+	if s.Next == "" {
+		return "", nil
+	}
+
+	return nextPageURL(r.serviceURL, s.Next)
+}
+
+// ExtractNamespaces interprets the results of a single page from a List()
+// call, producing a slice of Namespace entities.
+func ExtractNamespaces(r pagination.Page) ([]Namespace, error) {
+	var s struct {
+		Namespaces []Namespace `json:"namespaces"`
+	}
+	err := (r.(NamespacePage)).ExtractInto(&s)
+	return s.Namespaces, err
+}
@@ -0,0 +1,2 @@
+// namespaces unit tests
+package testing
@@ -0,0 +1,212 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/metadefs/namespaces"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/metadefs/properties"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/metadefs/resourcetypes"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/metadefs/tags"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fakeclient "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListFirstPageResult represents the first page of a List response.
+const ListFirstPageResult = `
+{
+    "namespaces": [
+        {
+            "namespace": "OS::Compute::Watchdog",
+            "display_name": "Watchdog Behavior",
+            "description": "Compute drivers may enable watchdog behavior over instances.",
+            "visibility": "public",
+            "protected": true,
+            "owner": "admin",
+            "resource_type_associations": [
+                {
+                    "name": "OS::Glance::Image",
+                    "prefix": "hw_",
+                    "created_at": "2014-08-28T17:13:04Z"
+                }
+            ],
+            "schema": "/v2/schemas/metadefs/namespace",
+            "self": "/v2/metadefs/namespaces/OS::Compute::Watchdog",
+            "created_at": "2014-08-28T17:13:06Z",
+            "updated_at": "2014-08-28T17:13:06Z"
+        }
+    ],
+    "first": "/metadefs/namespaces?limit=1&resource_types=OS%3A%3AGlance%3A%3AImage",
+    "next": "/metadefs/namespaces?limit=1&marker=OS%3A%3ACompute%3A%3AWatchdog&resource_types=OS%3A%3AGlance%3A%3AImage",
+    "schema": "/v2/schemas/metadefs/namespaces"
+}
+`
+
+// ListSecondPageResult represents the second page of a List response.
+const ListSecondPageResult = `
+{
+    "namespaces": [
+        {
+            "namespace": "OS::Software::WebServers",
+            "display_name": "Web Servers",
+            "visibility": "public",
+            "protected": true,
+            "owner": "admin",
+            "schema": "/v2/schemas/metadefs/namespace",
+            "self": "/v2/metadefs/namespaces/OS::Software::WebServers",
+            "created_at": "2014-08-28T17:13:06Z",
+            "updated_at": "2014-08-28T17:13:06Z"
+        }
+    ],
+    "first": "/metadefs/namespaces?limit=1&resource_types=OS%3A%3AGlance%3A%3AImage",
+    "schema": "/v2/schemas/metadefs/namespaces"
+}
+`
+
+// GetResult represents raw server response on a Get request.
+const GetResult = `
+{
+    "namespace": "OS::Compute::Watchdog",
+    "display_name": "Watchdog Behavior",
+    "description": "Compute drivers may enable watchdog behavior over instances.",
+    "visibility": "public",
+    "protected": true,
+    "owner": "admin",
+    "resource_type_associations": [
+        {
+            "name": "OS::Glance::Image",
+            "prefix": "hw_",
+            "created_at": "2014-08-28T17:13:04Z"
+        }
+    ],
+    "properties": {
+        "hw_watchdog_action": {
+            "title": "Watchdog Action",
+            "type": "string",
+            "enum": ["disabled", "reset", "poweroff", "pause", "none"]
+        }
+    },
+    "tags": [
+        {
+            "name": "watchdog"
+        }
+    ],
+    "schema": "/v2/schemas/metadefs/namespace",
+    "self": "/v2/metadefs/namespaces/OS::Compute::Watchdog",
+    "created_at": "2014-08-28T17:13:06Z",
+    "updated_at": "2014-08-28T17:13:06Z"
+}
+`
+
+// CreateRequest represents a request to create a namespace.
+const CreateRequest = `
+{
+    "namespace": "OS::Compute::Watchdog",
+    "display_name": "Watchdog Behavior",
+    "description": "Compute drivers may enable watchdog behavior over instances.",
+    "visibility": "public",
+    "protected": true,
+    "resource_type_associations": [
+        {
+            "name": "OS::Glance::Image",
+            "prefix": "hw_"
+        }
+    ],
+    "properties": {
+        "watchdog_action": {
+            "title": "Watchdog Action",
+            "type": "string",
+            "enum": ["disabled", "reset", "poweroff", "pause", "none"]
+        }
+    },
+    "tags": [
+        {
+            "name": "watchdog"
+        }
+    ]
+}
+`
+
+// UpdateRequest represents a request to update a namespace.
+const UpdateRequest = `
+{
+    "namespace": "OS::Compute::Watchdog",
+    "display_name": "Watchdog Behavior",
+    "description": "Compute drivers may enable watchdog behavior over instances.",
+    "visibility": "public",
+    "protected": true
+}
+`
+
+// Watchdog is the expected watchdog namespace of the ListFirstPageResult.
+var Watchdog = namespaces.Namespace{
+	Namespace:   "OS::Compute::Watchdog",
+	DisplayName: "Watchdog Behavior",
+	Description: "Compute drivers may enable watchdog behavior over instances.",
+	Visibility:  namespaces.VisibilityPublic,
+	Protected:   true,
+	Owner:       "admin",
+	ResourceTypeAssociations: []resourcetypes.Association{
+		{
+			Name:      "OS::Glance::Image",
+			Prefix:    "hw_",
+			CreatedAt: time.Date(2014, 8, 28, 17, 13, 4, 0, time.UTC),
+		},
+	},
+	Schema:    "/v2/schemas/metadefs/namespace",
+	Self:      "/v2/metadefs/namespaces/OS::Compute::Watchdog",
+	CreatedAt: time.Date(2014, 8, 28, 17, 13, 6, 0, time.UTC),
+	UpdatedAt: time.Date(2014, 8, 28, 17, 13, 6, 0, time.UTC),
+}
+
+// WebServers is the expected namespace of the ListSecondPageResult.
+var WebServers = namespaces.Namespace{
+	Namespace:   "OS::Software::WebServers",
+	DisplayName: "Web Servers",
+	Visibility:  namespaces.VisibilityPublic,
+	Protected:   true,
+	Owner:       "admin",
+	Schema:      "/v2/schemas/metadefs/namespace",
+	Self:        "/v2/metadefs/namespaces/OS::Software::WebServers",
+	CreatedAt:   time.Date(2014, 8, 28, 17, 13, 6, 0, time.UTC),
+	UpdatedAt:   time.Date(2014, 8, 28, 17, 13, 6, 0, time.UTC),
+}
+
+// WatchdogDetail is the expected representation of the GetResult.
+var WatchdogDetail = func() namespaces.Namespace {
+	n := Watchdog
+	n.Properties = map[string]properties.Property{
+		"hw_watchdog_action": {
+			Name:  "hw_watchdog_action",
+			Title: "Watchdog Action",
+			Type:  properties.TypeString,
+			Enum:  []string{"disabled", "reset", "poweroff", "pause", "none"},
+		},
+	}
+	n.Tags = []tags.Tag{{Name: "watchdog"}}
+	return n
+}()
+
+// HandleListSuccessfully sets up the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/metadefs/namespaces", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		th.AssertEquals(t, "OS::Glance::Image", r.URL.Query().Get("resource_types"))
+		switch r.URL.Query().Get("marker") {
+		case "":
+			fmt.Fprint(w, ListFirstPageResult)
+		case "OS::Compute::Watchdog":
+			fmt.Fprint(w, ListSecondPageResult)
+		default:
+			t.Fatalf("Unexpected marker: %q", r.URL.Query().Get("marker"))
+		}
+	})
+}
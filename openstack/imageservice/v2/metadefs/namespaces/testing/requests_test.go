@@ -0,0 +1,132 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/metadefs/namespaces"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/metadefs/properties"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/metadefs/resourcetypes"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fakeclient "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleListSuccessfully(t)
+
+	allPages, err := namespaces.List(fakeclient.ServiceClient(), namespaces.ListOpts{
+		Limit:         1,
+		ResourceTypes: []string{"OS::Glance::Image"},
+	}).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := namespaces.ExtractNamespaces(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []namespaces.Namespace{Watchdog, WebServers}, actual)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::Watchdog", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestFormValues(t, r, map[string]string{"resource_type": "OS::Glance::Image"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetResult)
+	})
+
+	actual, err := namespaces.Get(fakeclient.ServiceClient(), "OS::Compute::Watchdog", namespaces.GetOpts{
+		ResourceType: "OS::Glance::Image",
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, WatchdogDetail, *actual)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/metadefs/namespaces", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, GetResult)
+	})
+
+	protected := true
+	actual, err := namespaces.Create(fakeclient.ServiceClient(), namespaces.CreateOpts{
+		Namespace:   "OS::Compute::Watchdog",
+		DisplayName: "Watchdog Behavior",
+		Description: "Compute drivers may enable watchdog behavior over instances.",
+		Visibility:  namespaces.VisibilityPublic,
+		Protected:   &protected,
+		ResourceTypeAssociations: []resourcetypes.AssociateOpts{
+			{
+				Name:   "OS::Glance::Image",
+				Prefix: "hw_",
+			},
+		},
+		Properties: map[string]properties.CreateOpts{
+			"watchdog_action": {
+				Title: "Watchdog Action",
+				Type:  properties.TypeString,
+				Enum:  []string{"disabled", "reset", "poweroff", "pause", "none"},
+			},
+		},
+		Tags: []string{"watchdog"},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, WatchdogDetail, *actual)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::Watchdog", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetResult)
+	})
+
+	protected := true
+	actual, err := namespaces.Update(fakeclient.ServiceClient(), "OS::Compute::Watchdog", namespaces.UpdateOpts{
+		Namespace:   "OS::Compute::Watchdog",
+		DisplayName: "Watchdog Behavior",
+		Description: "Compute drivers may enable watchdog behavior over instances.",
+		Visibility:  namespaces.VisibilityPublic,
+		Protected:   &protected,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "OS::Compute::Watchdog", actual.Namespace)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::Watchdog", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := namespaces.Delete(fakeclient.ServiceClient(), "OS::Compute::Watchdog").ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,46 @@
+/*
+Package objects enables management of the Imageservice metadata definition
+objects.
+
+Example to List Objects of a Namespace
+
+	allPages, err := objects.List(imagesClient, "OS::Compute::CPUPinning").AllPages()
+	if err != nil {
+	  panic(err)
+	}
+
+	allObjects, err := objects.ExtractObjects(allPages)
+	if err != nil {
+	  panic(err)
+	}
+
+	for _, object := range allObjects {
+	  fmt.Printf("%+v\n", object)
+	}
+
+Example to Create an Object
+
+	createOpts := objects.CreateOpts{
+	  Name:        "CPU Limits",
+	  Description: "CPU limits of the instance.",
+	  Properties: map[string]properties.CreateOpts{
+	    "quota:cpu_shares": {
+	      Title: "Quota: CPU Shares",
+	      Type:  properties.TypeInteger,
+	    },
+	  },
+	}
+
+	object, err := objects.Create(imagesClient, "OS::Compute::Quota", createOpts).Extract()
+	if err != nil {
+	  panic(err)
+	}
+
+Example to Delete an Object
+
+	err := objects.Delete(imagesClient, "OS::Compute::Quota", "CPU Limits").ExtractErr()
+	if err != nil {
+	  panic(err)
+	}
+*/
+package objects
@@ -0,0 +1,117 @@
+package objects
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/metadefs/properties"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// List retrieves the objects of a namespace.
+func List(c *gophercloud.ServiceClient, namespace string) pagination.Pager {
+	return pagination.NewPager(c, rootURL(c, namespace), func(r pagination.PageResult) pagination.Page {
+		return ObjectPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Get retrieves a specific object of a namespace.
+func Get(c *gophercloud.ServiceClient, namespace, name string) (r GetResult) {
+	resp, err := c.Get(resourceURL(c, namespace, name), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToObjectCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters of a new object.
+type CreateOpts struct {
+	// Name is the name of the object.
+	Name string `json:"name" required:"true"`
+
+	// Description is the description of the object.
+	Description string `json:"description,omitempty"`
+
+	// Required is a list of the names of the required properties.
+	Required []string `json:"required,omitempty"`
+
+	// Properties contains the definitions of the object properties keyed by
+	// their names. The Name field of the definitions is ignored.
+	Properties map[string]properties.CreateOpts `json:"-"`
+}
+
+// ToObjectCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToObjectCreateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(opts.Properties) > 0 {
+		props := make(map[string]interface{}, len(opts.Properties))
+		for name, prop := range opts.Properties {
+			prop.Name = name
+			p, err := prop.ToPropertyCreateMap()
+			if err != nil {
+				return nil, err
+			}
+			delete(p, "name")
+			props[name] = p
+		}
+		b["properties"] = props
+	}
+
+	return b, nil
+}
+
+// Create requests the creation of a new object in a namespace.
+func Create(c *gophercloud.ServiceClient, namespace string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToObjectCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(rootURL(c, namespace), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToObjectUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies the new definition of an object. The Imageservice
+// replaces the whole object, so all the fields must be set.
+type UpdateOpts CreateOpts
+
+// ToObjectUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToObjectUpdateMap() (map[string]interface{}, error) {
+	return CreateOpts(opts).ToObjectCreateMap()
+}
+
+// Update replaces the definition of an object.
+func Update(c *gophercloud.ServiceClient, namespace, name string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToObjectUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(resourceURL(c, namespace, name), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete removes an object from a namespace.
+func Delete(c *gophercloud.ServiceClient, namespace, name string) (r DeleteResult) {
+	resp, err := c.Delete(resourceURL(c, namespace, name), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,117 @@
+package objects
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/metadefs/properties"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Object represents a metadata definition object, which groups related
+// properties.
+type Object struct {
+	// Name is the name of the object.
+	Name string `json:"name"`
+
+	// Description is the description of the object.
+	Description string `json:"description"`
+
+	// Required is a list of the names of the required properties.
+	Required []string `json:"required"`
+
+	// Properties contains the definitions of the object properties keyed by
+	// their names.
+	Properties map[string]properties.Property `json:"properties"`
+
+	// Schema is the path to the JSON-schema that represent the object.
+	Schema string `json:"schema"`
+
+	// Self contains URI for the object.
+	Self string `json:"self"`
+
+	// CreatedAt is the date when the object has been created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is the date when the last change has been made to the object.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// UnmarshalJSON sets the names of the object properties.
+func (r *Object) UnmarshalJSON(b []byte) error {
+	type tmp Object
+	var s tmp
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Object(s)
+
+	for name, prop := range r.Properties {
+		prop.Name = name
+		r.Properties[name] = prop
+	}
+
+	return nil
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any commonResult as an Object.
+func (r commonResult) Extract() (*Object, error) {
+	var s *Object
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// GetResult represents the result of a Get operation. Call its Extract
+// method to interpret it as an Object.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult represents the result of a Create operation. Call its Extract
+// method to interpret it as an Object.
+type CreateResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an Update operation. Call its
+// Extract method to interpret it as an Object.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a Delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ObjectPage is a single page of Object results.
+type ObjectPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not an ObjectPage contains any results.
+func (r ObjectPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	objects, err := ExtractObjects(r)
+	return len(objects) == 0, err
+}
+
+// ExtractObjects returns a slice of Objects contained in a single page of
+// results.
+func ExtractObjects(r pagination.Page) ([]Object, error) {
+	var s struct {
+		Objects []Object `json:"objects"`
+	}
+	err := (r.(ObjectPage)).ExtractInto(&s)
+	return s.Objects, err
+}
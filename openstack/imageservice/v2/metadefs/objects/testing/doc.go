@@ -0,0 +1,2 @@
+// objects unit tests
+package testing
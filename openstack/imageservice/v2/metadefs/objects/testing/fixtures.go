@@ -0,0 +1,83 @@
+package testing
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/metadefs/objects"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/metadefs/properties"
+)
+
+// ListResult represents raw server response on a List request.
+const ListResult = `
+{
+    "objects": [
+        {
+            "name": "CPU Limits",
+            "description": "CPU limits of the instance.",
+            "required": [],
+            "properties": {
+                "quota:cpu_shares": {
+                    "title": "Quota: CPU Shares",
+                    "type": "integer"
+                }
+            },
+            "schema": "/v2/schemas/metadefs/object",
+            "self": "/v2/metadefs/namespaces/OS::Compute::Quota/objects/CPU Limits",
+            "created_at": "2014-08-28T17:13:06Z",
+            "updated_at": "2014-08-28T17:13:06Z"
+        }
+    ],
+    "schema": "v2/schemas/metadefs/objects"
+}
+`
+
+// CreateRequest represents a request to create an object.
+const CreateRequest = `
+{
+    "name": "CPU Limits",
+    "description": "CPU limits of the instance.",
+    "properties": {
+        "quota:cpu_shares": {
+            "title": "Quota: CPU Shares",
+            "type": "integer"
+        }
+    }
+}
+`
+
+// GetResult represents raw server response on a Get request.
+const GetResult = `
+{
+    "name": "CPU Limits",
+    "description": "CPU limits of the instance.",
+    "required": [],
+    "properties": {
+        "quota:cpu_shares": {
+            "title": "Quota: CPU Shares",
+            "type": "integer"
+        }
+    },
+    "schema": "/v2/schemas/metadefs/object",
+    "self": "/v2/metadefs/namespaces/OS::Compute::Quota/objects/CPU Limits",
+    "created_at": "2014-08-28T17:13:06Z",
+    "updated_at": "2014-08-28T17:13:06Z"
+}
+`
+
+// CPULimits is the expected representation of the GetResult.
+var CPULimits = objects.Object{
+	Name:        "CPU Limits",
+	Description: "CPU limits of the instance.",
+	Required:    []string{},
+	Properties: map[string]properties.Property{
+		"quota:cpu_shares": {
+			Name:  "quota:cpu_shares",
+			Title: "Quota: CPU Shares",
+			Type:  properties.TypeInteger,
+		},
+	},
+	Schema:    "/v2/schemas/metadefs/object",
+	Self:      "/v2/metadefs/namespaces/OS::Compute::Quota/objects/CPU Limits",
+	CreatedAt: time.Date(2014, 8, 28, 17, 13, 6, 0, time.UTC),
+	UpdatedAt: time.Date(2014, 8, 28, 17, 13, 6, 0, time.UTC),
+}
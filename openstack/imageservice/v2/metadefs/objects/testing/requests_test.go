@@ -0,0 +1,94 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/metadefs/objects"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/metadefs/properties"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fakeclient "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::Quota/objects", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListResult)
+	})
+
+	allPages, err := objects.List(fakeclient.ServiceClient(), "OS::Compute::Quota").AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := objects.ExtractObjects(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []objects.Object{CPULimits}, actual)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::Quota/objects", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, GetResult)
+	})
+
+	actual, err := objects.Create(fakeclient.ServiceClient(), "OS::Compute::Quota", objects.CreateOpts{
+		Name:        "CPU Limits",
+		Description: "CPU limits of the instance.",
+		Properties: map[string]properties.CreateOpts{
+			"quota:cpu_shares": {
+				Title: "Quota: CPU Shares",
+				Type:  properties.TypeInteger,
+			},
+		},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, CPULimits, *actual)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::Quota/objects/CPU Limits", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetResult)
+	})
+
+	actual, err := objects.Get(fakeclient.ServiceClient(), "OS::Compute::Quota", "CPU Limits").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, CPULimits, *actual)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::Quota/objects/CPU Limits", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := objects.Delete(fakeclient.ServiceClient(), "OS::Compute::Quota", "CPU Limits").ExtractErr()
+	th.AssertNoErr(t, err)
+}
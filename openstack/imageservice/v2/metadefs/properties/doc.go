@@ -0,0 +1,61 @@
+/*
+Package properties enables management of the Imageservice metadata
+definition properties and the validation of image properties against them.
+
+Example to List Properties of a Namespace
+
+	props, err := properties.List(imagesClient, "OS::Compute::Libvirt").Extract()
+	if err != nil {
+	  panic(err)
+	}
+
+	for name, prop := range props {
+	  fmt.Printf("%s: %+v\n", name, prop)
+	}
+
+Example to Create a Property
+
+	createOpts := properties.CreateOpts{
+	  Name:  "watchdog_action",
+	  Title: "Watchdog Action",
+	  Type:  properties.TypeString,
+	  Enum:  []string{"disabled", "reset", "poweroff", "pause", "none"},
+	}
+
+	prop, err := properties.Create(imagesClient, "OS::Compute::Watchdog", createOpts).Extract()
+	if err != nil {
+	  panic(err)
+	}
+
+Example to Delete a Property
+
+	err := properties.Delete(imagesClient, "OS::Compute::Watchdog", "watchdog_action").ExtractErr()
+	if err != nil {
+	  panic(err)
+	}
+
+Example to Validate Image Properties
+
+	props, err := properties.List(imagesClient, "OS::Compute::Watchdog").Extract()
+	if err != nil {
+	  panic(err)
+	}
+
+	image, err := images.Get(imagesClient, "da3b75d9-3f4a-40e7-8a2c-bfab23927dea").Extract()
+	if err != nil {
+	  panic(err)
+	}
+
+	values := make(map[string]string)
+	for k, v := range image.Properties {
+	  if s, ok := v.(string); ok {
+	    values[k] = s
+	  }
+	}
+
+	err = properties.Validate(props, "hw_", values)
+	if err != nil {
+	  panic(err)
+	}
+*/
+package properties
@@ -0,0 +1,20 @@
+package properties
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ErrInvalidValue is the error when a value doesn't match the definition of
+// a property.
+type ErrInvalidValue struct {
+	gophercloud.BaseError
+	Name   string
+	Value  string
+	Reason string
+}
+
+func (e ErrInvalidValue) Error() string {
+	return fmt.Sprintf("Invalid value %q of the property %q: %s", e.Value, e.Name, e.Reason)
+}
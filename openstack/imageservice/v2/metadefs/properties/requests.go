@@ -0,0 +1,139 @@
+package properties
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// List retrieves the properties of a namespace.
+func List(c *gophercloud.ServiceClient, namespace string) (r ListResult) {
+	resp, err := c.Get(rootURL(c, namespace), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves a specific property of a namespace.
+func Get(c *gophercloud.ServiceClient, namespace, name string) (r GetResult) {
+	resp, err := c.Get(resourceURL(c, namespace, name), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToPropertyCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters of a new property.
+type CreateOpts struct {
+	// Name is the name of the property.
+	Name string `json:"name" required:"true"`
+
+	// Title is the human-readable name of the property.
+	Title string `json:"title" required:"true"`
+
+	// Type is the type of the property value.
+	Type PropertyType `json:"type" required:"true"`
+
+	// Description is the description of the property.
+	Description string `json:"description,omitempty"`
+
+	// Operators is a list of the operators, which can be used in the value.
+	Operators []string `json:"operators,omitempty"`
+
+	// Default is the default value of the property.
+	Default interface{} `json:"default,omitempty"`
+
+	// ReadOnly is whether the property is read-only.
+	ReadOnly *bool `json:"readonly,omitempty"`
+
+	// Minimum is the minimum value of an integer or a number property.
+	Minimum *float64 `json:"minimum,omitempty"`
+
+	// Maximum is the maximum value of an integer or a number property.
+	Maximum *float64 `json:"maximum,omitempty"`
+
+	// Enum is a list of the allowed values.
+	Enum []string `json:"enum,omitempty"`
+
+	// Pattern is a regular expression, which a string value must match.
+	Pattern string `json:"pattern,omitempty"`
+
+	// MinLength is the minimum length of a string value.
+	MinLength *int `json:"minLength,omitempty"`
+
+	// MaxLength is the maximum length of a string value.
+	MaxLength *int `json:"maxLength,omitempty"`
+
+	// Items describes the items of an array property.
+	Items *Items `json:"items,omitempty"`
+
+	// UniqueItems is whether the items of an array value must be unique.
+	UniqueItems *bool `json:"uniqueItems,omitempty"`
+
+	// MinItems is the minimum number of items of an array value.
+	MinItems *int `json:"minItems,omitempty"`
+
+	// MaxItems is the maximum number of items of an array value.
+	MaxItems *int `json:"maxItems,omitempty"`
+
+	// AdditionalItems is whether an array value can contain items, which are
+	// not described by Items.
+	AdditionalItems *bool `json:"additionalItems,omitempty"`
+}
+
+// ToPropertyCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToPropertyCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create requests the creation of a new property in a namespace.
+func Create(c *gophercloud.ServiceClient, namespace string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToPropertyCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(rootURL(c, namespace), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToPropertyUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies the new definition of a property. The Imageservice
+// replaces the whole property, so all the fields must be set. The property
+// is renamed, when Name differs from the current name.
+type UpdateOpts CreateOpts
+
+// ToPropertyUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToPropertyUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update replaces the definition of a property.
+func Update(c *gophercloud.ServiceClient, namespace, name string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToPropertyUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(resourceURL(c, namespace, name), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete removes a property from a namespace.
+func Delete(c *gophercloud.ServiceClient, namespace, name string) (r DeleteResult) {
+	resp, err := c.Delete(resourceURL(c, namespace, name), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
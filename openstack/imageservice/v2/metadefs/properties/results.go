@@ -0,0 +1,154 @@
+package properties
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// PropertyType represents the type of a property value.
+type PropertyType string
+
+const (
+	// TypeArray represents a property with a list of values.
+	TypeArray PropertyType = "array"
+
+	// TypeBoolean represents a property with a boolean value.
+	TypeBoolean PropertyType = "boolean"
+
+	// TypeInteger represents a property with an integer value.
+	TypeInteger PropertyType = "integer"
+
+	// TypeNumber represents a property with a numeric value.
+	TypeNumber PropertyType = "number"
+
+	// TypeObject represents a property with an object value.
+	TypeObject PropertyType = "object"
+
+	// TypeString represents a property with a string value.
+	TypeString PropertyType = "string"
+)
+
+// Items describes the items of an array property.
+type Items struct {
+	// Type is the type of the items.
+	Type PropertyType `json:"type,omitempty"`
+
+	// Enum is a list of the allowed item values.
+	Enum []string `json:"enum,omitempty"`
+}
+
+// Property represents a metadata definition property.
+type Property struct {
+	// Name is the name of the property.
+	Name string `json:"name"`
+
+	// Title is the human-readable name of the property.
+	Title string `json:"title"`
+
+	// Type is the type of the property value.
+	Type PropertyType `json:"type"`
+
+	// Description is the description of the property.
+	Description string `json:"description"`
+
+	// Operators is a list of the operators, which can be used in the value.
+	Operators []string `json:"operators"`
+
+	// Default is the default value of the property.
+	Default interface{} `json:"default"`
+
+	// ReadOnly is whether the property is read-only.
+	ReadOnly bool `json:"readonly"`
+
+	// Minimum is the minimum value of an integer or a number property.
+	Minimum *float64 `json:"minimum"`
+
+	// Maximum is the maximum value of an integer or a number property.
+	Maximum *float64 `json:"maximum"`
+
+	// Enum is a list of the allowed values.
+	Enum []string `json:"enum"`
+
+	// Pattern is a regular expression, which a string value must match.
+	Pattern string `json:"pattern"`
+
+	// MinLength is the minimum length of a string value.
+	MinLength *int `json:"minLength"`
+
+	// MaxLength is the maximum length of a string value.
+	MaxLength *int `json:"maxLength"`
+
+	// Items describes the items of an array property.
+	Items *Items `json:"items"`
+
+	// UniqueItems is whether the items of an array value must be unique.
+	UniqueItems bool `json:"uniqueItems"`
+
+	// MinItems is the minimum number of items of an array value.
+	MinItems *int `json:"minItems"`
+
+	// MaxItems is the maximum number of items of an array value.
+	MaxItems *int `json:"maxItems"`
+
+	// AdditionalItems is whether an array value can contain items, which are
+	// not described by Items.
+	AdditionalItems *bool `json:"additionalItems"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any commonResult as a Property.
+func (r commonResult) Extract() (*Property, error) {
+	var s *Property
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// GetResult represents the result of a Get operation. Call its Extract
+// method to interpret it as a Property.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult represents the result of a Create operation. Call its Extract
+// method to interpret it as a Property.
+type CreateResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an Update operation. Call its
+// Extract method to interpret it as a Property.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a Delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ListResult represents the result of a List operation. Call its Extract
+// method to interpret it as a map of Properties.
+type ListResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ListResult as a map of Properties keyed by their
+// names.
+func (r ListResult) Extract() (map[string]Property, error) {
+	var s struct {
+		Properties map[string]Property `json:"properties"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, prop := range s.Properties {
+		prop.Name = name
+		s.Properties[name] = prop
+	}
+	return s.Properties, nil
+}
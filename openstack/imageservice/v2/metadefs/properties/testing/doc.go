@@ -0,0 +1,2 @@
+// properties unit tests
+package testing
@@ -0,0 +1,64 @@
+package testing
+
+import (
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/metadefs/properties"
+)
+
+// ListResult represents raw server response on a List request.
+const ListResult = `
+{
+    "properties": {
+        "watchdog_action": {
+            "title": "Watchdog Action",
+            "type": "string",
+            "description": "The action to take when the watchdog timer is triggered.",
+            "enum": ["disabled", "reset", "poweroff", "pause", "none"]
+        },
+        "video_ram": {
+            "title": "Max Video Ram",
+            "type": "integer",
+            "minimum": 0
+        }
+    }
+}
+`
+
+// CreateRequest represents a request to create a property.
+const CreateRequest = `
+{
+    "name": "watchdog_action",
+    "title": "Watchdog Action",
+    "type": "string",
+    "enum": ["disabled", "reset", "poweroff", "pause", "none"]
+}
+`
+
+// GetResult represents raw server response on a Get request.
+const GetResult = `
+{
+    "name": "watchdog_action",
+    "title": "Watchdog Action",
+    "type": "string",
+    "description": "The action to take when the watchdog timer is triggered.",
+    "enum": ["disabled", "reset", "poweroff", "pause", "none"]
+}
+`
+
+var minimum = float64(0)
+
+// WatchdogAction is the expected watchdog_action property.
+var WatchdogAction = properties.Property{
+	Name:        "watchdog_action",
+	Title:       "Watchdog Action",
+	Type:        properties.TypeString,
+	Description: "The action to take when the watchdog timer is triggered.",
+	Enum:        []string{"disabled", "reset", "poweroff", "pause", "none"},
+}
+
+// VideoRAM is the expected video_ram property.
+var VideoRAM = properties.Property{
+	Name:    "video_ram",
+	Title:   "Max Video Ram",
+	Type:    properties.TypeInteger,
+	Minimum: &minimum,
+}
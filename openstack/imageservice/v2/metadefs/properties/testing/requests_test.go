@@ -0,0 +1,118 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/metadefs/properties"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fakeclient "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::Watchdog/properties", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListResult)
+	})
+
+	actual, err := properties.List(fakeclient.ServiceClient(), "OS::Compute::Watchdog").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, map[string]properties.Property{
+		"watchdog_action": WatchdogAction,
+		"video_ram":       VideoRAM,
+	}, actual)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::Watchdog/properties", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, GetResult)
+	})
+
+	actual, err := properties.Create(fakeclient.ServiceClient(), "OS::Compute::Watchdog", properties.CreateOpts{
+		Name:  "watchdog_action",
+		Title: "Watchdog Action",
+		Type:  properties.TypeString,
+		Enum:  []string{"disabled", "reset", "poweroff", "pause", "none"},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, WatchdogAction, *actual)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::Watchdog/properties/watchdog_action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetResult)
+	})
+
+	actual, err := properties.Get(fakeclient.ServiceClient(), "OS::Compute::Watchdog", "watchdog_action").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, WatchdogAction, *actual)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::Watchdog/properties/watchdog_action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := properties.Delete(fakeclient.ServiceClient(), "OS::Compute::Watchdog", "watchdog_action").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	props := map[string]properties.Property{
+		"watchdog_action": WatchdogAction,
+		"video_ram":       VideoRAM,
+	}
+
+	err := properties.Validate(props, "hw_", map[string]string{
+		"hw_watchdog_action": "reset",
+		"hw_video_ram":       "64",
+		"os_distro":          "ubuntu",
+	})
+	th.AssertNoErr(t, err)
+
+	err = properties.Validate(props, "hw_", map[string]string{
+		"hw_watchdog_action": "explode",
+	})
+	th.CheckErr(t, err, &properties.ErrInvalidValue{})
+
+	var expected properties.ErrInvalidValue
+	err = properties.Validate(props, "hw_", map[string]string{
+		"hw_video_ram": "-1",
+	})
+	th.CheckErr(t, err, &expected)
+	th.AssertEquals(t, "hw_video_ram", expected.Name)
+
+	err = VideoRAM.Validate("lots")
+	th.CheckErr(t, err, &properties.ErrInvalidValue{})
+}
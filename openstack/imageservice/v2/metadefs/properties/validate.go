@@ -0,0 +1,163 @@
+package properties
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Validate checks the values, whose names start with prefix, against the
+// property definitions. The prefix is the one of the namespace resource type
+// association, e.g. "hw_" for the "OS::Glance::Image" resource type. Values
+// without a matching definition are ignored. The first invalid value is
+// returned as an ErrInvalidValue error.
+func Validate(props map[string]Property, prefix string, values map[string]string) error {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		prop, ok := props[strings.TrimPrefix(name, prefix)]
+		if !ok {
+			continue
+		}
+		if err := prop.validate(name, values[name]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate checks that value is a valid string representation of the
+// property value. Array values are represented as comma separated items.
+func (p Property) Validate(value string) error {
+	return p.validate(p.Name, value)
+}
+
+func (p Property) validate(name, value string) error {
+	invalid := func(format string, a ...interface{}) error {
+		return ErrInvalidValue{Name: name, Value: value, Reason: fmt.Sprintf(format, a...)}
+	}
+
+	value = p.trimOperator(value)
+
+	switch p.Type {
+	case TypeArray:
+		var items []string
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		if p.MinItems != nil && len(items) < *p.MinItems {
+			return invalid("at least %d items are required", *p.MinItems)
+		}
+		if p.MaxItems != nil && len(items) > *p.MaxItems {
+			return invalid("at most %d items are allowed", *p.MaxItems)
+		}
+		seen := make(map[string]bool, len(items))
+		for _, item := range items {
+			if p.UniqueItems && seen[item] {
+				return invalid("item %q is not unique", item)
+			}
+			seen[item] = true
+			if p.Items == nil {
+				continue
+			}
+			if err := checkScalar(p.Items.Type, item); err != nil {
+				return invalid("item %q: %s", item, err)
+			}
+			if len(p.Items.Enum) > 0 && !contains(p.Items.Enum, item) {
+				return invalid("item %q is not one of %v", item, p.Items.Enum)
+			}
+		}
+		return nil
+	case TypeObject:
+		return nil
+	}
+
+	if err := checkScalar(p.Type, value); err != nil {
+		return invalid("%s", err)
+	}
+
+	if len(p.Enum) > 0 && !contains(p.Enum, value) {
+		return invalid("value is not one of %v", p.Enum)
+	}
+
+	switch p.Type {
+	case TypeInteger, TypeNumber:
+		n, _ := strconv.ParseFloat(value, 64)
+		if p.Minimum != nil && n < *p.Minimum {
+			return invalid("value is lower than %v", *p.Minimum)
+		}
+		if p.Maximum != nil && n > *p.Maximum {
+			return invalid("value is greater than %v", *p.Maximum)
+		}
+	case TypeString:
+		length := utf8.RuneCountInString(value)
+		if p.MinLength != nil && length < *p.MinLength {
+			return invalid("value is shorter than %d characters", *p.MinLength)
+		}
+		if p.MaxLength != nil && length > *p.MaxLength {
+			return invalid("value is longer than %d characters", *p.MaxLength)
+		}
+		if p.Pattern != "" {
+			re, err := regexp.Compile(p.Pattern)
+			if err != nil {
+				return invalid("invalid pattern %q: %s", p.Pattern, err)
+			}
+			if !re.MatchString(value) {
+				return invalid("value doesn't match the %q pattern", p.Pattern)
+			}
+		}
+	}
+
+	return nil
+}
+
+// trimOperator removes a leading operator, e.g. "<in>", which is allowed by
+// the property definition.
+func (p Property) trimOperator(value string) string {
+	for _, op := range p.Operators {
+		if strings.HasPrefix(value, op) {
+			return strings.TrimSpace(strings.TrimPrefix(value, op))
+		}
+	}
+	return value
+}
+
+func checkScalar(t PropertyType, value string) error {
+	switch t {
+	case TypeInteger:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return fmt.Errorf("value is not an integer")
+		}
+	case TypeNumber:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return fmt.Errorf("value is not a number")
+		}
+	case TypeBoolean:
+		if _, err := strconv.ParseBool(strings.ToLower(value)); err != nil {
+			return fmt.Errorf("value is not a boolean")
+		}
+	}
+	return nil
+}
+
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
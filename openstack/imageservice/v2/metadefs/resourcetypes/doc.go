@@ -0,0 +1,52 @@
+/*
+Package resourcetypes enables management of the Imageservice metadata
+definition resource types and their associations with namespaces.
+
+Example to List Resource Types
+
+	allPages, err := resourcetypes.List(imagesClient).AllPages()
+	if err != nil {
+	  panic(err)
+	}
+
+	allResourceTypes, err := resourcetypes.ExtractResourceTypes(allPages)
+	if err != nil {
+	  panic(err)
+	}
+
+	for _, resourceType := range allResourceTypes {
+	  fmt.Printf("%+v\n", resourceType)
+	}
+
+Example to List Resource Type Associations of a Namespace
+
+	allPages, err := resourcetypes.ListAssociations(imagesClient, "OS::Compute::Watchdog").AllPages()
+	if err != nil {
+	  panic(err)
+	}
+
+	allAssociations, err := resourcetypes.ExtractAssociations(allPages)
+	if err != nil {
+	  panic(err)
+	}
+
+Example to Associate a Resource Type with a Namespace
+
+	createOpts := resourcetypes.AssociateOpts{
+	  Name:   "OS::Glance::Image",
+	  Prefix: "hw_",
+	}
+
+	association, err := resourcetypes.Associate(imagesClient, "OS::Compute::Watchdog", createOpts).Extract()
+	if err != nil {
+	  panic(err)
+	}
+
+Example to Disassociate a Resource Type from a Namespace
+
+	err := resourcetypes.Disassociate(imagesClient, "OS::Compute::Watchdog", "OS::Glance::Image").ExtractErr()
+	if err != nil {
+	  panic(err)
+	}
+*/
+package resourcetypes
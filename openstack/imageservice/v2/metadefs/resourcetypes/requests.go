@@ -0,0 +1,67 @@
+package resourcetypes
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// List retrieves all the resource types known to the Imageservice.
+func List(c *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(c, listURL(c), func(r pagination.PageResult) pagination.Page {
+		return ResourceTypePage{pagination.SinglePageBase(r)}
+	})
+}
+
+// ListAssociations retrieves the resource types associated with a
+// namespace.
+func ListAssociations(c *gophercloud.ServiceClient, namespace string) pagination.Pager {
+	return pagination.NewPager(c, associationsURL(c, namespace), func(r pagination.PageResult) pagination.Page {
+		return AssociationPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// AssociateOptsBuilder allows extensions to add additional parameters to the
+// Associate request.
+type AssociateOptsBuilder interface {
+	ToResourceTypeAssociateMap() (map[string]interface{}, error)
+}
+
+// AssociateOpts specifies parameters of a new resource type association.
+type AssociateOpts struct {
+	// Name is the name of the resource type, e.g. "OS::Glance::Image".
+	Name string `json:"name" required:"true"`
+
+	// Prefix is prepended to the names of the namespace properties, when
+	// they are applied to the resource type, e.g. "hw_".
+	Prefix string `json:"prefix,omitempty"`
+
+	// PropertiesTarget specifies the part of the resource, to which the
+	// properties apply, e.g. "image" for the "OS::Cinder::Volume" type.
+	PropertiesTarget string `json:"properties_target,omitempty"`
+}
+
+// ToResourceTypeAssociateMap constructs a request body from AssociateOpts.
+func (opts AssociateOpts) ToResourceTypeAssociateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Associate associates a resource type with a namespace.
+func Associate(c *gophercloud.ServiceClient, namespace string, opts AssociateOptsBuilder) (r AssociateResult) {
+	b, err := opts.ToResourceTypeAssociateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(associationsURL(c, namespace), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Disassociate removes the association of a resource type with a namespace.
+func Disassociate(c *gophercloud.ServiceClient, namespace, name string) (r DisassociateResult) {
+	resp, err := c.Delete(associationURL(c, namespace, name), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
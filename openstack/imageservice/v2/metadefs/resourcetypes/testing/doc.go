@@ -0,0 +1,2 @@
+// resourcetypes unit tests
+package testing
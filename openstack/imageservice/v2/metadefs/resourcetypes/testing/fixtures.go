@@ -0,0 +1,80 @@
+package testing
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/metadefs/resourcetypes"
+)
+
+// ListResult represents raw server response on a List request.
+const ListResult = `
+{
+    "resource_types": [
+        {
+            "name": "OS::Glance::Image",
+            "created_at": "2014-08-28T18:13:04Z",
+            "updated_at": "2014-08-28T18:13:04Z"
+        },
+        {
+            "name": "OS::Nova::Flavor",
+            "created_at": "2014-08-28T18:13:04Z",
+            "updated_at": "2014-08-28T18:13:04Z"
+        }
+    ]
+}
+`
+
+// ListAssociationsResult represents raw server response on a
+// ListAssociations request.
+const ListAssociationsResult = `
+{
+    "resource_type_associations": [
+        {
+            "name": "OS::Glance::Image",
+            "prefix": "hw_",
+            "created_at": "2014-08-28T17:13:04Z",
+            "updated_at": "2014-08-28T17:13:04Z"
+        }
+    ]
+}
+`
+
+// AssociateRequest represents a request to associate a resource type.
+const AssociateRequest = `
+{
+    "name": "OS::Glance::Image",
+    "prefix": "hw_"
+}
+`
+
+// AssociateResult represents raw server response on an Associate request.
+const AssociateResult = `
+{
+    "name": "OS::Glance::Image",
+    "prefix": "hw_",
+    "created_at": "2014-08-28T17:13:04Z",
+    "updated_at": "2014-08-28T17:13:04Z"
+}
+`
+
+// ExpectedResourceTypes is the expected representation of the ListResult.
+var ExpectedResourceTypes = []resourcetypes.ResourceType{
+	{
+		Name:      "OS::Glance::Image",
+		CreatedAt: time.Date(2014, 8, 28, 18, 13, 4, 0, time.UTC),
+		UpdatedAt: time.Date(2014, 8, 28, 18, 13, 4, 0, time.UTC),
+	},
+	{
+		Name:      "OS::Nova::Flavor",
+		CreatedAt: time.Date(2014, 8, 28, 18, 13, 4, 0, time.UTC),
+		UpdatedAt: time.Date(2014, 8, 28, 18, 13, 4, 0, time.UTC),
+	},
+}
+
+// ImageAssociation is the expected representation of the AssociateResult.
+var ImageAssociation = resourcetypes.Association{
+	Name:      "OS::Glance::Image",
+	Prefix:    "hw_",
+	CreatedAt: time.Date(2014, 8, 28, 17, 13, 4, 0, time.UTC),
+	UpdatedAt: time.Date(2014, 8, 28, 17, 13, 4, 0, time.UTC),
+}
@@ -0,0 +1,90 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/metadefs/resourcetypes"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fakeclient "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/metadefs/resource_types", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListResult)
+	})
+
+	allPages, err := resourcetypes.List(fakeclient.ServiceClient()).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := resourcetypes.ExtractResourceTypes(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, ExpectedResourceTypes, actual)
+}
+
+func TestListAssociations(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::Watchdog/resource_types", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListAssociationsResult)
+	})
+
+	allPages, err := resourcetypes.ListAssociations(fakeclient.ServiceClient(), "OS::Compute::Watchdog").AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := resourcetypes.ExtractAssociations(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []resourcetypes.Association{ImageAssociation}, actual)
+}
+
+func TestAssociate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::Watchdog/resource_types", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestJSONRequest(t, r, AssociateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, AssociateResult)
+	})
+
+	actual, err := resourcetypes.Associate(fakeclient.ServiceClient(), "OS::Compute::Watchdog", resourcetypes.AssociateOpts{
+		Name:   "OS::Glance::Image",
+		Prefix: "hw_",
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, ImageAssociation, *actual)
+}
+
+func TestDisassociate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/metadefs/namespaces/OS::Compute::Watchdog/resource_types/OS::Glance::Image", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := resourcetypes.Disassociate(fakeclient.ServiceClient(), "OS::Compute::Watchdog", "OS::Glance::Image").ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,21 @@
+package resourcetypes
+
+import "github.com/gophercloud/gophercloud"
+
+const (
+	rootPath      = "metadefs"
+	namespacePath = "namespaces"
+	resourcePath  = "resource_types"
+)
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath, resourcePath)
+}
+
+func associationsURL(c *gophercloud.ServiceClient, namespace string) string {
+	return c.ServiceURL(rootPath, namespacePath, namespace, resourcePath)
+}
+
+func associationURL(c *gophercloud.ServiceClient, namespace, name string) string {
+	return c.ServiceURL(rootPath, namespacePath, namespace, resourcePath, name)
+}
@@ -0,0 +1,58 @@
+/*
+Package tags enables management of the Imageservice metadata definition
+tags.
+
+Example to List Tags of a Namespace
+
+	allPages, err := tags.List(imagesClient, "OS::Software::WebServers", nil).AllPages()
+	if err != nil {
+	  panic(err)
+	}
+
+	allTags, err := tags.ExtractTags(allPages)
+	if err != nil {
+	  panic(err)
+	}
+
+	for _, tag := range allTags {
+	  fmt.Printf("%+v\n", tag)
+	}
+
+Example to Create a Tag
+
+	tag, err := tags.Create(imagesClient, "OS::Software::WebServers", "nginx").Extract()
+	if err != nil {
+	  panic(err)
+	}
+
+Example to Create Multiple Tags
+
+	createOpts := tags.CreateMultipleOpts{
+	  Tags:   []string{"apache", "nginx"},
+	  Append: true,
+	}
+
+	allTags, err := tags.CreateMultiple(imagesClient, "OS::Software::WebServers", createOpts).Extract()
+	if err != nil {
+	  panic(err)
+	}
+
+Example to Rename a Tag
+
+	updateOpts := tags.UpdateOpts{
+	  Name: "httpd",
+	}
+
+	tag, err := tags.Update(imagesClient, "OS::Software::WebServers", "apache", updateOpts).Extract()
+	if err != nil {
+	  panic(err)
+	}
+
+Example to Delete all Tags of a Namespace
+
+	err := tags.DeleteAll(imagesClient, "OS::Software::WebServers").ExtractErr()
+	if err != nil {
+	  panic(err)
+	}
+*/
+package tags
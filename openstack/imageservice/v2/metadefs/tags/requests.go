@@ -0,0 +1,164 @@
+package tags
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToTagListQuery() (string, error)
+}
+
+// ListOpts allows the sorting and the pagination of the tags.
+type ListOpts struct {
+	// Limit is the maximum number of tags to return.
+	Limit int `q:"limit"`
+
+	// Marker is the name of the last tag of the previous page.
+	Marker string `q:"marker"`
+
+	// SortKey sorts the tags by "name" or "created_at".
+	SortKey string `q:"sort_key"`
+
+	// SortDir sorts the tags in "asc" or "desc" order.
+	SortDir string `q:"sort_dir"`
+}
+
+// ToTagListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToTagListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List retrieves the tags of a namespace.
+func List(c *gophercloud.ServiceClient, namespace string, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c, namespace)
+	if opts != nil {
+		query, err := opts.ToTagListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return TagPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Get retrieves a specific tag of a namespace.
+func Get(c *gophercloud.ServiceClient, namespace, name string) (r GetResult) {
+	resp, err := c.Get(resourceURL(c, namespace, name), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Create adds a single tag to a namespace.
+func Create(c *gophercloud.ServiceClient, namespace, name string) (r CreateResult) {
+	resp, err := c.Post(resourceURL(c, namespace, name), nil, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateMultipleOptsBuilder allows extensions to add additional parameters
+// to the CreateMultiple request.
+type CreateMultipleOptsBuilder interface {
+	ToTagCreateMultipleMap() (map[string]interface{}, error)
+	ToTagCreateMultipleHeaders() (map[string]string, error)
+}
+
+// CreateMultipleOpts specifies the tags added to a namespace.
+type CreateMultipleOpts struct {
+	// Tags is a list of the tag names.
+	Tags []string `json:"-"`
+
+	// Append specifies whether the tags are appended to the existing ones.
+	// Otherwise the existing tags are replaced.
+	Append bool `json:"-" h:"X-Openstack-Append"`
+}
+
+// ToTagCreateMultipleMap constructs a request body from CreateMultipleOpts.
+func (opts CreateMultipleOpts) ToTagCreateMultipleMap() (map[string]interface{}, error) {
+	tags := make([]map[string]string, 0, len(opts.Tags))
+	for _, name := range opts.Tags {
+		tags = append(tags, map[string]string{"name": name})
+	}
+	return map[string]interface{}{"tags": tags}, nil
+}
+
+// ToTagCreateMultipleHeaders constructs the request headers from
+// CreateMultipleOpts.
+func (opts CreateMultipleOpts) ToTagCreateMultipleHeaders() (map[string]string, error) {
+	if !opts.Append {
+		return nil, nil
+	}
+	return gophercloud.BuildHeaders(opts)
+}
+
+// CreateMultiple adds multiple tags to a namespace at once.
+func CreateMultiple(c *gophercloud.ServiceClient, namespace string, opts CreateMultipleOptsBuilder) (r CreateMultipleResult) {
+	b, err := opts.ToTagCreateMultipleMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	h, err := opts.ToTagCreateMultipleHeaders()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(rootURL(c, namespace), b, &r.Body, &gophercloud.RequestOpts{
+		MoreHeaders: h,
+		OkCodes:     []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToTagUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies the new name of a tag.
+type UpdateOpts struct {
+	// Name is the new name of the tag.
+	Name string `json:"name" required:"true"`
+}
+
+// ToTagUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToTagUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update renames a tag.
+func Update(c *gophercloud.ServiceClient, namespace, name string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToTagUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(resourceURL(c, namespace, name), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete removes a tag from a namespace.
+func Delete(c *gophercloud.ServiceClient, namespace, name string) (r DeleteResult) {
+	resp, err := c.Delete(resourceURL(c, namespace, name), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteAll removes all the tags from a namespace.
+func DeleteAll(c *gophercloud.ServiceClient, namespace string) (r DeleteResult) {
+	resp, err := c.Delete(rootURL(c, namespace), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
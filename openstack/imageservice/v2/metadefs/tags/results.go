@@ -0,0 +1,104 @@
+package tags
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Tag represents a metadata definition tag.
+type Tag struct {
+	// Name is the name of the tag.
+	Name string `json:"name"`
+
+	// CreatedAt is the date when the tag has been created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is the date when the last change has been made to the tag.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any commonResult as a Tag.
+func (r commonResult) Extract() (*Tag, error) {
+	var s *Tag
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// GetResult represents the result of a Get operation. Call its Extract
+// method to interpret it as a Tag.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult represents the result of a Create operation. Call its Extract
+// method to interpret it as a Tag.
+type CreateResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an Update operation. Call its
+// Extract method to interpret it as a Tag.
+type UpdateResult struct {
+	commonResult
+}
+
+// CreateMultipleResult represents the result of a CreateMultiple operation.
+// Call its Extract method to interpret it as a slice of tag names.
+type CreateMultipleResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a CreateMultipleResult as a slice of tag names.
+func (r CreateMultipleResult) Extract() ([]string, error) {
+	var s struct {
+		Tags []struct {
+			Name string `json:"name"`
+		} `json:"tags"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(s.Tags))
+	for _, tag := range s.Tags {
+		names = append(names, tag.Name)
+	}
+	return names, nil
+}
+
+// DeleteResult represents the result of a Delete or DeleteAll operation.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// TagPage is a single page of Tag results.
+type TagPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not a TagPage contains any results.
+func (r TagPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	tags, err := ExtractTags(r)
+	return len(tags) == 0, err
+}
+
+// ExtractTags returns a slice of Tags contained in a single page of results.
+func ExtractTags(r pagination.Page) ([]Tag, error) {
+	var s struct {
+		Tags []Tag `json:"tags"`
+	}
+	err := (r.(TagPage)).ExtractInto(&s)
+	return s.Tags, err
+}
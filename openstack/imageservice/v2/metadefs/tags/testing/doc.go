@@ -0,0 +1,2 @@
+// tags unit tests
+package testing
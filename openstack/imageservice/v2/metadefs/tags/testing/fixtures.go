@@ -0,0 +1,66 @@
+package testing
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/metadefs/tags"
+)
+
+// ListResult represents raw server response on a List request.
+const ListResult = `
+{
+    "tags": [
+        {
+            "name": "apache",
+            "created_at": "2015-05-09T01:12:31Z",
+            "updated_at": "2015-05-09T01:12:31Z"
+        },
+        {
+            "name": "nginx",
+            "created_at": "2015-05-09T01:12:32Z",
+            "updated_at": "2015-05-09T01:12:32Z"
+        }
+    ]
+}
+`
+
+// GetResult represents raw server response on a Get request.
+const GetResult = `
+{
+    "name": "nginx",
+    "created_at": "2015-05-09T01:12:32Z",
+    "updated_at": "2015-05-09T01:12:32Z"
+}
+`
+
+// CreateMultipleRequest represents a request to create multiple tags.
+const CreateMultipleRequest = `
+{
+    "tags": [
+        {
+            "name": "apache"
+        },
+        {
+            "name": "nginx"
+        }
+    ]
+}
+`
+
+// CreateMultipleResult represents raw server response on a CreateMultiple
+// request.
+const CreateMultipleResult = CreateMultipleRequest
+
+// Apache is the expected apache tag.
+var Apache = tags.Tag{
+	Name:      "apache",
+	CreatedAt: time.Date(2015, 5, 9, 1, 12, 31, 0, time.UTC),
+	UpdatedAt: time.Date(2015, 5, 9, 1, 12, 31, 0, time.UTC),
+}
+
+// Nginx is the expected nginx tag.
+var Nginx = tags.Tag{
+	Name:      "nginx",
+	CreatedAt: time.Date(2015, 5, 9, 1, 12, 32, 0, time.UTC),
+	UpdatedAt: time.Date(2015, 5, 9, 1, 12, 32, 0, time.UTC),
+}
@@ -0,0 +1,108 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/metadefs/tags"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fakeclient "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/metadefs/namespaces/OS::Software::WebServers/tags", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestFormValues(t, r, map[string]string{"sort_key": "name"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListResult)
+	})
+
+	allPages, err := tags.List(fakeclient.ServiceClient(), "OS::Software::WebServers", tags.ListOpts{SortKey: "name"}).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := tags.ExtractTags(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []tags.Tag{Apache, Nginx}, actual)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/metadefs/namespaces/OS::Software::WebServers/tags/nginx", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, GetResult)
+	})
+
+	actual, err := tags.Create(fakeclient.ServiceClient(), "OS::Software::WebServers", "nginx").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, Nginx, *actual)
+}
+
+func TestCreateMultiple(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/metadefs/namespaces/OS::Software::WebServers/tags", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestHeader(t, r, "X-Openstack-Append", "true")
+		th.TestJSONRequest(t, r, CreateMultipleRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, CreateMultipleResult)
+	})
+
+	actual, err := tags.CreateMultiple(fakeclient.ServiceClient(), "OS::Software::WebServers", tags.CreateMultipleOpts{
+		Tags:   []string{"apache", "nginx"},
+		Append: true,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{"apache", "nginx"}, actual)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/metadefs/namespaces/OS::Software::WebServers/tags/engine-x", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestJSONRequest(t, r, `{"name": "nginx"}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetResult)
+	})
+
+	actual, err := tags.Update(fakeclient.ServiceClient(), "OS::Software::WebServers", "engine-x", tags.UpdateOpts{Name: "nginx"}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, Nginx, *actual)
+}
+
+func TestDeleteAll(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/metadefs/namespaces/OS::Software::WebServers/tags", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := tags.DeleteAll(fakeclient.ServiceClient(), "OS::Software::WebServers").ExtractErr()
+	th.AssertNoErr(t, err)
+}
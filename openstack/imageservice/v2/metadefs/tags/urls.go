@@ -0,0 +1,17 @@
+package tags
+
+import "github.com/gophercloud/gophercloud"
+
+const (
+	rootPath      = "metadefs"
+	namespacePath = "namespaces"
+	resourcePath  = "tags"
+)
+
+func rootURL(c *gophercloud.ServiceClient, namespace string) string {
+	return c.ServiceURL(rootPath, namespacePath, namespace, resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, namespace, name string) string {
+	return c.ServiceURL(rootPath, namespacePath, namespace, resourcePath, name)
+}
@@ -0,0 +1,41 @@
+/*
+Package stores enables the discovery of the Imageservice stores and the
+deletion of image data from a single store. It requires the multi-store
+support in the Imageservice.
+
+Example to List Stores
+
+	allPages, err := stores.List(imagesClient).AllPages()
+	if err != nil {
+	  panic(err)
+	}
+
+	allStores, err := stores.ExtractStores(allPages)
+	if err != nil {
+	  panic(err)
+	}
+
+	for _, store := range allStores {
+	  fmt.Printf("%+v\n", store)
+	}
+
+Example to List Stores with their Details
+
+	allPages, err := stores.ListDetail(imagesClient).AllPages()
+	if err != nil {
+	  panic(err)
+	}
+
+	allStores, err := stores.ExtractStores(allPages)
+	if err != nil {
+	  panic(err)
+	}
+
+Example to Delete an Image from a Store
+
+	err := stores.Delete(imagesClient, "ceph", "da3b75d9-3f4a-40e7-8a2c-bfab23927dea").ExtractErr()
+	if err != nil {
+	  panic(err)
+	}
+*/
+package stores
@@ -0,0 +1,31 @@
+package stores
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// List retrieves the stores enabled in the Imageservice.
+func List(c *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(c, listURL(c), func(r pagination.PageResult) pagination.Page {
+		return StorePage{pagination.SinglePageBase(r)}
+	})
+}
+
+// ListDetail retrieves the stores enabled in the Imageservice together with
+// their type and properties. This call requires admin privileges.
+func ListDetail(c *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(c, listDetailURL(c), func(r pagination.PageResult) pagination.Page {
+		return StorePage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Delete removes the data of an image from a single store. The image data
+// in the other stores is kept.
+func Delete(c *gophercloud.ServiceClient, storeID, imageID string) (r DeleteResult) {
+	resp, err := c.Delete(deleteURL(c, storeID, imageID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
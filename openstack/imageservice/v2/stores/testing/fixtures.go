@@ -0,0 +1,80 @@
+package testing
+
+import (
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/stores"
+)
+
+// ListResult represents raw server response on a List request.
+const ListResult = `
+{
+    "stores": [
+        {
+            "id": "ceph",
+            "description": "Ceph RBD store",
+            "default": true
+        },
+        {
+            "id": "file",
+            "description": "Local file store"
+        },
+        {
+            "id": "http",
+            "description": "Read-only web store",
+            "read-only": true
+        }
+    ]
+}
+`
+
+// ListDetailResult represents raw server response on a ListDetail request.
+const ListDetailResult = `
+{
+    "stores": [
+        {
+            "id": "ceph",
+            "description": "Ceph RBD store",
+            "default": true,
+            "type": "rbd",
+            "weight": 100,
+            "properties": {
+                "pool": "images",
+                "chunk_size": 8
+            }
+        }
+    ]
+}
+`
+
+// ExpectedStores is the expected representation of the ListResult.
+var ExpectedStores = []stores.Store{
+	{
+		ID:          "ceph",
+		Description: "Ceph RBD store",
+		Default:     true,
+	},
+	{
+		ID:          "file",
+		Description: "Local file store",
+	},
+	{
+		ID:          "http",
+		Description: "Read-only web store",
+		ReadOnly:    true,
+	},
+}
+
+// ExpectedDetailStores is the expected representation of the
+// ListDetailResult.
+var ExpectedDetailStores = []stores.Store{
+	{
+		ID:          "ceph",
+		Description: "Ceph RBD store",
+		Default:     true,
+		Type:        "rbd",
+		Weight:      100,
+		Properties: map[string]interface{}{
+			"pool":       "images",
+			"chunk_size": float64(8),
+		},
+	},
+}
@@ -0,0 +1,68 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/stores"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fakeclient "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/info/stores", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListResult)
+	})
+
+	allPages, err := stores.List(fakeclient.ServiceClient()).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := stores.ExtractStores(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, ExpectedStores, actual)
+}
+
+func TestListDetail(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/info/stores/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListDetailResult)
+	})
+
+	allPages, err := stores.ListDetail(fakeclient.ServiceClient()).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := stores.ExtractStores(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, ExpectedDetailStores, actual)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/stores/ceph/da3b75d9-3f4a-40e7-8a2c-bfab23927dea", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := stores.Delete(fakeclient.ServiceClient(), "ceph", "da3b75d9-3f4a-40e7-8a2c-bfab23927dea").ExtractErr()
+	th.AssertNoErr(t, err)
+}
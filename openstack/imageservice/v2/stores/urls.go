@@ -0,0 +1,21 @@
+package stores
+
+import "github.com/gophercloud/gophercloud"
+
+const (
+	infoPath     = "info"
+	resourcePath = "stores"
+	detailPath   = "detail"
+)
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(infoPath, resourcePath)
+}
+
+func listDetailURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(infoPath, resourcePath, detailPath)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, storeID, imageID string) string {
+	return c.ServiceURL(resourcePath, storeID, imageID)
+}
@@ -31,6 +31,17 @@ Example to Get a Task
 
 	fmt.Printf("%+v\n", task)
 
+Example to List Tasks of an Image
+
+	imageTasks, err := tasks.ListByImage(imagesClient, "da3b75d9-3f4a-40e7-8a2c-bfab23927dea").Extract()
+	if err != nil {
+	  panic(err)
+	}
+
+	for _, task := range imageTasks {
+	  fmt.Printf("%+v\n", task)
+	}
+
 Example to Create a Task
 
 	createOpts := tasks.CreateOpts{
@@ -95,6 +95,14 @@ func Get(c *gophercloud.ServiceClient, taskID string) (r GetResult) {
 	return
 }
 
+// ListByImage retrieves the tasks associated with a specific image. This
+// requires the Imageservice API version 2.12 or newer.
+func ListByImage(c *gophercloud.ServiceClient, imageID string) (r ListByImageResult) {
+	resp, err := c.Get(listByImageURL(c, imageID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
 // CreateOptsBuilder allows to add additional parameters to the Create request.
 type CreateOptsBuilder interface {
 	ToTaskCreateMap() (map[string]interface{}, error)
@@ -64,6 +64,33 @@ type Task struct {
 
 	// Schema the path to the JSON-schema that represent the task.
 	Schema string `json:"schema"`
+
+	// ImageID is the identifier of the image associated with the task. It is
+	// only returned by ListByImage.
+	ImageID string `json:"image_id"`
+
+	// RequestID is the identifier of the request, which created the task. It
+	// is only returned by ListByImage.
+	RequestID string `json:"request_id"`
+
+	// UserID is the identifier of the user, which created the task. It is only
+	// returned by ListByImage.
+	UserID string `json:"user_id"`
+}
+
+// ListByImageResult represents the result of a ListByImage operation. Call
+// its Extract method to interpret it as a slice of Tasks.
+type ListByImageResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ListByImageResult as a slice of Tasks.
+func (r ListByImageResult) Extract() ([]Task, error) {
+	var s struct {
+		Tasks []Task `json:"tasks"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Tasks, err
 }
 
 // Extract interprets any commonResult as a Task.
@@ -122,3 +122,58 @@ const TaskCreateResult = `
     "schema": "/v2/schemas/task"
 }
 `
+
+// TasksListByImageResult represents raw server response from a server to a
+// list by image call.
+const TasksListByImageResult = `
+{
+    "tasks": [
+        {
+            "id": "ee22890e-8948-4ea6-9668-831f973c84f5",
+            "image_id": "cc5e3c7b-8e54-4bc5-9e4b-1f9b7d9fa0a1",
+            "request_id": "req-a37e2e0e-0a8b-4ba5-a9a1-5ca0e3b4e0f5",
+            "user_id": "d8b7c8f2d3b54eb1b4dd2e8f2e3b1b0f",
+            "type": "api_image_import",
+            "status": "failure",
+            "owner": "424e7cf0243c468ca61732ba45973b3e",
+            "expires_at": "2018-07-27T08:59:14Z",
+            "created_at": "2018-07-25T08:59:13Z",
+            "updated_at": "2018-07-25T08:59:14Z",
+            "message": "Image import failed for store ceph",
+            "input": {
+                "import_req": {
+                    "method": {
+                        "name": "copy-image"
+                    },
+                    "stores": ["ceph"]
+                }
+            },
+            "result": null
+        }
+    ]
+}
+`
+
+// ImageTask is an expected representation of a task from the
+// TasksListByImageResult.
+var ImageTask = tasks.Task{
+	ID:        "ee22890e-8948-4ea6-9668-831f973c84f5",
+	ImageID:   "cc5e3c7b-8e54-4bc5-9e4b-1f9b7d9fa0a1",
+	RequestID: "req-a37e2e0e-0a8b-4ba5-a9a1-5ca0e3b4e0f5",
+	UserID:    "d8b7c8f2d3b54eb1b4dd2e8f2e3b1b0f",
+	Type:      "api_image_import",
+	Status:    string(tasks.TaskStatusFailure),
+	Owner:     "424e7cf0243c468ca61732ba45973b3e",
+	Message:   "Image import failed for store ceph",
+	Input: map[string]interface{}{
+		"import_req": map[string]interface{}{
+			"method": map[string]interface{}{
+				"name": "copy-image",
+			},
+			"stores": []interface{}{"ceph"},
+		},
+	},
+	ExpiresAt: time.Date(2018, 7, 27, 8, 59, 14, 0, time.UTC),
+	CreatedAt: time.Date(2018, 7, 25, 8, 59, 13, 0, time.UTC),
+	UpdatedAt: time.Date(2018, 7, 25, 8, 59, 14, 0, time.UTC),
+}
@@ -136,3 +136,22 @@ func TestCreate(t *testing.T) {
 		},
 	})
 }
+
+func TestListByImage(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/images/cc5e3c7b-8e54-4bc5-9e4b-1f9b7d9fa0a1/tasks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, TasksListByImageResult)
+	})
+
+	s, err := tasks.ListByImage(fakeclient.ServiceClient(), "cc5e3c7b-8e54-4bc5-9e4b-1f9b7d9fa0a1").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []tasks.Task{ImageTask}, s)
+}
@@ -8,7 +8,10 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/utils"
 )
 
-const resourcePath = "tasks"
+const (
+	resourcePath = "tasks"
+	imagesPath   = "images"
+)
 
 func rootURL(c *gophercloud.ServiceClient) string {
 	return c.ServiceURL(resourcePath)
@@ -30,6 +33,10 @@ func createURL(c *gophercloud.ServiceClient) string {
 	return rootURL(c)
 }
 
+func listByImageURL(c *gophercloud.ServiceClient, imageID string) string {
+	return c.ServiceURL(imagesPath, imageID, resourcePath)
+}
+
 func nextPageURL(serviceURL, requestedNext string) (string, error) {
 	base, err := utils.BaseEndpoint(serviceURL)
 	if err != nil {
@@ -30,5 +30,27 @@ Example to Failover an amphora
 	if err != nil {
 		panic(err)
 	}
+
+Example to Get the Statistics of an Amphora
+
+	ampID := "d67d56a6-4a86-4688-a282-f46444705c64"
+
+	stats, err := amphorae.GetStats(octaviaClient, ampID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, s := range stats {
+		fmt.Printf("%s: %d active connections\n", s.ListenerID, s.ActiveConnections)
+	}
+
+Example to Refresh the Configuration of an Amphora
+
+	ampID := "d67d56a6-4a86-4688-a282-f46444705c64"
+
+	err := amphorae.Configure(octaviaClient, ampID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
 */
 package amphorae
@@ -67,3 +67,19 @@ func Failover(c *gophercloud.ServiceClient, id string) (r FailoverResult) {
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// GetStats retrieves the statistics of the listeners served by an amphora.
+func GetStats(c *gophercloud.ServiceClient, id string) (r StatsResult) {
+	resp, err := c.Get(statsURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Configure asks an amphora to refresh its agent configuration.
+func Configure(c *gophercloud.ServiceClient, id string) (r ConfigureResult) {
+	resp, err := c.Put(configURL(c, id), nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -156,3 +156,52 @@ type GetResult struct {
 type FailoverResult struct {
 	gophercloud.ErrResult
 }
+
+// Stats holds the statistics of a listener served by an amphora.
+type Stats struct {
+	// The ID of the amphora.
+	ID string `json:"id"`
+
+	// The ID of the listener.
+	ListenerID string `json:"listener_id"`
+
+	// The ID of the load balancer.
+	LoadbalancerID string `json:"loadbalancer_id"`
+
+	// The currently active connections.
+	ActiveConnections int `json:"active_connections"`
+
+	// The total bytes received.
+	BytesIn int `json:"bytes_in"`
+
+	// The total bytes sent.
+	BytesOut int `json:"bytes_out"`
+
+	// The total requests that were unable to be fulfilled.
+	RequestErrors int `json:"request_errors"`
+
+	// The total connections handled.
+	TotalConnections int `json:"total_connections"`
+}
+
+// StatsResult represents the result of a GetStats operation.
+// Call its Extract method to interpret it as a slice of Stats.
+type StatsResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts the statistics
+// of the listeners of an amphora.
+func (r StatsResult) Extract() ([]Stats, error) {
+	var s struct {
+		Stats []Stats `json:"amphora_stats"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Stats, err
+}
+
+// ConfigureResult represents the result of a configure operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type ConfigureResult struct {
+	gophercloud.ErrResult
+}
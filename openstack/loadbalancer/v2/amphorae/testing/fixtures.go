@@ -177,3 +177,56 @@ func HandleAmphoraFailoverSuccessfully(t *testing.T) {
 		w.WriteHeader(http.StatusAccepted)
 	})
 }
+
+// AmphoraStatsBody contains the canned body of an amphora stats response.
+const AmphoraStatsBody = `
+{
+    "amphora_stats": [
+        {
+            "active_connections": 48629,
+            "bytes_in": 65671420,
+            "bytes_out": 774771186,
+            "id": "36e08a3e-a78f-4b40-a229-1e7e23eee1ab",
+            "listener_id": "bbb35f84-35cc-4b2f-84c2-a6a29bba68aa",
+            "loadbalancer_id": "6bd55cd3-802e-447e-a518-1e74e23bb106",
+            "request_errors": 0,
+            "total_connections": 26189172
+        }
+    ]
+}
+`
+
+// ExpectedStats is the expected result of the amphora stats request.
+var ExpectedStats = []amphorae.Stats{
+	{
+		ActiveConnections: 48629,
+		BytesIn:           65671420,
+		BytesOut:          774771186,
+		ID:                "36e08a3e-a78f-4b40-a229-1e7e23eee1ab",
+		ListenerID:        "bbb35f84-35cc-4b2f-84c2-a6a29bba68aa",
+		LoadbalancerID:    "6bd55cd3-802e-447e-a518-1e74e23bb106",
+		RequestErrors:     0,
+		TotalConnections:  26189172,
+	},
+}
+
+// HandleAmphoraGetStatsSuccessfully sets up the test server to respond to an amphora stats request.
+func HandleAmphoraGetStatsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/octavia/amphorae/36e08a3e-a78f-4b40-a229-1e7e23eee1ab/stats", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+
+		fmt.Fprintf(w, AmphoraStatsBody)
+	})
+}
+
+// HandleAmphoraConfigureSuccessfully sets up the test server to respond to an amphora configure request.
+func HandleAmphoraConfigureSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/octavia/amphorae/36e08a3e-a78f-4b40-a229-1e7e23eee1ab/config", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
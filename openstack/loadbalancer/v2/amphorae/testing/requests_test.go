@@ -72,3 +72,22 @@ func TestFailoverAmphora(t *testing.T) {
 	res := amphorae.Failover(fake.ServiceClient(), "36e08a3e-a78f-4b40-a229-1e7e23eee1ab")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestGetAmphoraStats(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAmphoraGetStatsSuccessfully(t)
+
+	stats, err := amphorae.GetStats(fake.ServiceClient(), "36e08a3e-a78f-4b40-a229-1e7e23eee1ab").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedStats, stats)
+}
+
+func TestConfigureAmphora(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAmphoraConfigureSuccessfully(t)
+
+	res := amphorae.Configure(fake.ServiceClient(), "36e08a3e-a78f-4b40-a229-1e7e23eee1ab")
+	th.AssertNoErr(t, res.Err)
+}
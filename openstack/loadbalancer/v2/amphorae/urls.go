@@ -6,6 +6,8 @@ const (
 	rootPath     = "octavia"
 	resourcePath = "amphorae"
 	failoverPath = "failover"
+	statsPath    = "stats"
+	configPath   = "config"
 )
 
 func rootURL(c *gophercloud.ServiceClient) string {
@@ -19,3 +21,11 @@ func resourceURL(c *gophercloud.ServiceClient, id string) string {
 func failoverRootURL(c *gophercloud.ServiceClient, id string) string {
 	return c.ServiceURL(rootPath, resourcePath, id, failoverPath)
 }
+
+func statsURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, resourcePath, id, statsPath)
+}
+
+func configURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, resourcePath, id, configPath)
+}
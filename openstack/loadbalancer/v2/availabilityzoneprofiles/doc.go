@@ -0,0 +1,55 @@
+/*
+Package availabilityzoneprofiles provides information and interaction with the
+availability zone profiles of the OpenStack Octavia Load Balancing service. An
+availability zone profile holds the provider specific settings of the
+availability zones referring to it.
+
+Example to List Availability Zone Profiles
+
+	allPages, err := availabilityzoneprofiles.List(lbClient, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allProfiles, err := availabilityzoneprofiles.ExtractAvailabilityZoneProfiles(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, azp := range allProfiles {
+		fmt.Printf("%+v\n", azp)
+	}
+
+Example to Create an Availability Zone Profile
+
+	createOpts := availabilityzoneprofiles.CreateOpts{
+		Name:                 "amphora-az1",
+		ProviderName:         "amphora",
+		AvailabilityZoneData: `{"compute_zone": "az1"}`,
+	}
+
+	azProfile, err := availabilityzoneprofiles.Create(lbClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update an Availability Zone Profile
+
+	zoneData := `{"compute_zone": "az2"}`
+	updateOpts := availabilityzoneprofiles.UpdateOpts{
+		AvailabilityZoneData: &zoneData,
+	}
+
+	azProfile, err := availabilityzoneprofiles.Update(lbClient, "9a1c2f6e-3b7d-4e8a-a5c4-0e2b7f1d6c3a", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete an Availability Zone Profile
+
+	err := availabilityzoneprofiles.Delete(lbClient, "9a1c2f6e-3b7d-4e8a-a5c4-0e2b7f1d6c3a").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package availabilityzoneprofiles
@@ -0,0 +1,2 @@
+// availabilityzoneprofiles unit tests
+package testing
@@ -0,0 +1,162 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/availabilityzoneprofiles"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// AvailabilityZoneProfilesListBody contains the canned body of an availability
+// zone profile list response.
+const AvailabilityZoneProfilesListBody = `
+{
+	"availability_zone_profiles": [
+		{
+			"id": "9a1c2f6e-3b7d-4e8a-a5c4-0e2b7f1d6c3a",
+			"name": "amphora-az1",
+			"provider_name": "amphora",
+			"availability_zone_data": "{\"compute_zone\": \"az1\"}"
+		},
+		{
+			"id": "7e2b5d1a-8c4f-4a9e-b3d6-1f5c8e2a4b7d",
+			"name": "amphora-az2",
+			"provider_name": "amphora",
+			"availability_zone_data": "{\"compute_zone\": \"az2\"}"
+		}
+	]
+}
+`
+
+// SingleAvailabilityZoneProfileBody is the canned body of a Get request on an
+// existing availability zone profile.
+const SingleAvailabilityZoneProfileBody = `
+{
+	"availability_zone_profile": {
+		"id": "9a1c2f6e-3b7d-4e8a-a5c4-0e2b7f1d6c3a",
+		"name": "amphora-az1",
+		"provider_name": "amphora",
+		"availability_zone_data": "{\"compute_zone\": \"az1\"}"
+	}
+}
+`
+
+// PostUpdateAvailabilityZoneProfileBody is the canned response body of an
+// Update request on an existing availability zone profile.
+const PostUpdateAvailabilityZoneProfileBody = `
+{
+	"availability_zone_profile": {
+		"id": "9a1c2f6e-3b7d-4e8a-a5c4-0e2b7f1d6c3a",
+		"name": "amphora-az1",
+		"provider_name": "amphora",
+		"availability_zone_data": "{\"compute_zone\": \"az2\"}"
+	}
+}
+`
+
+var (
+	AvailabilityZoneProfileAZ1 = availabilityzoneprofiles.AvailabilityZoneProfile{
+		ID:                   "9a1c2f6e-3b7d-4e8a-a5c4-0e2b7f1d6c3a",
+		Name:                 "amphora-az1",
+		ProviderName:         "amphora",
+		AvailabilityZoneData: `{"compute_zone": "az1"}`,
+	}
+	AvailabilityZoneProfileAZ2 = availabilityzoneprofiles.AvailabilityZoneProfile{
+		ID:                   "7e2b5d1a-8c4f-4a9e-b3d6-1f5c8e2a4b7d",
+		Name:                 "amphora-az2",
+		ProviderName:         "amphora",
+		AvailabilityZoneData: `{"compute_zone": "az2"}`,
+	}
+	AvailabilityZoneProfileUpdated = availabilityzoneprofiles.AvailabilityZoneProfile{
+		ID:                   "9a1c2f6e-3b7d-4e8a-a5c4-0e2b7f1d6c3a",
+		Name:                 "amphora-az1",
+		ProviderName:         "amphora",
+		AvailabilityZoneData: `{"compute_zone": "az2"}`,
+	}
+)
+
+// HandleAvailabilityZoneProfileListSuccessfully sets up the test server to
+// respond to a availability zone profile List request.
+func HandleAvailabilityZoneProfileListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/availabilityzoneprofiles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		r.ParseForm()
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			fmt.Fprintf(w, AvailabilityZoneProfilesListBody)
+		case "7e2b5d1a-8c4f-4a9e-b3d6-1f5c8e2a4b7d":
+			fmt.Fprintf(w, `{ "availability_zone_profiles": [] }`)
+		default:
+			t.Fatalf("/v2.0/lbaas/availabilityzoneprofiles invoked with unexpected marker=[%s]", marker)
+		}
+	})
+}
+
+// HandleAvailabilityZoneProfileCreationSuccessfully sets up the test server to
+// respond to an availability zone profile creation request with a given
+// response.
+func HandleAvailabilityZoneProfileCreationSuccessfully(t *testing.T, response string) {
+	th.Mux.HandleFunc("/v2.0/lbaas/availabilityzoneprofiles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{
+			"availability_zone_profile": {
+				"name": "amphora-az1",
+				"provider_name": "amphora",
+				"availability_zone_data": "{\"compute_zone\": \"az1\"}"
+			}
+		}`)
+
+		w.WriteHeader(http.StatusCreated)
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, response)
+	})
+}
+
+// HandleAvailabilityZoneProfileGetSuccessfully sets up the test server to
+// respond to a availability zone profile Get request.
+func HandleAvailabilityZoneProfileGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/availabilityzoneprofiles/9a1c2f6e-3b7d-4e8a-a5c4-0e2b7f1d6c3a", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+
+		fmt.Fprintf(w, SingleAvailabilityZoneProfileBody)
+	})
+}
+
+// HandleAvailabilityZoneProfileDeletionSuccessfully sets up the test server to
+// respond to an availability zone profile deletion request.
+func HandleAvailabilityZoneProfileDeletionSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/availabilityzoneprofiles/9a1c2f6e-3b7d-4e8a-a5c4-0e2b7f1d6c3a", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleAvailabilityZoneProfileUpdateSuccessfully sets up the test server to
+// respond to an availability zone profile Update request.
+func HandleAvailabilityZoneProfileUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/availabilityzoneprofiles/9a1c2f6e-3b7d-4e8a-a5c4-0e2b7f1d6c3a", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestJSONRequest(t, r, `{
+			"availability_zone_profile": {
+				"availability_zone_data": "{\"compute_zone\": \"az2\"}"
+			}
+		}`)
+
+		fmt.Fprintf(w, PostUpdateAvailabilityZoneProfileBody)
+	})
+}
@@ -0,0 +1,104 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/availabilityzoneprofiles"
+	fake "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/testhelper"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestListAvailabilityZoneProfiles(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAvailabilityZoneProfileListSuccessfully(t)
+
+	pages := 0
+	err := availabilityzoneprofiles.List(fake.ServiceClient(), availabilityzoneprofiles.ListOpts{}).EachPage(func(page pagination.Page) (bool, error) {
+		pages++
+
+		actual, err := availabilityzoneprofiles.ExtractAvailabilityZoneProfiles(page)
+		if err != nil {
+			return false, err
+		}
+
+		if len(actual) != 2 {
+			t.Fatalf("Expected 2 availability zone profiles, got %d", len(actual))
+		}
+		th.CheckDeepEquals(t, AvailabilityZoneProfileAZ1, actual[0])
+		th.CheckDeepEquals(t, AvailabilityZoneProfileAZ2, actual[1])
+
+		return true, nil
+	})
+
+	th.AssertNoErr(t, err)
+
+	if pages != 1 {
+		t.Errorf("Expected 1 page, saw %d", pages)
+	}
+}
+
+func TestCreateAvailabilityZoneProfile(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAvailabilityZoneProfileCreationSuccessfully(t, SingleAvailabilityZoneProfileBody)
+
+	actual, err := availabilityzoneprofiles.Create(fake.ServiceClient(), availabilityzoneprofiles.CreateOpts{
+		Name:                 "amphora-az1",
+		ProviderName:         "amphora",
+		AvailabilityZoneData: `{"compute_zone": "az1"}`,
+	}).Extract()
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, AvailabilityZoneProfileAZ1, *actual)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	res := availabilityzoneprofiles.Create(fake.ServiceClient(), availabilityzoneprofiles.CreateOpts{})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+	res = availabilityzoneprofiles.Create(fake.ServiceClient(), availabilityzoneprofiles.CreateOpts{Name: "amphora-az1", ProviderName: "amphora"})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestGetAvailabilityZoneProfile(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAvailabilityZoneProfileGetSuccessfully(t)
+
+	actual, err := availabilityzoneprofiles.Get(fake.ServiceClient(), "9a1c2f6e-3b7d-4e8a-a5c4-0e2b7f1d6c3a").Extract()
+	if err != nil {
+		t.Fatalf("Unexpected Get error: %v", err)
+	}
+
+	th.CheckDeepEquals(t, AvailabilityZoneProfileAZ1, *actual)
+}
+
+func TestDeleteAvailabilityZoneProfile(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAvailabilityZoneProfileDeletionSuccessfully(t)
+
+	res := availabilityzoneprofiles.Delete(fake.ServiceClient(), "9a1c2f6e-3b7d-4e8a-a5c4-0e2b7f1d6c3a")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestUpdateAvailabilityZoneProfile(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAvailabilityZoneProfileUpdateSuccessfully(t)
+
+	zoneData := `{"compute_zone": "az2"}`
+	actual, err := availabilityzoneprofiles.Update(fake.ServiceClient(), "9a1c2f6e-3b7d-4e8a-a5c4-0e2b7f1d6c3a", availabilityzoneprofiles.UpdateOpts{
+		AvailabilityZoneData: &zoneData,
+	}).Extract()
+	if err != nil {
+		t.Fatalf("Unexpected Update error: %v", err)
+	}
+
+	th.CheckDeepEquals(t, AvailabilityZoneProfileUpdated, *actual)
+}
@@ -0,0 +1,55 @@
+/*
+Package availabilityzones provides information and interaction with the
+availability zones of the OpenStack Octavia Load Balancing service. An
+availability zone is a named location, configured by an availability zone
+profile, users pick when creating a load balancer.
+
+Example to List Availability Zones
+
+	allPages, err := availabilityzones.List(lbClient, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allAvailabilityZones, err := availabilityzones.ExtractAvailabilityZones(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, az := range allAvailabilityZones {
+		fmt.Printf("%+v\n", az)
+	}
+
+Example to Create an Availability Zone
+
+	createOpts := availabilityzones.CreateOpts{
+		Name:                      "az1",
+		Description:               "First availability zone",
+		AvailabilityZoneProfileID: "9a1c2f6e-3b7d-4e8a-a5c4-0e2b7f1d6c3a",
+	}
+
+	az, err := availabilityzones.Create(lbClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Disable an Availability Zone
+
+	enabled := false
+	updateOpts := availabilityzones.UpdateOpts{
+		Enabled: &enabled,
+	}
+
+	az, err := availabilityzones.Update(lbClient, "az1", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete an Availability Zone
+
+	err := availabilityzones.Delete(lbClient, "az1").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package availabilityzones
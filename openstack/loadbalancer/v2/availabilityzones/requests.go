@@ -0,0 +1,145 @@
+package availabilityzones
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToAvailabilityZoneListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the AvailabilityZone attributes you want to see returned. SortKey allows you
+// to sort by a particular attribute. SortDir sets the direction, and is either
+// `asc' or `desc'. Marker and Limit are used for pagination.
+type ListOpts struct {
+	Name                      string   `q:"name"`
+	Description               string   `q:"description"`
+	AvailabilityZoneProfileID string   `q:"availability_zone_profile_id"`
+	Enabled                   *bool    `q:"enabled"`
+	Fields                    []string `q:"fields"`
+	Marker                    string   `q:"marker"`
+	Limit                     int      `q:"limit"`
+	SortKey                   string   `q:"sort_key"`
+	SortDir                   string   `q:"sort_dir"`
+}
+
+// ToAvailabilityZoneListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToAvailabilityZoneListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// availabilityzones.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToAvailabilityZoneListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return AvailabilityZonePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToAvailabilityZoneCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts is the common options struct used in this package's Create
+// operation.
+type CreateOpts struct {
+	// The name of the availability zone. It must match the name of the
+	// availability zone in the compute service for the amphora provider.
+	Name string `json:"name" required:"true"`
+
+	// Human-readable description for the availability zone.
+	Description string `json:"description,omitempty"`
+
+	// The ID of the availability zone profile holding the settings of the
+	// availability zone.
+	AvailabilityZoneProfileID string `json:"availability_zone_profile_id" required:"true"`
+
+	// Whether the availability zone can be used to create new load balancers.
+	// Defaults to true.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// ToAvailabilityZoneCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToAvailabilityZoneCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "availability_zone")
+}
+
+// Create is an operation which provisions a new availability zone based on the
+// configuration defined in the CreateOpts struct.
+func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToAvailabilityZoneCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(rootURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves a particular availability zone based on its name.
+func Get(c *gophercloud.ServiceClient, name string) (r GetResult) {
+	resp, err := c.Get(resourceURL(c, name), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToAvailabilityZoneUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts is the common options struct used in this package's Update
+// operation. The name and availability zone profile of an availability zone
+// cannot be changed.
+type UpdateOpts struct {
+	// Human-readable description for the availability zone.
+	Description *string `json:"description,omitempty"`
+
+	// Whether the availability zone can be used to create new load balancers.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// ToAvailabilityZoneUpdateMap builds a request body from UpdateOpts.
+func (opts UpdateOpts) ToAvailabilityZoneUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "availability_zone")
+}
+
+// Update allows availability zones to be updated.
+func Update(c *gophercloud.ServiceClient, name string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToAvailabilityZoneUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(resourceURL(c, name), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete will permanently delete a particular availability zone based on its
+// name. An availability zone still used by a load balancer cannot be deleted.
+func Delete(c *gophercloud.ServiceClient, name string) (r DeleteResult) {
+	resp, err := c.Delete(resourceURL(c, name), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
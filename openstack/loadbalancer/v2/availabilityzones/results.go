@@ -0,0 +1,104 @@
+package availabilityzones
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// AvailabilityZone is a named location, such as a compute availability zone,
+// users pick when creating a load balancer.
+type AvailabilityZone struct {
+	// The name of the availability zone, identifying it.
+	Name string `json:"name"`
+
+	// Human-readable description for the availability zone.
+	Description string `json:"description"`
+
+	// The ID of the availability zone profile holding the settings of the
+	// availability zone.
+	AvailabilityZoneProfileID string `json:"availability_zone_profile_id"`
+
+	// Whether the availability zone can be used to create new load
+	// balancers.
+	Enabled bool `json:"enabled"`
+}
+
+// AvailabilityZonePage is the page returned by a pager when traversing over a
+// collection of availability zones.
+type AvailabilityZonePage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of availability zones has
+// reached the end of a page and the pager seeks to traverse over a new one. In
+// order to do this, it needs to construct the next page's URL.
+func (r AvailabilityZonePage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"availability_zones_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether an AvailabilityZonePage struct is empty.
+func (r AvailabilityZonePage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractAvailabilityZones(r)
+	return len(is) == 0, err
+}
+
+// ExtractAvailabilityZones accepts a Page struct, specifically an
+// AvailabilityZonePage struct, and extracts the elements into a slice of
+// AvailabilityZone structs. In other words, a generic collection is mapped into
+// a relevant slice.
+func ExtractAvailabilityZones(r pagination.Page) ([]AvailabilityZone, error) {
+	var s struct {
+		AvailabilityZones []AvailabilityZone `json:"availability_zones"`
+	}
+	err := (r.(AvailabilityZonePage)).ExtractInto(&s)
+	return s.AvailabilityZones, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts an
+// availability zone.
+func (r commonResult) Extract() (*AvailabilityZone, error) {
+	var s struct {
+		AvailabilityZone *AvailabilityZone `json:"availability_zone"`
+	}
+	err := r.ExtractInto(&s)
+	return s.AvailabilityZone, err
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as an AvailabilityZone.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as an AvailabilityZone.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as an AvailabilityZone.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
@@ -0,0 +1,162 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/availabilityzones"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// AvailabilityZonesListBody contains the canned body of an availability zone
+// list response.
+const AvailabilityZonesListBody = `
+{
+	"availability_zones": [
+		{
+			"name": "az1",
+			"description": "First availability zone",
+			"availability_zone_profile_id": "9a1c2f6e-3b7d-4e8a-a5c4-0e2b7f1d6c3a",
+			"enabled": true
+		},
+		{
+			"name": "az2",
+			"description": "Second availability zone",
+			"availability_zone_profile_id": "7e2b5d1a-8c4f-4a9e-b3d6-1f5c8e2a4b7d",
+			"enabled": false
+		}
+	]
+}
+`
+
+// SingleAvailabilityZoneBody is the canned body of a Get request on an existing
+// availability zone.
+const SingleAvailabilityZoneBody = `
+{
+	"availability_zone": {
+		"name": "az1",
+		"description": "First availability zone",
+		"availability_zone_profile_id": "9a1c2f6e-3b7d-4e8a-a5c4-0e2b7f1d6c3a",
+		"enabled": true
+	}
+}
+`
+
+// PostUpdateAvailabilityZoneBody is the canned response body of an Update
+// request on an existing availability zone.
+const PostUpdateAvailabilityZoneBody = `
+{
+	"availability_zone": {
+		"name": "az1",
+		"description": "First availability zone",
+		"availability_zone_profile_id": "9a1c2f6e-3b7d-4e8a-a5c4-0e2b7f1d6c3a",
+		"enabled": false
+	}
+}
+`
+
+var (
+	AvailabilityZoneAZ1 = availabilityzones.AvailabilityZone{
+		Name:                      "az1",
+		Description:               "First availability zone",
+		AvailabilityZoneProfileID: "9a1c2f6e-3b7d-4e8a-a5c4-0e2b7f1d6c3a",
+		Enabled:                   true,
+	}
+	AvailabilityZoneAZ2 = availabilityzones.AvailabilityZone{
+		Name:                      "az2",
+		Description:               "Second availability zone",
+		AvailabilityZoneProfileID: "7e2b5d1a-8c4f-4a9e-b3d6-1f5c8e2a4b7d",
+		Enabled:                   false,
+	}
+	AvailabilityZoneUpdated = availabilityzones.AvailabilityZone{
+		Name:                      "az1",
+		Description:               "First availability zone",
+		AvailabilityZoneProfileID: "9a1c2f6e-3b7d-4e8a-a5c4-0e2b7f1d6c3a",
+		Enabled:                   false,
+	}
+)
+
+// HandleAvailabilityZoneListSuccessfully sets up the test server to respond to
+// an availability zone List request.
+func HandleAvailabilityZoneListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/availabilityzones", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		r.ParseForm()
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			fmt.Fprintf(w, AvailabilityZonesListBody)
+		case "az2":
+			fmt.Fprintf(w, `{ "availability_zones": [] }`)
+		default:
+			t.Fatalf("/v2.0/lbaas/availabilityzones invoked with unexpected marker=[%s]", marker)
+		}
+	})
+}
+
+// HandleAvailabilityZoneCreationSuccessfully sets up the test server to respond
+// to a availability zone creation request with a given response.
+func HandleAvailabilityZoneCreationSuccessfully(t *testing.T, response string) {
+	th.Mux.HandleFunc("/v2.0/lbaas/availabilityzones", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{
+			"availability_zone": {
+				"name": "az1",
+				"description": "First availability zone",
+				"availability_zone_profile_id": "9a1c2f6e-3b7d-4e8a-a5c4-0e2b7f1d6c3a",
+				"enabled": true
+			}
+		}`)
+
+		w.WriteHeader(http.StatusCreated)
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, response)
+	})
+}
+
+// HandleAvailabilityZoneGetSuccessfully sets up the test server to respond to
+// an availability zone Get request.
+func HandleAvailabilityZoneGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/availabilityzones/az1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+
+		fmt.Fprintf(w, SingleAvailabilityZoneBody)
+	})
+}
+
+// HandleAvailabilityZoneDeletionSuccessfully sets up the test server to respond
+// to a availability zone deletion request.
+func HandleAvailabilityZoneDeletionSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/availabilityzones/az1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleAvailabilityZoneUpdateSuccessfully sets up the test server to respond
+// to a availability zone Update request.
+func HandleAvailabilityZoneUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/availabilityzones/az1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestJSONRequest(t, r, `{
+			"availability_zone": {
+				"enabled": false
+			}
+		}`)
+
+		fmt.Fprintf(w, PostUpdateAvailabilityZoneBody)
+	})
+}
@@ -0,0 +1,118 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/availabilityzones"
+	fake "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/testhelper"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestListAvailabilityZones(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAvailabilityZoneListSuccessfully(t)
+
+	pages := 0
+	err := availabilityzones.List(fake.ServiceClient(), availabilityzones.ListOpts{}).EachPage(func(page pagination.Page) (bool, error) {
+		pages++
+
+		actual, err := availabilityzones.ExtractAvailabilityZones(page)
+		if err != nil {
+			return false, err
+		}
+
+		if len(actual) != 2 {
+			t.Fatalf("Expected 2 availabilityzones, got %d", len(actual))
+		}
+		th.CheckDeepEquals(t, AvailabilityZoneAZ1, actual[0])
+		th.CheckDeepEquals(t, AvailabilityZoneAZ2, actual[1])
+
+		return true, nil
+	})
+
+	th.AssertNoErr(t, err)
+
+	if pages != 1 {
+		t.Errorf("Expected 1 page, saw %d", pages)
+	}
+}
+
+func TestListAllAvailabilityZones(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAvailabilityZoneListSuccessfully(t)
+
+	allPages, err := availabilityzones.List(fake.ServiceClient(), availabilityzones.ListOpts{}).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := availabilityzones.ExtractAvailabilityZones(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []availabilityzones.AvailabilityZone{AvailabilityZoneAZ1, AvailabilityZoneAZ2}, actual)
+}
+
+func TestCreateAvailabilityZone(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAvailabilityZoneCreationSuccessfully(t, SingleAvailabilityZoneBody)
+
+	enabled := true
+	actual, err := availabilityzones.Create(fake.ServiceClient(), availabilityzones.CreateOpts{
+		Name:                      "az1",
+		Description:               "First availability zone",
+		AvailabilityZoneProfileID: "9a1c2f6e-3b7d-4e8a-a5c4-0e2b7f1d6c3a",
+		Enabled:                   &enabled,
+	}).Extract()
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, AvailabilityZoneAZ1, *actual)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	res := availabilityzones.Create(fake.ServiceClient(), availabilityzones.CreateOpts{})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+	res = availabilityzones.Create(fake.ServiceClient(), availabilityzones.CreateOpts{Name: "az1"})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestGetAvailabilityZone(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAvailabilityZoneGetSuccessfully(t)
+
+	actual, err := availabilityzones.Get(fake.ServiceClient(), "az1").Extract()
+	if err != nil {
+		t.Fatalf("Unexpected Get error: %v", err)
+	}
+
+	th.CheckDeepEquals(t, AvailabilityZoneAZ1, *actual)
+}
+
+func TestDeleteAvailabilityZone(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAvailabilityZoneDeletionSuccessfully(t)
+
+	res := availabilityzones.Delete(fake.ServiceClient(), "az1")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestUpdateAvailabilityZone(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAvailabilityZoneUpdateSuccessfully(t)
+
+	enabled := false
+	actual, err := availabilityzones.Update(fake.ServiceClient(), "az1", availabilityzones.UpdateOpts{
+		Enabled: &enabled,
+	}).Extract()
+	if err != nil {
+		t.Fatalf("Unexpected Update error: %v", err)
+	}
+
+	th.CheckDeepEquals(t, AvailabilityZoneUpdated, *actual)
+}
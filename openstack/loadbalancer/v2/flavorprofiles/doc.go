@@ -0,0 +1,54 @@
+/*
+Package flavorprofiles provides information and interaction with the flavor
+profiles of the OpenStack Octavia Load Balancing service. A flavor profile
+holds the provider specific settings of the flavors referring to it.
+
+Example to List Flavor Profiles
+
+	allPages, err := flavorprofiles.List(lbClient, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allFlavorProfiles, err := flavorprofiles.ExtractFlavorProfiles(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, fp := range allFlavorProfiles {
+		fmt.Printf("%+v\n", fp)
+	}
+
+Example to Create a Flavor Profile
+
+	createOpts := flavorprofiles.CreateOpts{
+		Name:         "amphora-single",
+		ProviderName: "amphora",
+		FlavorData:   `{"loadbalancer_topology": "SINGLE"}`,
+	}
+
+	flavorProfile, err := flavorprofiles.Create(lbClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Flavor Profile
+
+	flavorData := `{"loadbalancer_topology": "ACTIVE_STANDBY"}`
+	updateOpts := flavorprofiles.UpdateOpts{
+		FlavorData: &flavorData,
+	}
+
+	flavorProfile, err := flavorprofiles.Update(lbClient, "dcd65be5-f117-4260-ab3d-b32cc5bd1272", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Flavor Profile
+
+	err := flavorprofiles.Delete(lbClient, "dcd65be5-f117-4260-ab3d-b32cc5bd1272").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package flavorprofiles
@@ -0,0 +1,2 @@
+// flavorprofiles unit tests
+package testing
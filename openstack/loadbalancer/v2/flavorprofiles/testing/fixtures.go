@@ -0,0 +1,161 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/flavorprofiles"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// FlavorProfilesListBody contains the canned body of a flavor profile list
+// response.
+const FlavorProfilesListBody = `
+{
+	"flavorprofiles": [
+		{
+			"id": "dcd65be5-f117-4260-ab3d-b32cc5bd1272",
+			"name": "amphora-single",
+			"provider_name": "amphora",
+			"flavor_data": "{\"loadbalancer_topology\": \"SINGLE\"}"
+		},
+		{
+			"id": "4c6c1d44-0a4a-4e5b-8c59-2e3e1a1c6e2f",
+			"name": "amphora-act-stdby",
+			"provider_name": "amphora",
+			"flavor_data": "{\"loadbalancer_topology\": \"ACTIVE_STANDBY\"}"
+		}
+	]
+}
+`
+
+// SingleFlavorProfileBody is the canned body of a Get request on an existing
+// flavor profile.
+const SingleFlavorProfileBody = `
+{
+	"flavorprofile": {
+		"id": "dcd65be5-f117-4260-ab3d-b32cc5bd1272",
+		"name": "amphora-single",
+		"provider_name": "amphora",
+		"flavor_data": "{\"loadbalancer_topology\": \"SINGLE\"}"
+	}
+}
+`
+
+// PostUpdateFlavorProfileBody is the canned response body of an Update
+// request on an existing flavor profile.
+const PostUpdateFlavorProfileBody = `
+{
+	"flavorprofile": {
+		"id": "dcd65be5-f117-4260-ab3d-b32cc5bd1272",
+		"name": "amphora-single",
+		"provider_name": "amphora",
+		"flavor_data": "{\"loadbalancer_topology\": \"ACTIVE_STANDBY\"}"
+	}
+}
+`
+
+var (
+	FlavorProfileSingle = flavorprofiles.FlavorProfile{
+		ID:           "dcd65be5-f117-4260-ab3d-b32cc5bd1272",
+		Name:         "amphora-single",
+		ProviderName: "amphora",
+		FlavorData:   `{"loadbalancer_topology": "SINGLE"}`,
+	}
+	FlavorProfileActStdby = flavorprofiles.FlavorProfile{
+		ID:           "4c6c1d44-0a4a-4e5b-8c59-2e3e1a1c6e2f",
+		Name:         "amphora-act-stdby",
+		ProviderName: "amphora",
+		FlavorData:   `{"loadbalancer_topology": "ACTIVE_STANDBY"}`,
+	}
+	FlavorProfileUpdated = flavorprofiles.FlavorProfile{
+		ID:           "dcd65be5-f117-4260-ab3d-b32cc5bd1272",
+		Name:         "amphora-single",
+		ProviderName: "amphora",
+		FlavorData:   `{"loadbalancer_topology": "ACTIVE_STANDBY"}`,
+	}
+)
+
+// HandleFlavorProfileListSuccessfully sets up the test server to respond to a
+// flavor profile List request.
+func HandleFlavorProfileListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/flavorprofiles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		r.ParseForm()
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			fmt.Fprintf(w, FlavorProfilesListBody)
+		case "4c6c1d44-0a4a-4e5b-8c59-2e3e1a1c6e2f":
+			fmt.Fprintf(w, `{ "flavorprofiles": [] }`)
+		default:
+			t.Fatalf("/v2.0/lbaas/flavorprofiles invoked with unexpected marker=[%s]", marker)
+		}
+	})
+}
+
+// HandleFlavorProfileCreationSuccessfully sets up the test server to respond
+// to a flavor profile creation request with a given response.
+func HandleFlavorProfileCreationSuccessfully(t *testing.T, response string) {
+	th.Mux.HandleFunc("/v2.0/lbaas/flavorprofiles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{
+			"flavorprofile": {
+				"name": "amphora-single",
+				"provider_name": "amphora",
+				"flavor_data": "{\"loadbalancer_topology\": \"SINGLE\"}"
+			}
+		}`)
+
+		w.WriteHeader(http.StatusCreated)
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, response)
+	})
+}
+
+// HandleFlavorProfileGetSuccessfully sets up the test server to respond to a
+// flavor profile Get request.
+func HandleFlavorProfileGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/flavorprofiles/dcd65be5-f117-4260-ab3d-b32cc5bd1272", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+
+		fmt.Fprintf(w, SingleFlavorProfileBody)
+	})
+}
+
+// HandleFlavorProfileDeletionSuccessfully sets up the test server to respond
+// to a flavor profile deletion request.
+func HandleFlavorProfileDeletionSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/flavorprofiles/dcd65be5-f117-4260-ab3d-b32cc5bd1272", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleFlavorProfileUpdateSuccessfully sets up the test server to respond to
+// a flavor profile Update request.
+func HandleFlavorProfileUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/flavorprofiles/dcd65be5-f117-4260-ab3d-b32cc5bd1272", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestJSONRequest(t, r, `{
+			"flavorprofile": {
+				"flavor_data": "{\"loadbalancer_topology\": \"ACTIVE_STANDBY\"}"
+			}
+		}`)
+
+		fmt.Fprintf(w, PostUpdateFlavorProfileBody)
+	})
+}
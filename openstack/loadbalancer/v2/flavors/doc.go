@@ -0,0 +1,55 @@
+/*
+Package flavors provides information and interaction with the flavors of the
+OpenStack Octavia Load Balancing service. A flavor is a named set of load
+balancer settings, held by a flavor profile, users pick when creating a load
+balancer.
+
+Example to List Flavors
+
+	allPages, err := flavors.List(lbClient, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allFlavors, err := flavors.ExtractFlavors(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, flavor := range allFlavors {
+		fmt.Printf("%+v\n", flavor)
+	}
+
+Example to Create a Flavor
+
+	createOpts := flavors.CreateOpts{
+		Name:            "gold",
+		Description:     "Highly available load balancers",
+		FlavorProfileID: "dcd65be5-f117-4260-ab3d-b32cc5bd1272",
+	}
+
+	flavor, err := flavors.Create(lbClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Disable a Flavor
+
+	enabled := false
+	updateOpts := flavors.UpdateOpts{
+		Enabled: &enabled,
+	}
+
+	flavor, err := flavors.Update(lbClient, "5548c807-e6e8-43d7-9ea4-b38d34dd74a0", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Flavor
+
+	err := flavors.Delete(lbClient, "5548c807-e6e8-43d7-9ea4-b38d34dd74a0").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package flavors
@@ -0,0 +1,146 @@
+package flavors
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToFlavorListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the Flavor attributes you want to see returned. SortKey allows you to sort
+// by a particular attribute. SortDir sets the direction, and is either `asc'
+// or `desc'. Marker and Limit are used for pagination.
+type ListOpts struct {
+	ID              string   `q:"id"`
+	Name            string   `q:"name"`
+	Description     string   `q:"description"`
+	FlavorProfileID string   `q:"flavor_profile_id"`
+	Enabled         *bool    `q:"enabled"`
+	Fields          []string `q:"fields"`
+	Marker          string   `q:"marker"`
+	Limit           int      `q:"limit"`
+	SortKey         string   `q:"sort_key"`
+	SortDir         string   `q:"sort_dir"`
+}
+
+// ToFlavorListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToFlavorListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// flavors.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToFlavorListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return FlavorPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToFlavorCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts is the common options struct used in this package's Create
+// operation.
+type CreateOpts struct {
+	// Human-readable name for the flavor.
+	Name string `json:"name" required:"true"`
+
+	// Human-readable description for the flavor.
+	Description string `json:"description,omitempty"`
+
+	// The ID of the flavor profile holding the settings of the flavor.
+	FlavorProfileID string `json:"flavor_profile_id" required:"true"`
+
+	// Whether the flavor can be used to create new load balancers. Defaults
+	// to true.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// ToFlavorCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToFlavorCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "flavor")
+}
+
+// Create is an operation which provisions a new flavor based on the
+// configuration defined in the CreateOpts struct.
+func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToFlavorCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(rootURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves a particular flavor based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(resourceURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToFlavorUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts is the common options struct used in this package's Update
+// operation. The flavor profile of a flavor cannot be changed.
+type UpdateOpts struct {
+	// Human-readable name for the flavor.
+	Name *string `json:"name,omitempty"`
+
+	// Human-readable description for the flavor.
+	Description *string `json:"description,omitempty"`
+
+	// Whether the flavor can be used to create new load balancers.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// ToFlavorUpdateMap builds a request body from UpdateOpts.
+func (opts UpdateOpts) ToFlavorUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "flavor")
+}
+
+// Update allows flavors to be updated.
+func Update(c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToFlavorUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(resourceURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete will permanently delete a particular flavor based on its unique ID.
+// A flavor still used by a load balancer cannot be deleted.
+func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(resourceURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
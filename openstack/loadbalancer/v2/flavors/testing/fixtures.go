@@ -0,0 +1,167 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/flavors"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// FlavorsListBody contains the canned body of a flavor list response.
+const FlavorsListBody = `
+{
+	"flavors": [
+		{
+			"id": "5548c807-e6e8-43d7-9ea4-b38d34dd74a0",
+			"name": "gold",
+			"description": "Highly available load balancers",
+			"flavor_profile_id": "4c6c1d44-0a4a-4e5b-8c59-2e3e1a1c6e2f",
+			"enabled": true
+		},
+		{
+			"id": "a8e2b4f1-0a7d-4c0b-9b2e-6d1f3e5c7a9b",
+			"name": "bronze",
+			"description": "Single amphora load balancers",
+			"flavor_profile_id": "dcd65be5-f117-4260-ab3d-b32cc5bd1272",
+			"enabled": false
+		}
+	]
+}
+`
+
+// SingleFlavorBody is the canned body of a Get request on an existing flavor.
+const SingleFlavorBody = `
+{
+	"flavor": {
+		"id": "5548c807-e6e8-43d7-9ea4-b38d34dd74a0",
+		"name": "gold",
+		"description": "Highly available load balancers",
+		"flavor_profile_id": "4c6c1d44-0a4a-4e5b-8c59-2e3e1a1c6e2f",
+		"enabled": true
+	}
+}
+`
+
+// PostUpdateFlavorBody is the canned response body of an Update request on
+// an existing flavor.
+const PostUpdateFlavorBody = `
+{
+	"flavor": {
+		"id": "5548c807-e6e8-43d7-9ea4-b38d34dd74a0",
+		"name": "gold",
+		"description": "Highly available load balancers",
+		"flavor_profile_id": "4c6c1d44-0a4a-4e5b-8c59-2e3e1a1c6e2f",
+		"enabled": false
+	}
+}
+`
+
+var (
+	FlavorGold = flavors.Flavor{
+		ID:              "5548c807-e6e8-43d7-9ea4-b38d34dd74a0",
+		Name:            "gold",
+		Description:     "Highly available load balancers",
+		FlavorProfileID: "4c6c1d44-0a4a-4e5b-8c59-2e3e1a1c6e2f",
+		Enabled:         true,
+	}
+	FlavorBronze = flavors.Flavor{
+		ID:              "a8e2b4f1-0a7d-4c0b-9b2e-6d1f3e5c7a9b",
+		Name:            "bronze",
+		Description:     "Single amphora load balancers",
+		FlavorProfileID: "dcd65be5-f117-4260-ab3d-b32cc5bd1272",
+		Enabled:         false,
+	}
+	FlavorUpdated = flavors.Flavor{
+		ID:              "5548c807-e6e8-43d7-9ea4-b38d34dd74a0",
+		Name:            "gold",
+		Description:     "Highly available load balancers",
+		FlavorProfileID: "4c6c1d44-0a4a-4e5b-8c59-2e3e1a1c6e2f",
+		Enabled:         false,
+	}
+)
+
+// HandleFlavorListSuccessfully sets up the test server to respond to a flavor
+// List request.
+func HandleFlavorListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/flavors", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		r.ParseForm()
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			fmt.Fprintf(w, FlavorsListBody)
+		case "a8e2b4f1-0a7d-4c0b-9b2e-6d1f3e5c7a9b":
+			fmt.Fprintf(w, `{ "flavors": [] }`)
+		default:
+			t.Fatalf("/v2.0/lbaas/flavors invoked with unexpected marker=[%s]", marker)
+		}
+	})
+}
+
+// HandleFlavorCreationSuccessfully sets up the test server to respond to a
+// flavor creation request with a given response.
+func HandleFlavorCreationSuccessfully(t *testing.T, response string) {
+	th.Mux.HandleFunc("/v2.0/lbaas/flavors", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{
+			"flavor": {
+				"name": "gold",
+				"description": "Highly available load balancers",
+				"flavor_profile_id": "4c6c1d44-0a4a-4e5b-8c59-2e3e1a1c6e2f",
+				"enabled": true
+			}
+		}`)
+
+		w.WriteHeader(http.StatusCreated)
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, response)
+	})
+}
+
+// HandleFlavorGetSuccessfully sets up the test server to respond to a flavor
+// Get request.
+func HandleFlavorGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/flavors/5548c807-e6e8-43d7-9ea4-b38d34dd74a0", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+
+		fmt.Fprintf(w, SingleFlavorBody)
+	})
+}
+
+// HandleFlavorDeletionSuccessfully sets up the test server to respond to a
+// flavor deletion request.
+func HandleFlavorDeletionSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/flavors/5548c807-e6e8-43d7-9ea4-b38d34dd74a0", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleFlavorUpdateSuccessfully sets up the test server to respond to a
+// flavor Update request.
+func HandleFlavorUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/flavors/5548c807-e6e8-43d7-9ea4-b38d34dd74a0", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestJSONRequest(t, r, `{
+			"flavor": {
+				"enabled": false
+			}
+		}`)
+
+		fmt.Fprintf(w, PostUpdateFlavorBody)
+	})
+}
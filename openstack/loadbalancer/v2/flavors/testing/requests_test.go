@@ -0,0 +1,118 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/flavors"
+	fake "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/testhelper"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestListFlavors(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleFlavorListSuccessfully(t)
+
+	pages := 0
+	err := flavors.List(fake.ServiceClient(), flavors.ListOpts{}).EachPage(func(page pagination.Page) (bool, error) {
+		pages++
+
+		actual, err := flavors.ExtractFlavors(page)
+		if err != nil {
+			return false, err
+		}
+
+		if len(actual) != 2 {
+			t.Fatalf("Expected 2 flavors, got %d", len(actual))
+		}
+		th.CheckDeepEquals(t, FlavorGold, actual[0])
+		th.CheckDeepEquals(t, FlavorBronze, actual[1])
+
+		return true, nil
+	})
+
+	th.AssertNoErr(t, err)
+
+	if pages != 1 {
+		t.Errorf("Expected 1 page, saw %d", pages)
+	}
+}
+
+func TestListAllFlavors(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleFlavorListSuccessfully(t)
+
+	allPages, err := flavors.List(fake.ServiceClient(), flavors.ListOpts{}).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := flavors.ExtractFlavors(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []flavors.Flavor{FlavorGold, FlavorBronze}, actual)
+}
+
+func TestCreateFlavor(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleFlavorCreationSuccessfully(t, SingleFlavorBody)
+
+	enabled := true
+	actual, err := flavors.Create(fake.ServiceClient(), flavors.CreateOpts{
+		Name:            "gold",
+		Description:     "Highly available load balancers",
+		FlavorProfileID: "4c6c1d44-0a4a-4e5b-8c59-2e3e1a1c6e2f",
+		Enabled:         &enabled,
+	}).Extract()
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, FlavorGold, *actual)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	res := flavors.Create(fake.ServiceClient(), flavors.CreateOpts{})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+	res = flavors.Create(fake.ServiceClient(), flavors.CreateOpts{Name: "gold"})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestGetFlavor(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleFlavorGetSuccessfully(t)
+
+	actual, err := flavors.Get(fake.ServiceClient(), "5548c807-e6e8-43d7-9ea4-b38d34dd74a0").Extract()
+	if err != nil {
+		t.Fatalf("Unexpected Get error: %v", err)
+	}
+
+	th.CheckDeepEquals(t, FlavorGold, *actual)
+}
+
+func TestDeleteFlavor(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleFlavorDeletionSuccessfully(t)
+
+	res := flavors.Delete(fake.ServiceClient(), "5548c807-e6e8-43d7-9ea4-b38d34dd74a0")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestUpdateFlavor(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleFlavorUpdateSuccessfully(t)
+
+	enabled := false
+	actual, err := flavors.Update(fake.ServiceClient(), "5548c807-e6e8-43d7-9ea4-b38d34dd74a0", flavors.UpdateOpts{
+		Enabled: &enabled,
+	}).Extract()
+	if err != nil {
+		t.Fatalf("Unexpected Update error: %v", err)
+	}
+
+	th.CheckDeepEquals(t, FlavorUpdated, *actual)
+}
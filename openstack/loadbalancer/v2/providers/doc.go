@@ -17,5 +17,33 @@ Example to List Providers
 	for _, p := range allProviders {
 		fmt.Printf("%+v\n", p)
 	}
+
+Example to List the Flavor Capabilities of a Provider
+
+	allPages, err := providers.ListFlavorCapabilities(lbClient, "amphora", nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allCapabilities, err := providers.ExtractFlavorCapabilities(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, c := range allCapabilities {
+		fmt.Printf("%s: %s\n", c.Name, c.Description)
+	}
+
+Example to List the Availability Zone Capabilities of a Provider
+
+	allPages, err := providers.ListAvailabilityZoneCapabilities(lbClient, "amphora", nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allCapabilities, err := providers.ExtractAvailabilityZoneCapabilities(allPages)
+	if err != nil {
+		panic(err)
+	}
 */
 package providers
@@ -42,3 +42,56 @@ func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
 		return ProviderPage{pagination.LinkedPageBase{PageResult: r}}
 	})
 }
+
+// ListCapabilitiesOptsBuilder allows extensions to add additional parameters
+// to the ListFlavorCapabilities and ListAvailabilityZoneCapabilities requests.
+type ListCapabilitiesOptsBuilder interface {
+	ToCapabilityListQuery() (string, error)
+}
+
+// ListCapabilitiesOpts allows the filtering of the capabilities of a provider.
+type ListCapabilitiesOpts struct {
+	Name   string   `q:"name"`
+	Fields []string `q:"fields"`
+}
+
+// ToCapabilityListQuery formats a ListCapabilitiesOpts into a query string.
+func (opts ListCapabilitiesOpts) ToCapabilityListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// ListFlavorCapabilities returns a Pager which allows you to iterate over the
+// flavor capabilities of a provider, the settings a flavor profile of the
+// provider can hold. Listing capabilities requires an admin role.
+func ListFlavorCapabilities(c *gophercloud.ServiceClient, providerName string, opts ListCapabilitiesOptsBuilder) pagination.Pager {
+	url := flavorCapabilitiesURL(c, providerName)
+	if opts != nil {
+		query, err := opts.ToCapabilityListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return FlavorCapabilityPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// ListAvailabilityZoneCapabilities returns a Pager which allows you to iterate
+// over the availability zone capabilities of a provider, the settings an
+// availability zone profile of the provider can hold. Listing capabilities
+// requires an admin role.
+func ListAvailabilityZoneCapabilities(c *gophercloud.ServiceClient, providerName string, opts ListCapabilitiesOptsBuilder) pagination.Pager {
+	url := availabilityZoneCapabilitiesURL(c, providerName)
+	if opts != nil {
+		query, err := opts.ToCapabilityListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return AvailabilityZoneCapabilityPage{pagination.SinglePageBase(r)}
+	})
+}
@@ -73,3 +73,67 @@ func (r commonResult) Extract() (*Provider, error) {
 type GetResult struct {
 	commonResult
 }
+
+// Capability is a setting a flavor profile or availability zone profile of a
+// provider can hold.
+type Capability struct {
+	// Name of the capability, used as key in the profile data.
+	Name string `json:"name"`
+
+	// Human-readable description for the capability.
+	Description string `json:"description"`
+}
+
+// FlavorCapabilityPage is the page returned by a pager when traversing over
+// the flavor capabilities of a provider.
+type FlavorCapabilityPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty checks whether a FlavorCapabilityPage struct is empty.
+func (r FlavorCapabilityPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractFlavorCapabilities(r)
+	return len(is) == 0, err
+}
+
+// ExtractFlavorCapabilities accepts a Page struct, specifically a
+// FlavorCapabilityPage struct, and extracts the elements into a slice of
+// Capability structs.
+func ExtractFlavorCapabilities(r pagination.Page) ([]Capability, error) {
+	var s struct {
+		Capabilities []Capability `json:"flavor_capabilities"`
+	}
+	err := (r.(FlavorCapabilityPage)).ExtractInto(&s)
+	return s.Capabilities, err
+}
+
+// AvailabilityZoneCapabilityPage is the page returned by a pager when
+// traversing over the availability zone capabilities of a provider.
+type AvailabilityZoneCapabilityPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty checks whether an AvailabilityZoneCapabilityPage struct is empty.
+func (r AvailabilityZoneCapabilityPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractAvailabilityZoneCapabilities(r)
+	return len(is) == 0, err
+}
+
+// ExtractAvailabilityZoneCapabilities accepts a Page struct, specifically an
+// AvailabilityZoneCapabilityPage struct, and extracts the elements into a
+// slice of Capability structs.
+func ExtractAvailabilityZoneCapabilities(r pagination.Page) ([]Capability, error) {
+	var s struct {
+		Capabilities []Capability `json:"availability_zone_capabilities"`
+	}
+	err := (r.(AvailabilityZoneCapabilityPage)).ExtractInto(&s)
+	return s.Capabilities, err
+}
@@ -54,3 +54,73 @@ func HandleProviderListSuccessfully(t *testing.T) {
 		}
 	})
 }
+
+// FlavorCapabilitiesListBody contains the canned body of a flavor capability
+// list response.
+const FlavorCapabilitiesListBody = `
+{
+	"flavor_capabilities": [
+		{
+			"name": "loadbalancer_topology",
+			"description": "The load balancer topology. One of: SINGLE - One amphora per load balancer. ACTIVE_STANDBY - Two amphora per load balancer."
+		},
+		{
+			"name": "compute_flavor",
+			"description": "The compute driver flavor ID."
+		}
+	]
+}
+`
+
+// AvailabilityZoneCapabilitiesListBody contains the canned body of an
+// availability zone capability list response.
+const AvailabilityZoneCapabilitiesListBody = `
+{
+	"availability_zone_capabilities": [
+		{
+			"name": "compute_zone",
+			"description": "The compute availability zone."
+		}
+	]
+}
+`
+
+var (
+	CapabilityTopology = providers.Capability{
+		Name:        "loadbalancer_topology",
+		Description: "The load balancer topology. One of: SINGLE - One amphora per load balancer. ACTIVE_STANDBY - Two amphora per load balancer.",
+	}
+	CapabilityComputeFlavor = providers.Capability{
+		Name:        "compute_flavor",
+		Description: "The compute driver flavor ID.",
+	}
+	CapabilityComputeZone = providers.Capability{
+		Name:        "compute_zone",
+		Description: "The compute availability zone.",
+	}
+)
+
+// HandleFlavorCapabilitiesListSuccessfully sets up the test server to respond
+// to a flavor capability List request.
+func HandleFlavorCapabilitiesListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/providers/amphora/flavor_capabilities", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, FlavorCapabilitiesListBody)
+	})
+}
+
+// HandleAvailabilityZoneCapabilitiesListSuccessfully sets up the test server
+// to respond to an availability zone capability List request.
+func HandleAvailabilityZoneCapabilitiesListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/providers/amphora/availability_zone_capabilities", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"name": "compute_zone"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, AvailabilityZoneCapabilitiesListBody)
+	})
+}
@@ -51,3 +51,30 @@ func TestListAllProviders(t *testing.T) {
 	th.CheckDeepEquals(t, ProviderAmphora, actual[0])
 	th.CheckDeepEquals(t, ProviderOVN, actual[1])
 }
+
+func TestListFlavorCapabilities(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleFlavorCapabilitiesListSuccessfully(t)
+
+	allPages, err := providers.ListFlavorCapabilities(fake.ServiceClient(), "amphora", nil).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := providers.ExtractFlavorCapabilities(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []providers.Capability{CapabilityTopology, CapabilityComputeFlavor}, actual)
+}
+
+func TestListAvailabilityZoneCapabilities(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAvailabilityZoneCapabilitiesListSuccessfully(t)
+
+	listOpts := providers.ListCapabilitiesOpts{
+		Name: "compute_zone",
+	}
+	allPages, err := providers.ListAvailabilityZoneCapabilities(fake.ServiceClient(), "amphora", listOpts).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := providers.ExtractAvailabilityZoneCapabilities(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []providers.Capability{CapabilityComputeZone}, actual)
+}
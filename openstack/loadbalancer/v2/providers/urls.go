@@ -10,3 +10,11 @@ const (
 func rootURL(c *gophercloud.ServiceClient) string {
 	return c.ServiceURL(rootPath, resourcePath)
 }
+
+func flavorCapabilitiesURL(c *gophercloud.ServiceClient, providerName string) string {
+	return c.ServiceURL(rootPath, resourcePath, providerName, "flavor_capabilities")
+}
+
+func availabilityZoneCapabilitiesURL(c *gophercloud.ServiceClient, providerName string) string {
+	return c.ServiceURL(rootPath, resourcePath, providerName, "availability_zone_capabilities")
+}
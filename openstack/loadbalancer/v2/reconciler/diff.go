@@ -0,0 +1,895 @@
+package reconciler
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/l7policies"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/monitors"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/pools"
+)
+
+// differ computes the operations of a plan. The operations are grouped in
+// phases so that a resource is never deleted while it is still referenced,
+// and never referenced before it exists:
+//
+//  1. the L7 policies of the kept listeners which are not in the spec,
+//  2. the listeners which are not in the spec or are recreated, along with
+//     their L7 policies,
+//  3. the pools, with their health monitor and members,
+//  4. the listeners,
+//  5. the L7 policies and rules of the kept listeners,
+//  6. the pools which are not in the spec or are recreated, along with their
+//     health monitor and members.
+type differ struct {
+	loadBalancerID string
+	spec           Spec
+	tree           *tree
+	state          *state
+
+	// newPools holds the names of the pools which get a new ID.
+	newPools map[string]bool
+
+	deletePolicies  []Operation
+	deleteListeners []Operation
+	pools           []Operation
+	listeners       []Operation
+	policies        []Operation
+	deletePools     []Operation
+}
+
+func newDiffer(loadBalancerID string, spec Spec, t *tree) *differ {
+	return &differ{
+		loadBalancerID: loadBalancerID,
+		spec:           spec,
+		tree:           t,
+		state:          newState(),
+		newPools:       make(map[string]bool),
+	}
+}
+
+func (d *differ) diff() (*Plan, error) {
+	if err := d.diffPools(); err != nil {
+		return nil, err
+	}
+	if err := d.diffListeners(); err != nil {
+		return nil, err
+	}
+
+	var ops []Operation
+	for _, phase := range [][]Operation{d.deletePolicies, d.deleteListeners, d.pools, d.listeners, d.policies, d.deletePools} {
+		ops = append(ops, phase...)
+	}
+
+	return &Plan{
+		LoadBalancerID: d.loadBalancerID,
+		Operations:     ops,
+		state:          d.state,
+	}, nil
+}
+
+func (d *differ) diffPools() error {
+	matched := make(map[string]bool)
+	for _, sp := range d.spec.Pools {
+		sp := sp
+
+		cur, err := d.findPool(sp.Name)
+		if err != nil {
+			return err
+		}
+
+		if cur != nil {
+			matched[cur.ID] = true
+			if cur.Protocol != string(sp.Protocol) {
+				d.deletePools = append(d.deletePools, deletePoolOp(cur, []string{"protocol"}))
+				cur = nil
+			}
+		}
+
+		if cur == nil {
+			d.newPools[sp.Name] = true
+			d.pools = append(d.pools, d.createPoolOp(sp))
+			continue
+		}
+
+		d.state.pools[sp.Name] = cur.ID
+
+		if changes, opts := poolUpdate(cur, sp); len(changes) > 0 {
+			d.pools = append(d.pools, Operation{
+				Action:   ActionUpdate,
+				Resource: ResourcePool,
+				Name:     sp.Name,
+				ID:       cur.ID,
+				Changes:  changes,
+				apply: func(c *gophercloud.ServiceClient, s *state) error {
+					return pools.Update(c, cur.ID, opts).Err
+				},
+			})
+		}
+
+		d.diffMonitor(sp.Name, cur.monitor, sp.Monitor)
+
+		if changes := memberChanges(cur.members, sp.Members); len(changes) > 0 {
+			members := batchMemberOpts(sp.Members)
+			d.pools = append(d.pools, Operation{
+				Action:   ActionUpdate,
+				Resource: ResourceMembers,
+				Name:     sp.Name,
+				ID:       cur.ID,
+				Changes:  changes,
+				apply: func(c *gophercloud.ServiceClient, s *state) error {
+					return pools.BatchUpdateMembers(c, cur.ID, members).Err
+				},
+			})
+		}
+	}
+
+	for _, cur := range d.tree.pools {
+		if !matched[cur.ID] {
+			d.deletePools = append(d.deletePools, deletePoolOp(cur, nil))
+		}
+	}
+
+	return nil
+}
+
+func (d *differ) findPool(name string) (*currentPool, error) {
+	var found *currentPool
+	for _, p := range d.tree.pools {
+		if p.Name != name {
+			continue
+		}
+		if found != nil {
+			return nil, ErrAmbiguousName{Resource: ResourcePool, Name: name}
+		}
+		found = p
+	}
+	return found, nil
+}
+
+// poolName returns the name under which an existing pool is known, or an
+// empty string if there is no such pool.
+func (d *differ) poolName(id string) string {
+	if id == "" {
+		return ""
+	}
+	for _, p := range d.tree.pools {
+		if p.ID == id {
+			return p.Name
+		}
+	}
+	return ""
+}
+
+// poolRefChanged reports whether a reference to the pool named want must be
+// updated, given the ID of the pool currently referenced.
+func (d *differ) poolRefChanged(id, want string) bool {
+	if want == "" {
+		return id != ""
+	}
+	return d.newPools[want] || d.poolName(id) != want
+}
+
+func (d *differ) createPoolOp(sp Pool) Operation {
+	opts := pools.CreateOpts{
+		LoadbalancerID: d.loadBalancerID,
+		Name:           sp.Name,
+		Description:    sp.Description,
+		Protocol:       sp.Protocol,
+		LBMethod:       sp.LBMethod,
+		Persistence:    sp.Persistence,
+		AdminStateUp:   sp.AdminStateUp,
+		Tags:           sp.Tags,
+	}
+
+	for _, m := range sp.Members {
+		opts.Members = append(opts.Members, pools.CreateMemberOpts{
+			Address:        m.Address,
+			ProtocolPort:   m.ProtocolPort,
+			Name:           m.Name,
+			Weight:         m.Weight,
+			SubnetID:       m.SubnetID,
+			AdminStateUp:   m.AdminStateUp,
+			Backup:         m.Backup,
+			MonitorAddress: m.MonitorAddress,
+			MonitorPort:    m.MonitorPort,
+			Tags:           m.Tags,
+		})
+	}
+
+	if sp.Monitor != nil {
+		monitor := monitorCreateOpts(*sp.Monitor)
+		opts.Monitor = &monitor
+	}
+
+	return Operation{
+		Action:   ActionCreate,
+		Resource: ResourcePool,
+		Name:     sp.Name,
+		apply: func(c *gophercloud.ServiceClient, s *state) error {
+			p, err := pools.Create(c, opts).Extract()
+			if err != nil {
+				return err
+			}
+			s.pools[sp.Name] = p.ID
+			return nil
+		},
+	}
+}
+
+func deletePoolOp(cur *currentPool, changes []string) Operation {
+	return Operation{
+		Action:   ActionDelete,
+		Resource: ResourcePool,
+		Name:     displayName(cur.Name, cur.ID),
+		ID:       cur.ID,
+		Changes:  changes,
+		apply: func(c *gophercloud.ServiceClient, s *state) error {
+			return pools.Delete(c, cur.ID).ExtractErr()
+		},
+	}
+}
+
+func poolUpdate(cur *currentPool, sp Pool) ([]string, pools.UpdateOpts) {
+	var changes []string
+	var opts pools.UpdateOpts
+
+	if cur.Description != sp.Description {
+		changes = append(changes, "description")
+		opts.Description = &sp.Description
+	}
+	if cur.LBMethod != string(sp.LBMethod) {
+		changes = append(changes, "lb_algorithm")
+		opts.LBMethod = sp.LBMethod
+	}
+	if sp.Persistence != nil && *sp.Persistence != cur.Persistence {
+		changes = append(changes, "session_persistence")
+		opts.Persistence = sp.Persistence
+	}
+	if sp.AdminStateUp != nil && *sp.AdminStateUp != cur.AdminStateUp {
+		changes = append(changes, "admin_state_up")
+		opts.AdminStateUp = sp.AdminStateUp
+	}
+	if sp.Tags != nil && !sameSet(sp.Tags, cur.Tags) {
+		changes = append(changes, "tags")
+		opts.Tags = &sp.Tags
+	}
+
+	return changes, opts
+}
+
+func (d *differ) diffMonitor(poolName string, cur *monitors.Monitor, want *Monitor) {
+	if cur != nil && (want == nil || cur.Type != want.Type) {
+		var changes []string
+		if want != nil {
+			changes = []string{"type"}
+		}
+		id := cur.ID
+		d.pools = append(d.pools, Operation{
+			Action:   ActionDelete,
+			Resource: ResourceMonitor,
+			Name:     poolName,
+			ID:       id,
+			Changes:  changes,
+			apply: func(c *gophercloud.ServiceClient, s *state) error {
+				return monitors.Delete(c, id).ExtractErr()
+			},
+		})
+		cur = nil
+	}
+
+	if want == nil {
+		return
+	}
+
+	if cur == nil {
+		opts := monitorCreateOpts(*want)
+		d.pools = append(d.pools, Operation{
+			Action:   ActionCreate,
+			Resource: ResourceMonitor,
+			Name:     poolName,
+			apply: func(c *gophercloud.ServiceClient, s *state) error {
+				o := opts
+				o.PoolID = s.pools[poolName]
+				return monitors.Create(c, o).Err
+			},
+		})
+		return
+	}
+
+	if changes, opts := monitorUpdate(cur, *want); len(changes) > 0 {
+		id := cur.ID
+		d.pools = append(d.pools, Operation{
+			Action:   ActionUpdate,
+			Resource: ResourceMonitor,
+			Name:     poolName,
+			ID:       id,
+			Changes:  changes,
+			apply: func(c *gophercloud.ServiceClient, s *state) error {
+				return monitors.Update(c, id, opts).Err
+			},
+		})
+	}
+}
+
+func monitorCreateOpts(m Monitor) monitors.CreateOpts {
+	return monitors.CreateOpts{
+		Name:           m.Name,
+		Type:           m.Type,
+		Delay:          m.Delay,
+		Timeout:        m.Timeout,
+		MaxRetries:     m.MaxRetries,
+		MaxRetriesDown: m.MaxRetriesDown,
+		HTTPMethod:     m.HTTPMethod,
+		URLPath:        m.URLPath,
+		ExpectedCodes:  m.ExpectedCodes,
+		AdminStateUp:   m.AdminStateUp,
+	}
+}
+
+func monitorUpdate(cur *monitors.Monitor, want Monitor) ([]string, monitors.UpdateOpts) {
+	var changes []string
+	var opts monitors.UpdateOpts
+
+	if cur.Name != want.Name {
+		changes = append(changes, "name")
+		opts.Name = &want.Name
+	}
+	if cur.Delay != want.Delay {
+		changes = append(changes, "delay")
+		opts.Delay = want.Delay
+	}
+	if cur.Timeout != want.Timeout {
+		changes = append(changes, "timeout")
+		opts.Timeout = want.Timeout
+	}
+	if cur.MaxRetries != want.MaxRetries {
+		changes = append(changes, "max_retries")
+		opts.MaxRetries = want.MaxRetries
+	}
+	if want.MaxRetriesDown != 0 && cur.MaxRetriesDown != want.MaxRetriesDown {
+		changes = append(changes, "max_retries_down")
+		opts.MaxRetriesDown = want.MaxRetriesDown
+	}
+	if want.HTTPMethod != "" && cur.HTTPMethod != want.HTTPMethod {
+		changes = append(changes, "http_method")
+		opts.HTTPMethod = want.HTTPMethod
+	}
+	if want.URLPath != "" && cur.URLPath != want.URLPath {
+		changes = append(changes, "url_path")
+		opts.URLPath = want.URLPath
+	}
+	if want.ExpectedCodes != "" && cur.ExpectedCodes != want.ExpectedCodes {
+		changes = append(changes, "expected_codes")
+		opts.ExpectedCodes = want.ExpectedCodes
+	}
+	if want.AdminStateUp != nil && cur.AdminStateUp != *want.AdminStateUp {
+		changes = append(changes, "admin_state_up")
+		opts.AdminStateUp = want.AdminStateUp
+	}
+
+	return changes, opts
+}
+
+func memberKey(address string, port int) string {
+	return address + ":" + strconv.Itoa(port)
+}
+
+// memberChanges lists the members to add, update and remove to go from the
+// current members of a pool to the wanted ones.
+func memberChanges(cur []pools.Member, want []Member) []string {
+	byKey := make(map[string]pools.Member, len(cur))
+	for _, m := range cur {
+		byKey[memberKey(m.Address, m.ProtocolPort)] = m
+	}
+
+	var changes []string
+	for _, w := range want {
+		key := memberKey(w.Address, w.ProtocolPort)
+		c, ok := byKey[key]
+		switch {
+		case !ok:
+			changes = append(changes, "+"+key)
+		case memberDiffers(c, w):
+			changes = append(changes, "~"+key)
+		}
+		delete(byKey, key)
+	}
+
+	for _, m := range cur {
+		key := memberKey(m.Address, m.ProtocolPort)
+		if _, ok := byKey[key]; ok {
+			changes = append(changes, "-"+key)
+		}
+	}
+
+	return changes
+}
+
+func memberDiffers(cur pools.Member, want Member) bool {
+	return cur.Name != want.Name ||
+		want.Weight != nil && *want.Weight != cur.Weight ||
+		want.SubnetID != "" && want.SubnetID != cur.SubnetID ||
+		want.AdminStateUp != nil && *want.AdminStateUp != cur.AdminStateUp ||
+		want.Backup != nil && *want.Backup != cur.Backup ||
+		want.MonitorAddress != "" && want.MonitorAddress != cur.MonitorAddress ||
+		want.MonitorPort != nil && *want.MonitorPort != cur.MonitorPort ||
+		want.Tags != nil && !sameSet(want.Tags, cur.Tags)
+}
+
+func batchMemberOpts(want []Member) []pools.BatchUpdateMemberOpts {
+	opts := make([]pools.BatchUpdateMemberOpts, len(want))
+	for i, m := range want {
+		m := m
+		opts[i] = pools.BatchUpdateMemberOpts{
+			Address:      m.Address,
+			ProtocolPort: m.ProtocolPort,
+			Name:         &m.Name,
+			Weight:       m.Weight,
+			AdminStateUp: m.AdminStateUp,
+			Backup:       m.Backup,
+			MonitorPort:  m.MonitorPort,
+			Tags:         m.Tags,
+		}
+		if m.SubnetID != "" {
+			opts[i].SubnetID = &m.SubnetID
+		}
+		if m.MonitorAddress != "" {
+			opts[i].MonitorAddress = &m.MonitorAddress
+		}
+	}
+	return opts
+}
+
+func (d *differ) diffListeners() error {
+	matched := make(map[string]bool)
+	for _, sl := range d.spec.Listeners {
+		sl := sl
+
+		cur, err := d.findListener(sl.Name)
+		if err != nil {
+			return err
+		}
+
+		if cur != nil {
+			matched[cur.ID] = true
+
+			var changes []string
+			if cur.Protocol != string(sl.Protocol) {
+				changes = append(changes, "protocol")
+			}
+			if cur.ProtocolPort != sl.ProtocolPort {
+				changes = append(changes, "protocol_port")
+			}
+			if len(changes) > 0 {
+				d.deleteListeners = append(d.deleteListeners, deleteListenerOp(cur, changes))
+				cur = nil
+			}
+		}
+
+		if cur == nil {
+			d.listeners = append(d.listeners, d.createListenerOp(sl))
+			continue
+		}
+
+		d.state.listeners[sl.Name] = cur.ID
+
+		changes, opts := listenerUpdate(cur, sl)
+		setDefaultPool := d.poolRefChanged(cur.DefaultPoolID, sl.DefaultPool)
+		if setDefaultPool {
+			changes = append([]string{"default_pool_id"}, changes...)
+		}
+		if len(changes) > 0 {
+			id := cur.ID
+			d.listeners = append(d.listeners, Operation{
+				Action:   ActionUpdate,
+				Resource: ResourceListener,
+				Name:     sl.Name,
+				ID:       id,
+				Changes:  changes,
+				apply: func(c *gophercloud.ServiceClient, s *state) error {
+					o := opts
+					if setDefaultPool {
+						poolID := s.pools[sl.DefaultPool]
+						o.DefaultPoolID = &poolID
+					}
+					return listeners.Update(c, id, o).Err
+				},
+			})
+		}
+
+		if err := d.diffPolicies(cur, sl); err != nil {
+			return err
+		}
+	}
+
+	for _, cur := range d.tree.listeners {
+		if !matched[cur.ID] {
+			d.deleteListeners = append(d.deleteListeners, deleteListenerOp(cur, nil))
+		}
+	}
+
+	return nil
+}
+
+func (d *differ) findListener(name string) (*currentListener, error) {
+	var found *currentListener
+	for _, l := range d.tree.listeners {
+		if l.Name != name {
+			continue
+		}
+		if found != nil {
+			return nil, ErrAmbiguousName{Resource: ResourceListener, Name: name}
+		}
+		found = l
+	}
+	return found, nil
+}
+
+func (d *differ) createListenerOp(sl Listener) Operation {
+	opts := listeners.CreateOpts{
+		LoadbalancerID:         d.loadBalancerID,
+		Name:                   sl.Name,
+		Description:            sl.Description,
+		Protocol:               sl.Protocol,
+		ProtocolPort:           sl.ProtocolPort,
+		ConnLimit:              sl.ConnLimit,
+		DefaultTlsContainerRef: sl.DefaultTlsContainerRef,
+		SniContainerRefs:       sl.SniContainerRefs,
+		AdminStateUp:           sl.AdminStateUp,
+		TimeoutClientData:      sl.TimeoutClientData,
+		TimeoutMemberData:      sl.TimeoutMemberData,
+		TimeoutMemberConnect:   sl.TimeoutMemberConnect,
+		TimeoutTCPInspect:      sl.TimeoutTCPInspect,
+		InsertHeaders:          sl.InsertHeaders,
+		AllowedCIDRs:           sl.AllowedCIDRs,
+		Tags:                   sl.Tags,
+	}
+
+	return Operation{
+		Action:   ActionCreate,
+		Resource: ResourceListener,
+		Name:     sl.Name,
+		apply: func(c *gophercloud.ServiceClient, s *state) error {
+			o := opts
+			o.DefaultPoolID = s.pools[sl.DefaultPool]
+			o.L7Policies = nil
+			for _, p := range sl.L7Policies {
+				o.L7Policies = append(o.L7Policies, policyCreateOpts(p, s))
+			}
+
+			l, err := listeners.Create(c, o).Extract()
+			if err != nil {
+				return err
+			}
+			s.listeners[sl.Name] = l.ID
+			return nil
+		},
+	}
+}
+
+func deleteListenerOp(cur *currentListener, changes []string) Operation {
+	return Operation{
+		Action:   ActionDelete,
+		Resource: ResourceListener,
+		Name:     displayName(cur.Name, cur.ID),
+		ID:       cur.ID,
+		Changes:  changes,
+		apply: func(c *gophercloud.ServiceClient, s *state) error {
+			return listeners.Delete(c, cur.ID).ExtractErr()
+		},
+	}
+}
+
+func listenerUpdate(cur *currentListener, sl Listener) ([]string, listeners.UpdateOpts) {
+	var changes []string
+	var opts listeners.UpdateOpts
+
+	if cur.Description != sl.Description {
+		changes = append(changes, "description")
+		opts.Description = &sl.Description
+	}
+	if sl.ConnLimit != nil && *sl.ConnLimit != cur.ConnLimit {
+		changes = append(changes, "connection_limit")
+		opts.ConnLimit = sl.ConnLimit
+	}
+	if sl.DefaultTlsContainerRef != "" && sl.DefaultTlsContainerRef != cur.DefaultTlsContainerRef {
+		changes = append(changes, "default_tls_container_ref")
+		opts.DefaultTlsContainerRef = &sl.DefaultTlsContainerRef
+	}
+	if sl.SniContainerRefs != nil && !sameSet(sl.SniContainerRefs, cur.SniContainerRefs) {
+		changes = append(changes, "sni_container_refs")
+		opts.SniContainerRefs = &sl.SniContainerRefs
+	}
+	if sl.AdminStateUp != nil && *sl.AdminStateUp != cur.AdminStateUp {
+		changes = append(changes, "admin_state_up")
+		opts.AdminStateUp = sl.AdminStateUp
+	}
+	if sl.TimeoutClientData != nil && *sl.TimeoutClientData != cur.TimeoutClientData {
+		changes = append(changes, "timeout_client_data")
+		opts.TimeoutClientData = sl.TimeoutClientData
+	}
+	if sl.TimeoutMemberData != nil && *sl.TimeoutMemberData != cur.TimeoutMemberData {
+		changes = append(changes, "timeout_member_data")
+		opts.TimeoutMemberData = sl.TimeoutMemberData
+	}
+	if sl.TimeoutMemberConnect != nil && *sl.TimeoutMemberConnect != cur.TimeoutMemberConnect {
+		changes = append(changes, "timeout_member_connect")
+		opts.TimeoutMemberConnect = sl.TimeoutMemberConnect
+	}
+	if sl.TimeoutTCPInspect != nil && *sl.TimeoutTCPInspect != cur.TimeoutTCPInspect {
+		changes = append(changes, "timeout_tcp_inspect")
+		opts.TimeoutTCPInspect = sl.TimeoutTCPInspect
+	}
+	if sl.InsertHeaders != nil && !sameMap(sl.InsertHeaders, cur.InsertHeaders) {
+		changes = append(changes, "insert_headers")
+		opts.InsertHeaders = &sl.InsertHeaders
+	}
+	if sl.AllowedCIDRs != nil && !sameSet(sl.AllowedCIDRs, cur.AllowedCIDRs) {
+		changes = append(changes, "allowed_cidrs")
+		opts.AllowedCIDRs = &sl.AllowedCIDRs
+	}
+	if sl.Tags != nil && !sameSet(sl.Tags, cur.Tags) {
+		changes = append(changes, "tags")
+		opts.Tags = &sl.Tags
+	}
+
+	return changes, opts
+}
+
+func (d *differ) diffPolicies(cl *currentListener, sl Listener) error {
+	matched := make(map[string]bool)
+	for _, sp := range sl.L7Policies {
+		sp := sp
+		key := sl.Name + "/" + sp.Name
+
+		cur, err := findPolicy(cl.policies, key, sp.Name)
+		if err != nil {
+			return err
+		}
+
+		if cur == nil {
+			d.policies = append(d.policies, Operation{
+				Action:   ActionCreate,
+				Resource: ResourceL7Policy,
+				Name:     key,
+				apply: func(c *gophercloud.ServiceClient, s *state) error {
+					opts := policyCreateOpts(sp, s)
+					opts.ListenerID = s.listeners[sl.Name]
+					p, err := l7policies.Create(c, opts).Extract()
+					if err != nil {
+						return err
+					}
+					s.policies[key] = p.ID
+					return nil
+				},
+			})
+			continue
+		}
+
+		matched[cur.ID] = true
+		d.state.policies[key] = cur.ID
+		d.diffPolicy(key, cur, sp)
+	}
+
+	for _, cur := range cl.policies {
+		if matched[cur.ID] {
+			continue
+		}
+		id := cur.ID
+		d.deletePolicies = append(d.deletePolicies, Operation{
+			Action:   ActionDelete,
+			Resource: ResourceL7Policy,
+			Name:     sl.Name + "/" + displayName(cur.Name, cur.ID),
+			ID:       id,
+			apply: func(c *gophercloud.ServiceClient, s *state) error {
+				return l7policies.Delete(c, id).ExtractErr()
+			},
+		})
+	}
+
+	return nil
+}
+
+func findPolicy(policies []l7policies.L7Policy, key, name string) (*l7policies.L7Policy, error) {
+	var found *l7policies.L7Policy
+	for i := range policies {
+		if policies[i].Name != name {
+			continue
+		}
+		if found != nil {
+			return nil, ErrAmbiguousName{Resource: ResourceL7Policy, Name: key}
+		}
+		found = &policies[i]
+	}
+	return found, nil
+}
+
+func policyCreateOpts(p L7Policy, s *state) l7policies.CreateOpts {
+	opts := l7policies.CreateOpts{
+		Name:             p.Name,
+		Description:      p.Description,
+		Action:           p.Action,
+		Position:         p.Position,
+		RedirectPoolID:   s.pools[p.RedirectPool],
+		RedirectURL:      p.RedirectURL,
+		RedirectPrefix:   p.RedirectPrefix,
+		RedirectHttpCode: p.RedirectHttpCode,
+		AdminStateUp:     p.AdminStateUp,
+		Tags:             p.Tags,
+	}
+	for _, r := range p.Rules {
+		opts.Rules = append(opts.Rules, l7policies.CreateRuleOpts{
+			RuleType:    r.RuleType,
+			CompareType: r.CompareType,
+			Key:         r.Key,
+			Value:       r.Value,
+			Invert:      r.Invert,
+		})
+	}
+	return opts
+}
+
+func (d *differ) diffPolicy(key string, cur *l7policies.L7Policy, sp L7Policy) {
+	var changes []string
+	var opts l7policies.UpdateOpts
+
+	if cur.Action != string(sp.Action) {
+		changes = append(changes, "action")
+		opts.Action = sp.Action
+	}
+	if cur.Description != sp.Description {
+		changes = append(changes, "description")
+		opts.Description = &sp.Description
+	}
+	if sp.Position != 0 && sp.Position != cur.Position {
+		changes = append(changes, "position")
+		opts.Position = sp.Position
+	}
+	setRedirectPool := d.poolRefChanged(cur.RedirectPoolID, sp.RedirectPool)
+	if setRedirectPool {
+		changes = append(changes, "redirect_pool_id")
+	}
+	if cur.RedirectURL != sp.RedirectURL {
+		changes = append(changes, "redirect_url")
+		opts.RedirectURL = &sp.RedirectURL
+	}
+	if cur.RedirectPrefix != sp.RedirectPrefix {
+		changes = append(changes, "redirect_prefix")
+		opts.RedirectPrefix = &sp.RedirectPrefix
+	}
+	if sp.RedirectHttpCode != 0 && sp.RedirectHttpCode != cur.RedirectHttpCode {
+		changes = append(changes, "redirect_http_code")
+	}
+	if sp.AdminStateUp != nil && *sp.AdminStateUp != cur.AdminStateUp {
+		changes = append(changes, "admin_state_up")
+		opts.AdminStateUp = sp.AdminStateUp
+	}
+	if sp.Tags != nil && !sameSet(sp.Tags, cur.Tags) {
+		changes = append(changes, "tags")
+		opts.Tags = &sp.Tags
+	}
+
+	// An update without a redirect HTTP code clears it, so the current one is
+	// sent again unless the policy no longer redirects to a URL.
+	switch {
+	case sp.RedirectHttpCode != 0:
+		opts.RedirectHttpCode = sp.RedirectHttpCode
+	case sp.Action == l7policies.ActionRedirectToURL || sp.Action == l7policies.ActionRedirectPrefix:
+		opts.RedirectHttpCode = cur.RedirectHttpCode
+	}
+
+	if len(changes) > 0 {
+		id := cur.ID
+		d.policies = append(d.policies, Operation{
+			Action:   ActionUpdate,
+			Resource: ResourceL7Policy,
+			Name:     key,
+			ID:       id,
+			Changes:  changes,
+			apply: func(c *gophercloud.ServiceClient, s *state) error {
+				o := opts
+				if setRedirectPool {
+					poolID := s.pools[sp.RedirectPool]
+					o.RedirectPoolID = &poolID
+				}
+				return l7policies.Update(c, id, o).Err
+			},
+		})
+	}
+
+	d.diffRules(key, cur, sp.Rules)
+}
+
+func (d *differ) diffRules(key string, cur *l7policies.L7Policy, want []L7Rule) {
+	wanted := make(map[L7Rule]bool, len(want))
+	for _, r := range want {
+		wanted[r] = true
+	}
+
+	existing := make(map[L7Rule]bool, len(cur.Rules))
+	for _, r := range cur.Rules {
+		rule := L7Rule{
+			RuleType:    l7policies.RuleType(r.RuleType),
+			CompareType: l7policies.CompareType(r.CompareType),
+			Key:         r.Key,
+			Value:       r.Value,
+			Invert:      r.Invert,
+		}
+		if wanted[rule] && !existing[rule] {
+			existing[rule] = true
+			continue
+		}
+
+		policyID, ruleID := cur.ID, r.ID
+		d.policies = append(d.policies, Operation{
+			Action:   ActionDelete,
+			Resource: ResourceL7Rule,
+			Name:     key + "/" + rule.String(),
+			ID:       ruleID,
+			apply: func(c *gophercloud.ServiceClient, s *state) error {
+				return l7policies.DeleteRule(c, policyID, ruleID).ExtractErr()
+			},
+		})
+	}
+
+	for _, r := range want {
+		if existing[r] {
+			continue
+		}
+		existing[r] = true
+
+		opts := l7policies.CreateRuleOpts{
+			RuleType:    r.RuleType,
+			CompareType: r.CompareType,
+			Key:         r.Key,
+			Value:       r.Value,
+			Invert:      r.Invert,
+		}
+		d.policies = append(d.policies, Operation{
+			Action:   ActionCreate,
+			Resource: ResourceL7Rule,
+			Name:     key + "/" + r.String(),
+			apply: func(c *gophercloud.ServiceClient, s *state) error {
+				return l7policies.CreateRule(c, s.policies[key], opts).Err
+			},
+		})
+	}
+}
+
+func displayName(name, id string) string {
+	if name == "" {
+		return id
+	}
+	return name
+}
+
+func sameSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	x := append([]string(nil), a...)
+	y := append([]string(nil), b...)
+	sort.Strings(x)
+	sort.Strings(y)
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func sameMap(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if w, ok := b[k]; !ok || w != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,65 @@
+/*
+Package reconciler brings the tree of an Octavia load balancer, made of its
+listeners, L7 policies and rules, pools, members and health monitors, to a
+declared state with the minimal set of API calls.
+
+The calls are made one after the other: before each of them, the reconciler
+waits for the load balancer to leave its immutable PENDING_* states. The
+members of a pool are reconciled in a single batch update. Resources whose
+immutable attributes differ, such as the protocol of a listener, are deleted
+and created again.
+
+Example to Show the Changes Without Applying Them
+
+	spec := reconciler.Spec{
+		Listeners: []reconciler.Listener{
+			{
+				Name:         "http",
+				Protocol:     listeners.ProtocolHTTP,
+				ProtocolPort: 80,
+				DefaultPool:  "web",
+			},
+		},
+		Pools: []reconciler.Pool{
+			{
+				Name:     "web",
+				Protocol: pools.ProtocolHTTP,
+				LBMethod: pools.LBMethodRoundRobin,
+				Members: []reconciler.Member{
+					{Address: "10.0.0.11", ProtocolPort: 8080},
+					{Address: "10.0.0.12", ProtocolPort: 8080},
+				},
+				Monitor: &reconciler.Monitor{
+					Type:       monitors.TypeHTTP,
+					Delay:      5,
+					Timeout:    3,
+					MaxRetries: 3,
+					URLPath:    "/healthz",
+				},
+			},
+		},
+	}
+
+	plan, err := reconciler.Reconcile(lbClient, "36e08a3e-a78f-4b40-a229-1e7e23eee1ab", spec, reconciler.ReconcileOpts{
+		DryRun: true,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(plan)
+
+Example to Reconcile a Load Balancer
+
+	plan, err := reconciler.Reconcile(lbClient, "36e08a3e-a78f-4b40-a229-1e7e23eee1ab", spec, reconciler.ReconcileOpts{
+		Timeout: 600,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, op := range plan.Operations {
+		fmt.Println(op)
+	}
+*/
+package reconciler
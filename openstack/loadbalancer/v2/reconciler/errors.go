@@ -0,0 +1,57 @@
+package reconciler
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ErrInvalidSpec is returned by Diff when the spec is inconsistent, for
+// example when it refers to a pool it does not define.
+type ErrInvalidSpec struct {
+	gophercloud.BaseError
+	Reason string
+}
+
+func (e ErrInvalidSpec) Error() string {
+	return fmt.Sprintf("Invalid load balancer spec: %s", e.Reason)
+}
+
+// ErrAmbiguousName is returned by Diff when several existing resources have
+// the name of a resource of the spec.
+type ErrAmbiguousName struct {
+	gophercloud.BaseError
+	Resource Resource
+	Name     string
+}
+
+func (e ErrAmbiguousName) Error() string {
+	return fmt.Sprintf("Several existing %ss are named %q", e.Resource, e.Name)
+}
+
+// ErrLoadBalancerError is returned by Apply when the load balancer goes into
+// the ERROR provisioning status.
+type ErrLoadBalancerError struct {
+	gophercloud.BaseError
+	LoadBalancerID string
+}
+
+func (e ErrLoadBalancerError) Error() string {
+	return fmt.Sprintf("Load balancer %s is in ERROR status", e.LoadBalancerID)
+}
+
+// ErrOperationFailed is returned by Apply when an operation of the plan fails.
+// The operations before it have been applied.
+type ErrOperationFailed struct {
+	gophercloud.BaseError
+	Operation Operation
+	Err       error
+}
+
+func (e ErrOperationFailed) Error() string {
+	return fmt.Sprintf("Failed to %s %s %s: %s", e.Operation.Action, e.Operation.Resource, e.Operation.Name, e.Err)
+}
+
+func (e ErrOperationFailed) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,424 @@
+package reconciler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/l7policies"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/monitors"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/pools"
+)
+
+// Spec is the desired tree of a load balancer: its listeners with their L7
+// policies, and its pools with their members and health monitor.
+//
+// Listeners, pools and L7 policies are matched to the existing resources by
+// name, and members by address and protocol port. Existing resources which
+// do not match the spec are deleted. Unless stated otherwise, zero values and
+// nil pointers, slices and maps leave the corresponding attribute as it is.
+type Spec struct {
+	Listeners []Listener
+	Pools     []Pool
+}
+
+// Listener is the desired state of a listener.
+type Listener struct {
+	// Name identifies the listener. It is required.
+	Name string
+
+	// Protocol and ProtocolPort are immutable: the listener is recreated
+	// when they change.
+	Protocol     listeners.Protocol
+	ProtocolPort int
+
+	// DefaultPool is the name of a pool of the spec. It is always reconciled:
+	// an empty value removes the default pool of the listener.
+	DefaultPool string
+
+	// Description is always reconciled.
+	Description string
+
+	ConnLimit              *int
+	DefaultTlsContainerRef string
+	SniContainerRefs       []string
+	AdminStateUp           *bool
+	TimeoutClientData      *int
+	TimeoutMemberData      *int
+	TimeoutMemberConnect   *int
+	TimeoutTCPInspect      *int
+	InsertHeaders          map[string]string
+	AllowedCIDRs           []string
+	Tags                   []string
+
+	// L7Policies are the L7 policies of the listener. They are always
+	// reconciled.
+	L7Policies []L7Policy
+}
+
+// Pool is the desired state of a pool.
+type Pool struct {
+	// Name identifies the pool. It is required.
+	Name string
+
+	// Protocol is immutable: the pool is recreated when it changes.
+	Protocol pools.Protocol
+
+	// LBMethod and Description are always reconciled.
+	LBMethod    pools.LBMethod
+	Description string
+
+	Persistence  *pools.SessionPersistence
+	AdminStateUp *bool
+	Tags         []string
+
+	// Members are the members of the pool. They are always reconciled, in a
+	// single batch update.
+	Members []Member
+
+	// Monitor is the health monitor of the pool. It is always reconciled: a
+	// nil value removes the health monitor of the pool.
+	Monitor *Monitor
+}
+
+// Member is the desired state of a pool member.
+type Member struct {
+	// Address and ProtocolPort identify the member. They are required.
+	Address      string
+	ProtocolPort int
+
+	// Name is always reconciled.
+	Name string
+
+	Weight         *int
+	SubnetID       string
+	AdminStateUp   *bool
+	Backup         *bool
+	MonitorAddress string
+	MonitorPort    *int
+	Tags           []string
+}
+
+// Monitor is the desired state of a health monitor.
+type Monitor struct {
+	// Type is immutable: the health monitor is recreated when it changes.
+	Type string
+
+	// Name, Delay, Timeout and MaxRetries are always reconciled.
+	Name       string
+	Delay      int
+	Timeout    int
+	MaxRetries int
+
+	MaxRetriesDown int
+	HTTPMethod     string
+	URLPath        string
+	ExpectedCodes  string
+	AdminStateUp   *bool
+}
+
+// L7Policy is the desired state of an L7 policy.
+type L7Policy struct {
+	// Name identifies the policy within its listener. It is required.
+	Name string
+
+	// Action, Description and the redirect targets are always reconciled.
+	Action         l7policies.Action
+	Description    string
+	RedirectPool   string
+	RedirectURL    string
+	RedirectPrefix string
+
+	Position         int32
+	RedirectHttpCode int32
+	AdminStateUp     *bool
+	Tags             []string
+
+	// Rules are the rules of the policy. They are always reconciled.
+	Rules []L7Rule
+}
+
+// L7Rule is the desired state of an L7 rule. Rules are identified by all
+// their attributes: a rule which differs is deleted and created again.
+type L7Rule struct {
+	RuleType    l7policies.RuleType
+	CompareType l7policies.CompareType
+	Key         string
+	Value       string
+	Invert      bool
+}
+
+// String returns a short description of the rule, such as
+// "PATH STARTS_WITH /api" or "NOT HEADER EQUAL_TO X-Env=test".
+func (r L7Rule) String() string {
+	value := r.Value
+	if r.Key != "" {
+		value = r.Key + "=" + value
+	}
+	s := fmt.Sprintf("%s %s %s", r.RuleType, r.CompareType, value)
+	if r.Invert {
+		s = "NOT " + s
+	}
+	return s
+}
+
+// Diff compares the tree of a load balancer with a spec and returns the plan
+// which reconciles them. It does not change anything.
+func Diff(client *gophercloud.ServiceClient, loadBalancerID string, spec Spec) (*Plan, error) {
+	if err := spec.validate(); err != nil {
+		return nil, err
+	}
+
+	t, err := fetchTree(client, loadBalancerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return newDiffer(loadBalancerID, spec, t).diff()
+}
+
+// ApplyOpts configures how Apply waits for the load balancer.
+type ApplyOpts struct {
+	// Interval is the time between two polls of the load balancer. It
+	// defaults to one second.
+	Interval time.Duration
+
+	// Timeout is the number of seconds to wait for the load balancer to become
+	// ACTIVE before each operation. It defaults to 300.
+	Timeout int
+}
+
+// maxConflictAttempts is the number of times an operation is tried when the
+// load balancer keeps being found in an immutable state.
+const maxConflictAttempts = 3
+
+// Apply runs the operations of a plan one after the other. Before each one,
+// it waits for the load balancer to leave its immutable PENDING_* states, and
+// an operation rejected with a 409 Conflict is retried. Apply returns once the
+// load balancer is ACTIVE again.
+func Apply(client *gophercloud.ServiceClient, plan *Plan, opts ApplyOpts) error {
+	if plan.Empty() {
+		return nil
+	}
+
+	s := plan.state.copy()
+	for _, op := range plan.Operations {
+		if err := applyOperation(client, plan.LoadBalancerID, op, s, opts); err != nil {
+			return ErrOperationFailed{Operation: op, Err: err}
+		}
+	}
+
+	return waitForActive(client, plan.LoadBalancerID, opts)
+}
+
+// ReconcileOpts configures Reconcile.
+type ReconcileOpts struct {
+	// DryRun only computes the plan, without applying it.
+	DryRun bool
+
+	// Interval and Timeout are passed to Apply.
+	Interval time.Duration
+	Timeout  int
+}
+
+// Reconcile brings a load balancer to the state described by a spec with the
+// minimal set of calls, and returns the plan it applied.
+func Reconcile(client *gophercloud.ServiceClient, loadBalancerID string, spec Spec, opts ReconcileOpts) (*Plan, error) {
+	plan, err := Diff(client, loadBalancerID, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.DryRun {
+		return plan, nil
+	}
+
+	err = Apply(client, plan, ApplyOpts{
+		Interval: opts.Interval,
+		Timeout:  opts.Timeout,
+	})
+	return plan, err
+}
+
+func applyOperation(client *gophercloud.ServiceClient, loadBalancerID string, op Operation, s *state, opts ApplyOpts) error {
+	for attempt := 1; ; attempt++ {
+		if err := waitForActive(client, loadBalancerID, opts); err != nil {
+			return err
+		}
+
+		err := op.apply(client, s)
+		if _, ok := err.(gophercloud.ErrDefault409); ok && attempt < maxConflictAttempts {
+			continue
+		}
+		return err
+	}
+}
+
+// waitForActive polls the load balancer until its provisioning status is
+// ACTIVE.
+func waitForActive(client *gophercloud.ServiceClient, loadBalancerID string, opts ApplyOpts) error {
+	interval := opts.Interval
+	if interval == 0 {
+		interval = time.Second
+	}
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = 300
+	}
+	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
+
+	for {
+		lb, err := loadbalancers.Get(client, loadBalancerID).Extract()
+		if err != nil {
+			return err
+		}
+
+		switch lb.ProvisioningStatus {
+		case "ACTIVE":
+			return nil
+		case "ERROR":
+			return ErrLoadBalancerError{LoadBalancerID: loadBalancerID}
+		}
+
+		if time.Now().After(deadline) {
+			err := gophercloud.ErrTimeOut{}
+			err.Info = fmt.Sprintf("Load balancer %s is still %s", loadBalancerID, lb.ProvisioningStatus)
+			return err
+		}
+		time.Sleep(interval)
+	}
+}
+
+func (s Spec) validate() error {
+	poolNames := make(map[string]bool, len(s.Pools))
+	for _, p := range s.Pools {
+		if p.Name == "" {
+			return ErrInvalidSpec{Reason: "a pool has no name"}
+		}
+		if poolNames[p.Name] {
+			return ErrInvalidSpec{Reason: fmt.Sprintf("pool %q is defined twice", p.Name)}
+		}
+		poolNames[p.Name] = true
+
+		members := make(map[string]bool, len(p.Members))
+		for _, m := range p.Members {
+			key := memberKey(m.Address, m.ProtocolPort)
+			if members[key] {
+				return ErrInvalidSpec{Reason: fmt.Sprintf("member %s of pool %q is defined twice", key, p.Name)}
+			}
+			members[key] = true
+		}
+	}
+
+	listenerNames := make(map[string]bool, len(s.Listeners))
+	for _, l := range s.Listeners {
+		if l.Name == "" {
+			return ErrInvalidSpec{Reason: "a listener has no name"}
+		}
+		if listenerNames[l.Name] {
+			return ErrInvalidSpec{Reason: fmt.Sprintf("listener %q is defined twice", l.Name)}
+		}
+		listenerNames[l.Name] = true
+
+		if l.DefaultPool != "" && !poolNames[l.DefaultPool] {
+			return ErrInvalidSpec{Reason: fmt.Sprintf("listener %q refers to undefined pool %q", l.Name, l.DefaultPool)}
+		}
+
+		policyNames := make(map[string]bool, len(l.L7Policies))
+		for _, p := range l.L7Policies {
+			if p.Name == "" {
+				return ErrInvalidSpec{Reason: fmt.Sprintf("an L7 policy of listener %q has no name", l.Name)}
+			}
+			if policyNames[p.Name] {
+				return ErrInvalidSpec{Reason: fmt.Sprintf("L7 policy %q of listener %q is defined twice", p.Name, l.Name)}
+			}
+			policyNames[p.Name] = true
+
+			if p.RedirectPool != "" && !poolNames[p.RedirectPool] {
+				return ErrInvalidSpec{Reason: fmt.Sprintf("L7 policy %q of listener %q refers to undefined pool %q", p.Name, l.Name, p.RedirectPool)}
+			}
+		}
+	}
+
+	return nil
+}
+
+// tree is the current state of a load balancer.
+type tree struct {
+	listeners []*currentListener
+	pools     []*currentPool
+}
+
+type currentListener struct {
+	listeners.Listener
+	policies []l7policies.L7Policy
+}
+
+type currentPool struct {
+	pools.Pool
+	members []pools.Member
+	monitor *monitors.Monitor
+}
+
+func fetchTree(client *gophercloud.ServiceClient, loadBalancerID string) (*tree, error) {
+	t := new(tree)
+
+	allPages, err := pools.List(client, pools.ListOpts{LoadbalancerID: loadBalancerID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	allPools, err := pools.ExtractPools(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range allPools {
+		cp := &currentPool{Pool: p}
+
+		allPages, err := pools.ListMembers(client, p.ID, nil).AllPages()
+		if err != nil {
+			return nil, err
+		}
+		cp.members, err = pools.ExtractMembers(allPages)
+		if err != nil {
+			return nil, err
+		}
+
+		if p.MonitorID != "" {
+			cp.monitor, err = monitors.Get(client, p.MonitorID).Extract()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		t.pools = append(t.pools, cp)
+	}
+
+	allPages, err = listeners.List(client, listeners.ListOpts{LoadbalancerID: loadBalancerID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	allListeners, err := listeners.ExtractListeners(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, l := range allListeners {
+		cl := &currentListener{Listener: l}
+
+		allPages, err := l7policies.List(client, l7policies.ListOpts{ListenerID: l.ID}).AllPages()
+		if err != nil {
+			return nil, err
+		}
+		cl.policies, err = l7policies.ExtractL7Policies(allPages)
+		if err != nil {
+			return nil, err
+		}
+
+		t.listeners = append(t.listeners, cl)
+	}
+
+	return t, nil
+}
@@ -0,0 +1,129 @@
+package reconciler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// Action is the kind of change an Operation makes.
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// Resource is the type of resource an Operation applies to.
+type Resource string
+
+const (
+	ResourceListener Resource = "listener"
+	ResourcePool     Resource = "pool"
+	ResourceMembers  Resource = "members"
+	ResourceMonitor  Resource = "healthmonitor"
+	ResourceL7Policy Resource = "l7policy"
+	ResourceL7Rule   Resource = "l7rule"
+)
+
+// Operation is a single API call of a Plan.
+type Operation struct {
+	// Action is the kind of change made by the operation.
+	Action Action
+
+	// Resource is the type of the resource changed by the operation.
+	Resource Resource
+
+	// Name identifies the resource within the spec. Members and health
+	// monitors are named after their pool, L7 policies are prefixed with the
+	// name of their listener, and L7 rules with the name of their policy.
+	Name string
+
+	// ID is the ID of the existing resource, if any.
+	ID string
+
+	// Changes lists the attributes changed by an update. For the deletion of a
+	// resource that is recreated, it lists the immutable attributes that
+	// differ. For members, it lists the members added (+), updated (~) and
+	// removed (-).
+	Changes []string
+
+	apply func(*gophercloud.ServiceClient, *state) error
+}
+
+// String returns the operation as a line of a diff.
+func (o Operation) String() string {
+	var sign string
+	switch o.Action {
+	case ActionCreate:
+		sign = "+"
+	case ActionUpdate:
+		sign = "~"
+	case ActionDelete:
+		sign = "-"
+	}
+
+	s := fmt.Sprintf("%s %s %s", sign, o.Resource, o.Name)
+	if len(o.Changes) > 0 {
+		s += " (" + strings.Join(o.Changes, ", ") + ")"
+	}
+	return s
+}
+
+// Plan is the ordered list of operations which brings a load balancer to the
+// state described by a Spec.
+type Plan struct {
+	// LoadBalancerID is the ID of the reconciled load balancer.
+	LoadBalancerID string
+
+	// Operations are the API calls to make, in order.
+	Operations []Operation
+
+	state *state
+}
+
+// Empty reports whether the load balancer already matches the spec.
+func (p Plan) Empty() bool {
+	return len(p.Operations) == 0
+}
+
+// String returns the plan as a diff, one operation per line.
+func (p Plan) String() string {
+	lines := make([]string, len(p.Operations))
+	for i, op := range p.Operations {
+		lines[i] = op.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// state maps the names of the spec to the IDs of the resources. It is filled
+// in with the IDs of the created resources while a plan is applied.
+type state struct {
+	listeners map[string]string
+	pools     map[string]string
+	policies  map[string]string
+}
+
+func newState() *state {
+	return &state{
+		listeners: make(map[string]string),
+		pools:     make(map[string]string),
+		policies:  make(map[string]string),
+	}
+}
+
+func (s *state) copy() *state {
+	c := newState()
+	for k, v := range s.listeners {
+		c.listeners[k] = v
+	}
+	for k, v := range s.pools {
+		c.pools[k] = v
+	}
+	for k, v := range s.policies {
+		c.policies[k] = v
+	}
+	return c
+}
@@ -0,0 +1,2 @@
+// reconciler unit tests
+package testing
@@ -0,0 +1,458 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const (
+	LoadBalancerID = "36e08a3e-a78f-4b40-a229-1e7e23eee1ab"
+
+	HTTPListenerID   = "b8c1d5a3-3a8e-4b7c-9e4f-1d2c3b4a5e6f"
+	LegacyListenerID = "c9d2e6b4-4b9f-4c8d-af50-2e3d4c5b6f70"
+	AdminListenerID  = "0b7e5f7a-9e1d-4b3c-8f2a-6d5c4b3a2e1f"
+
+	APIPolicyID = "d1e3f7c5-5ca0-4d9e-b061-3f4e5d6c7081"
+	APIRuleID   = "e2f4a8d6-6db1-4eaf-8172-405f6e7d8192"
+	OldPolicyID = "f3a5b9e7-7ec2-4fb0-9283-51607f8e9203"
+
+	WebPoolID   = "6a0e4bb8-6d2c-4a5e-9f1b-3c7d8e9f0a1b"
+	APIPoolID   = "7b1f5cc9-7e3d-4b6f-a02c-4d8e9f0a1b2c"
+	OldPoolID   = "8c2a6dda-8f4e-4c7a-b13d-5e9f0a1b2c3d"
+	AdminPoolID = "9d3b7eeb-9a5f-4d8b-824e-6f0a1b2c3d4e"
+
+	WebMonitorID = "4e8c2f1a-3b5d-4e7f-9a1c-2b3d4e5f6a7b"
+	APIMonitorID = "5f9d3a2b-4c6e-4f8a-ab2d-3c4e5f6a7b8c"
+)
+
+// ListenersListBody is the canned body of the listener List request.
+const ListenersListBody = `
+{
+	"listeners": [
+		{
+			"id": "b8c1d5a3-3a8e-4b7c-9e4f-1d2c3b4a5e6f",
+			"name": "http",
+			"description": "",
+			"protocol": "HTTP",
+			"protocol_port": 80,
+			"default_pool_id": "6a0e4bb8-6d2c-4a5e-9f1b-3c7d8e9f0a1b",
+			"connection_limit": -1,
+			"admin_state_up": true
+		},
+		{
+			"id": "c9d2e6b4-4b9f-4c8d-af50-2e3d4c5b6f70",
+			"name": "legacy",
+			"description": "",
+			"protocol": "TCP",
+			"protocol_port": 8080,
+			"default_pool_id": "8c2a6dda-8f4e-4c7a-b13d-5e9f0a1b2c3d",
+			"connection_limit": -1,
+			"admin_state_up": true
+		}
+	]
+}
+`
+
+// HTTPPoliciesListBody is the canned body of the L7 policy List request of
+// the http listener.
+const HTTPPoliciesListBody = `
+{
+	"l7policies": [
+		{
+			"id": "d1e3f7c5-5ca0-4d9e-b061-3f4e5d6c7081",
+			"name": "api",
+			"listener_id": "b8c1d5a3-3a8e-4b7c-9e4f-1d2c3b4a5e6f",
+			"action": "REDIRECT_TO_POOL",
+			"position": 1,
+			"description": "",
+			"redirect_pool_id": "7b1f5cc9-7e3d-4b6f-a02c-4d8e9f0a1b2c",
+			"admin_state_up": true,
+			"rules": [
+				{
+					"id": "e2f4a8d6-6db1-4eaf-8172-405f6e7d8192",
+					"type": "PATH",
+					"compare_type": "STARTS_WITH",
+					"value": "/api",
+					"invert": false
+				}
+			]
+		},
+		{
+			"id": "f3a5b9e7-7ec2-4fb0-9283-51607f8e9203",
+			"name": "old",
+			"listener_id": "b8c1d5a3-3a8e-4b7c-9e4f-1d2c3b4a5e6f",
+			"action": "REJECT",
+			"position": 2,
+			"description": "",
+			"admin_state_up": true,
+			"rules": []
+		}
+	]
+}
+`
+
+// PoolsListBody is the canned body of the pool List request.
+const PoolsListBody = `
+{
+	"pools": [
+		{
+			"id": "6a0e4bb8-6d2c-4a5e-9f1b-3c7d8e9f0a1b",
+			"name": "web",
+			"description": "",
+			"protocol": "HTTP",
+			"lb_algorithm": "ROUND_ROBIN",
+			"healthmonitor_id": "4e8c2f1a-3b5d-4e7f-9a1c-2b3d4e5f6a7b",
+			"admin_state_up": true
+		},
+		{
+			"id": "7b1f5cc9-7e3d-4b6f-a02c-4d8e9f0a1b2c",
+			"name": "api",
+			"description": "",
+			"protocol": "HTTP",
+			"lb_algorithm": "ROUND_ROBIN",
+			"healthmonitor_id": "",
+			"admin_state_up": true
+		},
+		{
+			"id": "8c2a6dda-8f4e-4c7a-b13d-5e9f0a1b2c3d",
+			"name": "old",
+			"description": "",
+			"protocol": "TCP",
+			"lb_algorithm": "ROUND_ROBIN",
+			"healthmonitor_id": "",
+			"admin_state_up": true
+		}
+	]
+}
+`
+
+// WebMembersListBody is the canned body of the member List request of the
+// web pool.
+const WebMembersListBody = `
+{
+	"members": [
+		{
+			"id": "1a2b3c4d-0001-4e5f-8a9b-0c1d2e3f4a5b",
+			"name": "web-1",
+			"address": "10.0.0.1",
+			"protocol_port": 80,
+			"weight": 1,
+			"admin_state_up": true
+		},
+		{
+			"id": "1a2b3c4d-0002-4e5f-8a9b-0c1d2e3f4a5b",
+			"name": "web-2",
+			"address": "10.0.0.2",
+			"protocol_port": 80,
+			"weight": 1,
+			"admin_state_up": true
+		}
+	]
+}
+`
+
+// APIMembersListBody is the canned body of the member List request of the
+// api pool.
+const APIMembersListBody = `
+{
+	"members": [
+		{
+			"id": "1a2b3c4d-0005-4e5f-8a9b-0c1d2e3f4a5b",
+			"name": "api-1",
+			"address": "10.0.0.5",
+			"protocol_port": 8080,
+			"weight": 1,
+			"admin_state_up": true
+		}
+	]
+}
+`
+
+// WebMonitorBody is the canned body of the Get request on the health monitor
+// of the web pool.
+const WebMonitorBody = `
+{
+	"healthmonitor": {
+		"id": "4e8c2f1a-3b5d-4e7f-9a1c-2b3d4e5f6a7b",
+		"name": "",
+		"type": "HTTP",
+		"delay": 5,
+		"timeout": 3,
+		"max_retries": 3,
+		"max_retries_down": 3,
+		"http_method": "GET",
+		"url_path": "/",
+		"expected_codes": "200",
+		"admin_state_up": true
+	}
+}
+`
+
+// Recorder records the calls which change the tree of the load balancer.
+type Recorder struct {
+	mu    sync.Mutex
+	Calls []string
+}
+
+func (r *Recorder) record(req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.Calls = append(r.Calls, req.Method+" "+req.URL.Path)
+}
+
+// HandleLoadBalancerGetSuccessfully sets up the test server to respond to the
+// load balancer Get request with the given provisioning statuses, one per
+// call. The last status is repeated.
+func HandleLoadBalancerGetSuccessfully(t *testing.T, statuses ...string) {
+	var mu sync.Mutex
+	th.Mux.HandleFunc("/v2.0/lbaas/loadbalancers/"+LoadBalancerID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		mu.Lock()
+		status := statuses[0]
+		if len(statuses) > 1 {
+			statuses = statuses[1:]
+		}
+		mu.Unlock()
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"loadbalancer": {"id": "%s", "provisioning_status": "%s"}}`, LoadBalancerID, status)
+	})
+}
+
+// HandleTreeSuccessfully sets up the test server to serve the tree of the
+// load balancer and to respond to the calls which change it. These calls are
+// recorded in the returned Recorder.
+func HandleTreeSuccessfully(t *testing.T) *Recorder {
+	rec := new(Recorder)
+
+	th.Mux.HandleFunc("/v2.0/lbaas/listeners", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+
+		if r.Method == "GET" {
+			th.TestFormValues(t, r, map[string]string{"loadbalancer_id": LoadBalancerID})
+			fmt.Fprintf(w, ListenersListBody)
+			return
+		}
+
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `{
+			"listener": {
+				"loadbalancer_id": "36e08a3e-a78f-4b40-a229-1e7e23eee1ab",
+				"name": "admin",
+				"protocol": "HTTP",
+				"protocol_port": 8081,
+				"default_pool_id": "9d3b7eeb-9a5f-4d8b-824e-6f0a1b2c3d4e",
+				"allowed_cidrs": ["10.1.0.0/16"]
+			}
+		}`)
+		rec.record(r)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"listener": {"id": "%s", "name": "admin"}}`, AdminListenerID)
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/listeners/"+LegacyListenerID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		rec.record(r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/l7policies", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+
+		switch r.URL.Query().Get("listener_id") {
+		case HTTPListenerID:
+			fmt.Fprintf(w, HTTPPoliciesListBody)
+		case LegacyListenerID:
+			fmt.Fprintf(w, `{"l7policies": []}`)
+		default:
+			t.Fatalf("unexpected listener_id=[%s]", r.URL.Query().Get("listener_id"))
+		}
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/l7policies/"+OldPolicyID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		rec.record(r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/l7policies/"+APIPolicyID+"/rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `{
+			"rule": {
+				"type": "HOST_NAME",
+				"compare_type": "EQUAL_TO",
+				"value": "api.example.com"
+			}
+		}`)
+		rec.record(r)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"rule": {"id": "7c0f2a4e-1b3d-4f5a-9c8e-0d1f2a3b4c5d"}}`)
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/pools", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+
+		if r.Method == "GET" {
+			th.TestFormValues(t, r, map[string]string{"loadbalancer_id": LoadBalancerID})
+			fmt.Fprintf(w, PoolsListBody)
+			return
+		}
+
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `{
+			"pool": {
+				"loadbalancer_id": "36e08a3e-a78f-4b40-a229-1e7e23eee1ab",
+				"name": "admin",
+				"protocol": "HTTP",
+				"lb_algorithm": "ROUND_ROBIN",
+				"members": [
+					{
+						"address": "10.0.0.9",
+						"protocol_port": 8081
+					}
+				]
+			}
+		}`)
+		rec.record(r)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"pool": {"id": "%s", "name": "admin"}}`, AdminPoolID)
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/pools/"+WebPoolID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestJSONRequest(t, r, `{"pool": {"lb_algorithm": "LEAST_CONNECTIONS"}}`)
+		rec.record(r)
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"pool": {"id": "%s"}}`, WebPoolID)
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/pools/"+OldPoolID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		rec.record(r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/pools/"+WebPoolID+"/members", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+
+		if r.Method == "GET" {
+			fmt.Fprintf(w, WebMembersListBody)
+			return
+		}
+
+		th.TestMethod(t, r, "PUT")
+		th.TestJSONRequest(t, r, `{
+			"members": [
+				{
+					"address": "10.0.0.1",
+					"protocol_port": 80,
+					"name": "web-1"
+				},
+				{
+					"address": "10.0.0.3",
+					"protocol_port": 80,
+					"name": "web-3"
+				}
+			]
+		}`)
+		rec.record(r)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/pools/"+APIPoolID+"/members", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, APIMembersListBody)
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/pools/"+OldPoolID+"/members", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"members": []}`)
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/healthmonitors/"+WebMonitorID, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+
+		if r.Method == "GET" {
+			fmt.Fprintf(w, WebMonitorBody)
+			return
+		}
+
+		th.TestMethod(t, r, "PUT")
+		th.TestJSONRequest(t, r, `{"healthmonitor": {"delay": 10}}`)
+		rec.record(r)
+		fmt.Fprintf(w, WebMonitorBody)
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/healthmonitors", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `{
+			"healthmonitor": {
+				"pool_id": "7b1f5cc9-7e3d-4b6f-a02c-4d8e9f0a1b2c",
+				"type": "TCP",
+				"delay": 5,
+				"timeout": 3,
+				"max_retries": 3
+			}
+		}`)
+		rec.record(r)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"healthmonitor": {"id": "%s"}}`, APIMonitorID)
+	})
+
+	return rec
+}
+
+// HandleSinglePoolTreeSuccessfully sets up the test server to serve a load
+// balancer with a single pool, named old, and to respond to its deletion
+// with the given status codes, one per call. The deletions are recorded in
+// the returned Recorder.
+func HandleSinglePoolTreeSuccessfully(t *testing.T, deleteCodes ...int) *Recorder {
+	rec := new(Recorder)
+
+	th.Mux.HandleFunc("/v2.0/lbaas/listeners", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"listeners": []}`)
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/pools", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"pools": [{"id": "%s", "name": "old", "protocol": "TCP"}]}`, OldPoolID)
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/pools/"+OldPoolID+"/members", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"members": []}`)
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/pools/"+OldPoolID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		rec.record(r)
+
+		rec.mu.Lock()
+		code := deleteCodes[0]
+		if len(deleteCodes) > 1 {
+			deleteCodes = deleteCodes[1:]
+		}
+		rec.mu.Unlock()
+
+		w.WriteHeader(code)
+	})
+
+	return rec
+}
@@ -0,0 +1,209 @@
+package testing
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/l7policies"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/monitors"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/pools"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/reconciler"
+	fake "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/testhelper"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+var spec = reconciler.Spec{
+	Listeners: []reconciler.Listener{
+		{
+			Name:         "http",
+			Protocol:     listeners.ProtocolHTTP,
+			ProtocolPort: 80,
+			DefaultPool:  "web",
+			L7Policies: []reconciler.L7Policy{
+				{
+					Name:         "api",
+					Action:       l7policies.ActionRedirectToPool,
+					Position:     1,
+					RedirectPool: "api",
+					Rules: []reconciler.L7Rule{
+						{
+							RuleType:    l7policies.TypePath,
+							CompareType: l7policies.CompareTypeStartWith,
+							Value:       "/api",
+						},
+						{
+							RuleType:    l7policies.TypeHostName,
+							CompareType: l7policies.CompareTypeEqual,
+							Value:       "api.example.com",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:         "admin",
+			Protocol:     listeners.ProtocolHTTP,
+			ProtocolPort: 8081,
+			DefaultPool:  "admin",
+			AllowedCIDRs: []string{"10.1.0.0/16"},
+		},
+	},
+	Pools: []reconciler.Pool{
+		{
+			Name:     "web",
+			Protocol: pools.ProtocolHTTP,
+			LBMethod: pools.LBMethodLeastConnections,
+			Members: []reconciler.Member{
+				{Address: "10.0.0.1", ProtocolPort: 80, Name: "web-1"},
+				{Address: "10.0.0.3", ProtocolPort: 80, Name: "web-3"},
+			},
+			Monitor: &reconciler.Monitor{
+				Type:       monitors.TypeHTTP,
+				Delay:      10,
+				Timeout:    3,
+				MaxRetries: 3,
+			},
+		},
+		{
+			Name:     "api",
+			Protocol: pools.ProtocolHTTP,
+			LBMethod: pools.LBMethodRoundRobin,
+			Members: []reconciler.Member{
+				{Address: "10.0.0.5", ProtocolPort: 8080, Name: "api-1"},
+			},
+			Monitor: &reconciler.Monitor{
+				Type:       monitors.TypeTCP,
+				Delay:      5,
+				Timeout:    3,
+				MaxRetries: 3,
+			},
+		},
+		{
+			Name:     "admin",
+			Protocol: pools.ProtocolHTTP,
+			LBMethod: pools.LBMethodRoundRobin,
+			Members: []reconciler.Member{
+				{Address: "10.0.0.9", ProtocolPort: 8081},
+			},
+		},
+	},
+}
+
+const expectedDiff = `- l7policy http/old
+- listener legacy
+~ pool web (lb_algorithm)
+~ healthmonitor web (delay)
+~ members web (+10.0.0.3:80, -10.0.0.2:80)
++ healthmonitor api
++ pool admin
++ listener admin
++ l7rule http/api/HOST_NAME EQUAL_TO api.example.com
+- pool old`
+
+func TestReconcileDryRun(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	rec := HandleTreeSuccessfully(t)
+
+	plan, err := reconciler.Reconcile(fake.ServiceClient(), LoadBalancerID, spec, reconciler.ReconcileOpts{
+		DryRun: true,
+	})
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, expectedDiff, plan.String())
+	th.AssertEquals(t, false, plan.Empty())
+	th.AssertEquals(t, WebPoolID, plan.Operations[2].ID)
+	th.AssertEquals(t, 0, len(rec.Calls))
+}
+
+func TestReconcile(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLoadBalancerGetSuccessfully(t, "ACTIVE")
+	rec := HandleTreeSuccessfully(t)
+
+	plan, err := reconciler.Reconcile(fake.ServiceClient(), LoadBalancerID, spec, reconciler.ReconcileOpts{
+		Interval: time.Millisecond,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 10, len(plan.Operations))
+
+	th.AssertDeepEquals(t, []string{
+		"DELETE /v2.0/lbaas/l7policies/" + OldPolicyID,
+		"DELETE /v2.0/lbaas/listeners/" + LegacyListenerID,
+		"PUT /v2.0/lbaas/pools/" + WebPoolID,
+		"PUT /v2.0/lbaas/healthmonitors/" + WebMonitorID,
+		"PUT /v2.0/lbaas/pools/" + WebPoolID + "/members",
+		"POST /v2.0/lbaas/healthmonitors",
+		"POST /v2.0/lbaas/pools",
+		"POST /v2.0/lbaas/listeners",
+		"POST /v2.0/lbaas/l7policies/" + APIPolicyID + "/rules",
+		"DELETE /v2.0/lbaas/pools/" + OldPoolID,
+	}, rec.Calls)
+}
+
+func TestApplyWaitsAndRetriesConflicts(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLoadBalancerGetSuccessfully(t, "PENDING_UPDATE", "ACTIVE")
+	rec := HandleSinglePoolTreeSuccessfully(t, http.StatusConflict, http.StatusNoContent)
+
+	plan, err := reconciler.Diff(fake.ServiceClient(), LoadBalancerID, reconciler.Spec{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "- pool old", plan.String())
+
+	err = reconciler.Apply(fake.ServiceClient(), plan, reconciler.ApplyOpts{
+		Interval: time.Millisecond,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{
+		"DELETE /v2.0/lbaas/pools/" + OldPoolID,
+		"DELETE /v2.0/lbaas/pools/" + OldPoolID,
+	}, rec.Calls)
+}
+
+func TestApplyLoadBalancerError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLoadBalancerGetSuccessfully(t, "ERROR")
+	rec := HandleSinglePoolTreeSuccessfully(t, http.StatusNoContent)
+
+	_, err := reconciler.Reconcile(fake.ServiceClient(), LoadBalancerID, reconciler.Spec{}, reconciler.ReconcileOpts{
+		Interval: time.Millisecond,
+	})
+
+	var opErr reconciler.ErrOperationFailed
+	th.AssertEquals(t, true, errors.As(err, &opErr))
+	th.AssertEquals(t, reconciler.ActionDelete, opErr.Operation.Action)
+	th.AssertEquals(t, true, errors.As(err, &reconciler.ErrLoadBalancerError{}))
+	th.AssertEquals(t, 0, len(rec.Calls))
+}
+
+func TestApplyTimeout(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLoadBalancerGetSuccessfully(t, "PENDING_UPDATE")
+	HandleSinglePoolTreeSuccessfully(t, http.StatusNoContent)
+
+	plan, err := reconciler.Diff(fake.ServiceClient(), LoadBalancerID, reconciler.Spec{})
+	th.AssertNoErr(t, err)
+
+	err = reconciler.Apply(fake.ServiceClient(), plan, reconciler.ApplyOpts{
+		Interval: 300 * time.Millisecond,
+		Timeout:  1,
+	})
+	th.AssertEquals(t, true, errors.As(err, &gophercloud.ErrTimeOut{}))
+}
+
+func TestDiffInvalidSpec(t *testing.T) {
+	_, err := reconciler.Diff(fake.ServiceClient(), LoadBalancerID, reconciler.Spec{
+		Listeners: []reconciler.Listener{
+			{Name: "http", Protocol: listeners.ProtocolHTTP, ProtocolPort: 80, DefaultPool: "web"},
+		},
+	})
+	th.AssertEquals(t, true, errors.As(err, &reconciler.ErrInvalidSpec{}))
+}
@@ -0,0 +1,47 @@
+/*
+Package tlsbundles packages a TLS certificate, its private key and its
+intermediate certificates into the PKCS#12 Barbican secret expected by the
+TERMINATED_HTTPS listeners of the OpenStack Octavia Load Balancing service.
+
+Example to Store a Certificate Bundle
+
+	bundle, err := tlsbundles.ParsePEM(certPEM, keyPEM, intermediatesPEM)
+	if err != nil {
+		panic(err)
+	}
+
+	ref, err := tlsbundles.Create(keyManagerClient, tlsbundles.CreateOpts{
+		Name:   "www.example.com",
+		Bundle: *bundle,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(ref)
+
+Example to Create a TERMINATED_HTTPS Listener
+
+	createOpts := listeners.CreateOpts{
+		Protocol:       listeners.ProtocolTerminatedHTTPS,
+		ProtocolPort:   443,
+		LoadbalancerID: "36e08a3e-a78f-4b40-a229-1e7e23eee1ab",
+		Name:           "https",
+	}
+
+	err := tlsbundles.ConfigureListener(keyManagerClient, &createOpts, tlsbundles.ListenerOpts{
+		Default: tlsbundles.CreateOpts{Name: "www.example.com", Bundle: *wwwBundle},
+		SNI: []tlsbundles.CreateOpts{
+			{Name: "api.example.com", Bundle: *apiBundle},
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	listener, err := listeners.Create(lbClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package tlsbundles
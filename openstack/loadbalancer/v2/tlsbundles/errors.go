@@ -0,0 +1,28 @@
+package tlsbundles
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ErrInvalidPEM is returned by ParsePEM when an element of the bundle cannot
+// be found in the PEM data.
+type ErrInvalidPEM struct {
+	gophercloud.BaseError
+	What string
+}
+
+func (e ErrInvalidPEM) Error() string {
+	return fmt.Sprintf("Unable to find exactly one %s in the PEM data", e.What)
+}
+
+// ErrKeyMismatch is returned when the private key of a bundle does not match
+// its certificate.
+type ErrKeyMismatch struct {
+	gophercloud.BaseError
+}
+
+func (e ErrKeyMismatch) Error() string {
+	return "The private key does not match the certificate"
+}
@@ -0,0 +1,301 @@
+package tlsbundles
+
+import (
+	"crypto/cipher"
+	"crypto/des"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"unicode/utf16"
+)
+
+// The PKCS#12 structures below follow RFC 7292. Only what Octavia needs is
+// implemented: the certificates are stored in clear, the private key is
+// encrypted with pbeWithSHAAnd3-KeyTripleDES-CBC and the whole is protected
+// by an HMAC-SHA1, as OpenSSL does.
+
+var (
+	oidDataContentType      = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidCertBag              = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 3}
+	oidPKCS8ShroudedKeyBag  = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 2}
+	oidCertTypeX509         = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 22, 1}
+	oidLocalKeyID           = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 21}
+	oidPBEWithSHAAnd3KeyDES = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 1, 3}
+	oidSHA1                 = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+)
+
+const (
+	pkcs12Iterations = 2048
+	pkcs12SaltLength = 8
+)
+
+type pfxPdu struct {
+	Version  int
+	AuthSafe contentInfo
+	MacData  macData
+}
+
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"tag:0,explicit"`
+}
+
+type macData struct {
+	Mac        digestInfo
+	MacSalt    []byte
+	Iterations int
+}
+
+type digestInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	Digest    []byte
+}
+
+type safeBag struct {
+	ID         asn1.ObjectIdentifier
+	Value      asn1.RawValue     `asn1:"tag:0,explicit"`
+	Attributes []pkcs12Attribute `asn1:"set,optional"`
+}
+
+type pkcs12Attribute struct {
+	ID    asn1.ObjectIdentifier
+	Value asn1.RawValue `asn1:"set"`
+}
+
+type certBag struct {
+	ID   asn1.ObjectIdentifier
+	Data []byte `asn1:"tag:0,explicit"`
+}
+
+type encryptedPrivateKeyInfo struct {
+	Algorithm     pkix.AlgorithmIdentifier
+	EncryptedData []byte
+}
+
+type pbeParams struct {
+	Salt       []byte
+	Iterations int
+}
+
+// encodePKCS12 packages a private key and its certificate chain, leaf first,
+// into a PKCS#12 archive protected by password.
+func encodePKCS12(key interface{}, chain []*x509.Certificate, password string) ([]byte, error) {
+	pass := bmpString(password)
+
+	leafID := sha1.Sum(chain[0].Raw)
+	localKeyID, err := localKeyIDAttribute(leafID[:])
+	if err != nil {
+		return nil, err
+	}
+
+	var certBags []safeBag
+	for i, cert := range chain {
+		b, err := asn1.Marshal(certBag{ID: oidCertTypeX509, Data: cert.Raw})
+		if err != nil {
+			return nil, err
+		}
+		bag := safeBag{
+			ID:    oidCertBag,
+			Value: asn1.RawValue{FullBytes: explicitTag0(b)},
+		}
+		if i == 0 {
+			bag.Attributes = []pkcs12Attribute{localKeyID}
+		}
+		certBags = append(certBags, bag)
+	}
+
+	shroudedKey, err := encryptPrivateKey(key, pass)
+	if err != nil {
+		return nil, err
+	}
+	keyBags := []safeBag{
+		{
+			ID:         oidPKCS8ShroudedKeyBag,
+			Value:      asn1.RawValue{FullBytes: explicitTag0(shroudedKey)},
+			Attributes: []pkcs12Attribute{localKeyID},
+		},
+	}
+
+	var authenticatedSafe []contentInfo
+	for _, bags := range [][]safeBag{certBags, keyBags} {
+		b, err := asn1.Marshal(bags)
+		if err != nil {
+			return nil, err
+		}
+		ci, err := dataContentInfo(b)
+		if err != nil {
+			return nil, err
+		}
+		authenticatedSafe = append(authenticatedSafe, ci)
+	}
+
+	authSafeBytes, err := asn1.Marshal(authenticatedSafe)
+	if err != nil {
+		return nil, err
+	}
+	authSafe, err := dataContentInfo(authSafeBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	macSalt, err := randomSalt()
+	if err != nil {
+		return nil, err
+	}
+	mac := hmac.New(sha1.New, pbkdf(macSalt, pass, pkcs12Iterations, 3, 20))
+	mac.Write(authSafeBytes)
+
+	return asn1.Marshal(pfxPdu{
+		Version:  3,
+		AuthSafe: authSafe,
+		MacData: macData{
+			Mac: digestInfo{
+				Algorithm: pkix.AlgorithmIdentifier{Algorithm: oidSHA1, Parameters: asn1.NullRawValue},
+				Digest:    mac.Sum(nil),
+			},
+			MacSalt:    macSalt,
+			Iterations: pkcs12Iterations,
+		},
+	})
+}
+
+func encryptPrivateKey(key interface{}, pass []byte) ([]byte, error) {
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	salt, err := randomSalt()
+	if err != nil {
+		return nil, err
+	}
+	params, err := asn1.Marshal(pbeParams{Salt: salt, Iterations: pkcs12Iterations})
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := des.NewTripleDESCipher(pbkdf(salt, pass, pkcs12Iterations, 1, 24))
+	if err != nil {
+		return nil, err
+	}
+	iv := pbkdf(salt, pass, pkcs12Iterations, 2, block.BlockSize())
+
+	padding := block.BlockSize() - len(pkcs8)%block.BlockSize()
+	data := make([]byte, len(pkcs8), len(pkcs8)+padding)
+	copy(data, pkcs8)
+	for i := 0; i < padding; i++ {
+		data = append(data, byte(padding))
+	}
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(data, data)
+
+	return asn1.Marshal(encryptedPrivateKeyInfo{
+		Algorithm: pkix.AlgorithmIdentifier{
+			Algorithm:  oidPBEWithSHAAnd3KeyDES,
+			Parameters: asn1.RawValue{FullBytes: params},
+		},
+		EncryptedData: data,
+	})
+}
+
+func localKeyIDAttribute(id []byte) (pkcs12Attribute, error) {
+	b, err := asn1.Marshal(id)
+	if err != nil {
+		return pkcs12Attribute{}, err
+	}
+	return pkcs12Attribute{
+		ID: oidLocalKeyID,
+		Value: asn1.RawValue{
+			Class:      asn1.ClassUniversal,
+			Tag:        asn1.TagSet,
+			IsCompound: true,
+			Bytes:      b,
+		},
+	}, nil
+}
+
+func dataContentInfo(data []byte) (contentInfo, error) {
+	b, err := asn1.Marshal(data)
+	if err != nil {
+		return contentInfo{}, err
+	}
+	return contentInfo{
+		ContentType: oidDataContentType,
+		Content:     asn1.RawValue{FullBytes: explicitTag0(b)},
+	}, nil
+}
+
+// explicitTag0 wraps DER data in a context-specific [0] constructed tag.
+func explicitTag0(data []byte) []byte {
+	b, _ := asn1.Marshal(asn1.RawValue{
+		Class:      asn1.ClassContextSpecific,
+		Tag:        0,
+		IsCompound: true,
+		Bytes:      data,
+	})
+	return b
+}
+
+func randomSalt() ([]byte, error) {
+	salt := make([]byte, pkcs12SaltLength)
+	_, err := rand.Read(salt)
+	return salt, err
+}
+
+// bmpString encodes a password as a zero terminated UCS-2 string.
+func bmpString(s string) []byte {
+	u := utf16.Encode([]rune(s))
+	b := make([]byte, 0, 2*len(u)+2)
+	for _, r := range u {
+		b = append(b, byte(r>>8), byte(r))
+	}
+	return append(b, 0, 0)
+}
+
+// pbkdf derives size bytes of key material from a password with the SHA-1
+// based function of RFC 7292, appendix B.2. The id selects the kind of key
+// material: 1 for an encryption key, 2 for an IV and 3 for a MAC key.
+func pbkdf(salt, password []byte, iterations int, id byte, size int) []byte {
+	const v = 64
+
+	D := make([]byte, v)
+	for i := range D {
+		D[i] = id
+	}
+
+	fill := func(in []byte) []byte {
+		if len(in) == 0 {
+			return nil
+		}
+		out := make([]byte, v*((len(in)+v-1)/v))
+		for i := range out {
+			out[i] = in[i%len(in)]
+		}
+		return out
+	}
+	I := append(fill(salt), fill(password)...)
+
+	var out []byte
+	for len(out) < size {
+		A := sha1.Sum(append(append([]byte{}, D...), I...))
+		for i := 1; i < iterations; i++ {
+			A = sha1.Sum(A[:])
+		}
+		out = append(out, A[:]...)
+
+		B := fill(A[:])
+		for j := 0; j < len(I); j += v {
+			// I_j = (I_j + B + 1) mod 2^(8v)
+			carry := 1
+			for k := v - 1; k >= 0; k-- {
+				carry += int(I[j+k]) + int(B[k])
+				I[j+k] = byte(carry)
+				carry >>= 8
+			}
+		}
+	}
+
+	return out[:size]
+}
@@ -0,0 +1,180 @@
+package tlsbundles
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/keymanager/v1/secrets"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/listeners"
+)
+
+// Bundle is a TLS certificate with its private key and the intermediate
+// certificates of its chain.
+type Bundle struct {
+	Certificate   *x509.Certificate
+	PrivateKey    crypto.PrivateKey
+	Intermediates []*x509.Certificate
+}
+
+// ParsePEM builds a Bundle from PEM encoded data. The private key may be in
+// PKCS#8, PKCS#1 or SEC 1 form. intermediates may hold several certificates,
+// or be nil.
+func ParsePEM(certificate, privateKey, intermediates []byte) (*Bundle, error) {
+	certs, err := parseCertificates(certificate)
+	if err != nil {
+		return nil, err
+	}
+	if len(certs) != 1 {
+		return nil, ErrInvalidPEM{What: "certificate"}
+	}
+
+	block, _ := pem.Decode(privateKey)
+	if block == nil {
+		return nil, ErrInvalidPEM{What: "private key"}
+	}
+	key, err := parsePrivateKey(block)
+	if err != nil {
+		return nil, err
+	}
+
+	chain, err := parseCertificates(intermediates)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Bundle{
+		Certificate:   certs[0],
+		PrivateKey:    key,
+		Intermediates: chain,
+	}, nil
+}
+
+func parseCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return certs, nil
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+}
+
+func parsePrivateKey(block *pem.Block) (crypto.PrivateKey, error) {
+	switch block.Type {
+	case "PRIVATE KEY":
+		return x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	}
+	return nil, ErrInvalidPEM{What: "private key"}
+}
+
+// PKCS12 packages the bundle into a PKCS#12 archive without password, which
+// is the format Octavia expects for the certificates of TERMINATED_HTTPS
+// listeners.
+func (b Bundle) PKCS12() ([]byte, error) {
+	if b.Certificate == nil {
+		return nil, gophercloud.ErrMissingInput{Argument: "Certificate"}
+	}
+	if b.PrivateKey == nil {
+		return nil, gophercloud.ErrMissingInput{Argument: "PrivateKey"}
+	}
+
+	signer, ok := b.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, ErrKeyMismatch{}
+	}
+	public, ok := signer.Public().(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !public.Equal(b.Certificate.PublicKey) {
+		return nil, ErrKeyMismatch{}
+	}
+
+	chain := append([]*x509.Certificate{b.Certificate}, b.Intermediates...)
+	return encodePKCS12(b.PrivateKey, chain, "")
+}
+
+// CreateOpts contains the values used to store a bundle in the Key Manager
+// service.
+type CreateOpts struct {
+	// Name is the name of the secret.
+	Name string
+
+	// Bundle is the certificate bundle to store.
+	Bundle Bundle
+
+	// Expiration is the date at which the secret expires.
+	Expiration *time.Time
+}
+
+// Create stores a bundle as a PKCS#12 Barbican secret and returns the
+// reference of the secret. client must be a Key Manager service client.
+func Create(client *gophercloud.ServiceClient, opts CreateOpts) (string, error) {
+	p12, err := opts.Bundle.PKCS12()
+	if err != nil {
+		return "", err
+	}
+
+	secret, err := secrets.Create(client, secrets.CreateOpts{
+		Name:                   opts.Name,
+		Payload:                base64.StdEncoding.EncodeToString(p12),
+		PayloadContentType:     "application/octet-stream",
+		PayloadContentEncoding: "base64",
+		SecretType:             secrets.OpaqueSecret,
+		Expiration:             opts.Expiration,
+	}).Extract()
+	if err != nil {
+		return "", err
+	}
+
+	return secret.SecretRef, nil
+}
+
+// ListenerOpts contains the certificate bundles of a TERMINATED_HTTPS
+// listener.
+type ListenerOpts struct {
+	// Default is the certificate served to the clients which do not use SNI,
+	// or ask for a host name no other certificate matches.
+	Default CreateOpts
+
+	// SNI are the certificates served based on the host name asked for by
+	// the clients.
+	SNI []CreateOpts
+}
+
+// ConfigureListener stores the bundles of opts as Barbican secrets, and sets
+// their references as the DefaultTlsContainerRef and SniContainerRefs of
+// listenerOpts. client must be a Key Manager service client.
+func ConfigureListener(client *gophercloud.ServiceClient, listenerOpts *listeners.CreateOpts, opts ListenerOpts) error {
+	ref, err := Create(client, opts.Default)
+	if err != nil {
+		return err
+	}
+
+	var sniRefs []string
+	for _, sni := range opts.SNI {
+		sniRef, err := Create(client, sni)
+		if err != nil {
+			return err
+		}
+		sniRefs = append(sniRefs, sniRef)
+	}
+
+	listenerOpts.DefaultTlsContainerRef = ref
+	listenerOpts.SniContainerRefs = sniRefs
+	return nil
+}
@@ -0,0 +1,2 @@
+// tlsbundles unit tests
+package testing
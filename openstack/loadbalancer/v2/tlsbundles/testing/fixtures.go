@@ -0,0 +1,117 @@
+package testing
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+	"golang.org/x/crypto/pkcs12"
+)
+
+// SecretRefPrefix is the prefix of the references of the created secrets.
+const SecretRefPrefix = "http://barbican:9311/v1/secrets/"
+
+// Chain is a certificate chain generated for the tests.
+type Chain struct {
+	Leaf         *x509.Certificate
+	LeafKey      *ecdsa.PrivateKey
+	Intermediate *x509.Certificate
+}
+
+// PEM returns the certificate, private key and intermediate of the chain in
+// PEM form.
+func (c Chain) PEM(t *testing.T) (cert, key, intermediate []byte) {
+	der, err := x509.MarshalECPrivateKey(c.LeafKey)
+	th.AssertNoErr(t, err)
+
+	cert = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.Leaf.Raw})
+	key = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+	intermediate = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.Intermediate.Raw})
+	return
+}
+
+// GenerateChain creates an intermediate CA and a leaf certificate for
+// hostname, signed by the intermediate.
+func GenerateChain(t *testing.T, hostname string) Chain {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	th.AssertNoErr(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test Intermediate CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	th.AssertNoErr(t, err)
+	ca, err := x509.ParseCertificate(caDER)
+	th.AssertNoErr(t, err)
+
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	th.AssertNoErr(t, err)
+	leafTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: hostname},
+		DNSNames:     []string{hostname},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, leafTemplate, ca, &leafKey.PublicKey, caKey)
+	th.AssertNoErr(t, err)
+	leaf, err := x509.ParseCertificate(leafDER)
+	th.AssertNoErr(t, err)
+
+	return Chain{Leaf: leaf, LeafKey: leafKey, Intermediate: ca}
+}
+
+// HandleSecretCreationSuccessfully sets up the test server to respond to
+// secret creation requests. It checks that the payload of each secret is a
+// PKCS#12 archive holding a key and two certificates, and records the names
+// of the secrets in the returned slice.
+func HandleSecretCreationSuccessfully(t *testing.T) *[]string {
+	var names []string
+	th.Mux.HandleFunc("/secrets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		var body struct {
+			Name                   string `json:"name"`
+			Payload                string `json:"payload"`
+			PayloadContentType     string `json:"payload_content_type"`
+			PayloadContentEncoding string `json:"payload_content_encoding"`
+			SecretType             string `json:"secret_type"`
+		}
+		th.AssertNoErr(t, json.NewDecoder(r.Body).Decode(&body))
+		th.AssertEquals(t, "application/octet-stream", body.PayloadContentType)
+		th.AssertEquals(t, "base64", body.PayloadContentEncoding)
+		th.AssertEquals(t, "opaque", body.SecretType)
+
+		p12, err := base64.StdEncoding.DecodeString(body.Payload)
+		th.AssertNoErr(t, err)
+		blocks, err := pkcs12.ToPEM(p12, "")
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, 3, len(blocks))
+
+		names = append(names, body.Name)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"secret_ref": "%s%s"}`, SecretRefPrefix, body.Name)
+	})
+	return &names
+}
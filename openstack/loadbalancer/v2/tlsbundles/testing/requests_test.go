@@ -0,0 +1,111 @@
+package testing
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"errors"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/tlsbundles"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+	"golang.org/x/crypto/pkcs12"
+)
+
+func TestParsePEM(t *testing.T) {
+	chain := GenerateChain(t, "www.example.com")
+	cert, key, intermediate := chain.PEM(t)
+
+	bundle, err := tlsbundles.ParsePEM(cert, key, intermediate)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, bundle.Certificate.Equal(chain.Leaf))
+	th.AssertEquals(t, true, chain.LeafKey.Equal(bundle.PrivateKey))
+	th.AssertEquals(t, 1, len(bundle.Intermediates))
+	th.AssertEquals(t, true, bundle.Intermediates[0].Equal(chain.Intermediate))
+
+	_, err = tlsbundles.ParsePEM(cert, cert, nil)
+	th.AssertEquals(t, true, errors.As(err, &tlsbundles.ErrInvalidPEM{}))
+}
+
+func TestPKCS12(t *testing.T) {
+	chain := GenerateChain(t, "www.example.com")
+
+	bundle := tlsbundles.Bundle{
+		Certificate: chain.Leaf,
+		PrivateKey:  chain.LeafKey,
+	}
+	p12, err := bundle.PKCS12()
+	th.AssertNoErr(t, err)
+
+	key, cert, err := pkcs12.Decode(p12, "")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, cert.Equal(chain.Leaf))
+	th.AssertEquals(t, true, chain.LeafKey.Equal(key))
+
+	bundle.Intermediates = append(bundle.Intermediates, chain.Intermediate)
+	p12, err = bundle.PKCS12()
+	th.AssertNoErr(t, err)
+
+	blocks, err := pkcs12.ToPEM(p12, "")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, len(blocks))
+	th.AssertDeepEquals(t, chain.Leaf.Raw, blocks[0].Bytes)
+	th.AssertDeepEquals(t, chain.Intermediate.Raw, blocks[1].Bytes)
+	th.AssertEquals(t, "PRIVATE KEY", blocks[2].Type)
+	th.AssertEquals(t, blocks[0].Headers["localKeyId"], blocks[2].Headers["localKeyId"])
+}
+
+func TestPKCS12KeyMismatch(t *testing.T) {
+	chain := GenerateChain(t, "www.example.com")
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	th.AssertNoErr(t, err)
+
+	_, err = tlsbundles.Bundle{Certificate: chain.Leaf, PrivateKey: otherKey}.PKCS12()
+	th.AssertEquals(t, true, errors.As(err, &tlsbundles.ErrKeyMismatch{}))
+
+	_, err = tlsbundles.Bundle{PrivateKey: otherKey}.PKCS12()
+	th.AssertEquals(t, true, errors.As(err, &gophercloud.ErrMissingInput{}))
+}
+
+func TestConfigureListener(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	names := HandleSecretCreationSuccessfully(t)
+
+	www := GenerateChain(t, "www.example.com")
+	api := GenerateChain(t, "api.example.com")
+
+	createOpts := listeners.CreateOpts{
+		Protocol:     listeners.ProtocolTerminatedHTTPS,
+		ProtocolPort: 443,
+	}
+	err := tlsbundles.ConfigureListener(client.ServiceClient(), &createOpts, tlsbundles.ListenerOpts{
+		Default: tlsbundles.CreateOpts{
+			Name: "www",
+			Bundle: tlsbundles.Bundle{
+				Certificate:   www.Leaf,
+				PrivateKey:    www.LeafKey,
+				Intermediates: []*x509.Certificate{www.Intermediate},
+			},
+		},
+		SNI: []tlsbundles.CreateOpts{
+			{
+				Name: "api",
+				Bundle: tlsbundles.Bundle{
+					Certificate:   api.Leaf,
+					PrivateKey:    api.LeafKey,
+					Intermediates: []*x509.Certificate{api.Intermediate},
+				},
+			},
+		},
+	})
+	th.AssertNoErr(t, err)
+
+	th.AssertDeepEquals(t, []string{"www", "api"}, *names)
+	th.AssertEquals(t, SecretRefPrefix+"www", createOpts.DefaultTlsContainerRef)
+	th.AssertDeepEquals(t, []string{SecretRefPrefix + "api"}, createOpts.SniContainerRefs)
+}
@@ -0,0 +1,88 @@
+/*
+Package bgpvpns allows management of BGP VPNs in the Openstack Network Service
+through the networking-bgpvpn extension. Networks, routers and ports are
+interconnected with a BGP VPN by associating them with it.
+
+Example to List BGP VPNs
+
+	listOpts := bgpvpns.ListOpts{
+		Type: bgpvpns.TypeL3,
+	}
+
+	allPages, err := bgpvpns.List(client, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allVPNs, err := bgpvpns.ExtractBGPVPNs(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, vpn := range allVPNs {
+		fmt.Printf("%+v\n", vpn)
+	}
+
+Example to Create a BGP VPN
+
+	createOpts := bgpvpns.CreateOpts{
+		Name:         "vpn1",
+		RouteTargets: []string{"64512:1444"},
+	}
+
+	vpn, err := bgpvpns.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Associate a Network with a BGP VPN
+
+	createOpts := bgpvpns.CreateNetworkAssociationOpts{
+		NetworkID: "8c5d88dc-60ac-4b02-a65a-36b65888ddcd",
+	}
+
+	association, err := bgpvpns.CreateNetworkAssociation(client, vpn.ID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Associate a Router with a BGP VPN
+
+	advertiseExtraRoutes := true
+	createOpts := bgpvpns.CreateRouterAssociationOpts{
+		RouterID:             "b0b7ff1b-8a41-4ba1-9cbf-c3c2d2d2e64f",
+		AdvertiseExtraRoutes: &advertiseExtraRoutes,
+	}
+
+	association, err := bgpvpns.CreateRouterAssociation(client, vpn.ID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Associate a Port with a BGP VPN, Advertising Static Routes
+
+	localPref := 100
+	createOpts := bgpvpns.CreatePortAssociationOpts{
+		PortID: "5e2f4a8f-53de-4a70-a2fa-3b7b8ab7ee7c",
+		Routes: []bgpvpns.PortRoute{
+			{
+				Type:      bgpvpns.RouteTypePrefix,
+				Prefix:    "10.10.0.0/24",
+				LocalPref: &localPref,
+			},
+		},
+	}
+
+	association, err := bgpvpns.CreatePortAssociation(client, vpn.ID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a BGP VPN
+
+	err := bgpvpns.Delete(client, "460ac411-3dfb-45bb-8116-ed1a7233d143").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package bgpvpns
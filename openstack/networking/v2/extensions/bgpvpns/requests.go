@@ -0,0 +1,511 @@
+package bgpvpns
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Type is the type of a BGP VPN.
+type Type string
+
+const (
+	TypeL2 Type = "l2"
+	TypeL3 Type = "l3"
+)
+
+// RouteType is the type of a route advertised on behalf of a port.
+type RouteType string
+
+const (
+	// RouteTypePrefix advertises a prefix with the port as next hop.
+	RouteTypePrefix RouteType = "prefix"
+
+	// RouteTypeBGPVPN advertises all the routes of another BGP VPN with the
+	// port as next hop.
+	RouteTypeBGPVPN RouteType = "bgpvpn"
+)
+
+// PortRoute is a route advertised in a BGP VPN on behalf of a port.
+type PortRoute struct {
+	// Type is the type of the route.
+	Type RouteType `json:"type" required:"true"`
+
+	// Prefix is the CIDR of the route. It is required when Type is
+	// RouteTypePrefix.
+	Prefix string `json:"prefix,omitempty"`
+
+	// BGPVPNID is the ID of the BGP VPN whose routes are advertised. It is
+	// required when Type is RouteTypeBGPVPN.
+	BGPVPNID string `json:"bgpvpn_id,omitempty"`
+
+	// LocalPref is the BGP LOCAL_PREF of the route, overriding the one of the
+	// BGP VPN.
+	LocalPref *int `json:"local_pref,omitempty"`
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToBGPVPNCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains all the values needed to create a new BGP VPN.
+type CreateOpts struct {
+	// TenantID specifies a tenant to own the BGP VPN. The caller must have an
+	// admin role in order to set this. Otherwise, this field is left unset
+	// and the caller will be the owner.
+	TenantID string `json:"tenant_id,omitempty"`
+
+	// ProjectID specifies a project to own the BGP VPN.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// Name is the human readable name of the BGP VPN.
+	Name string `json:"name,omitempty"`
+
+	// Type is the type of the BGP VPN. It defaults to l3.
+	Type Type `json:"type,omitempty"`
+
+	// RouteDistinguishers are the route distinguishers advertised for the
+	// BGP VPN. Setting them requires an admin role.
+	RouteDistinguishers []string `json:"route_distinguishers,omitempty"`
+
+	// RouteTargets are the route targets imported and exported by the
+	// BGP VPN. Setting them requires an admin role.
+	RouteTargets []string `json:"route_targets,omitempty"`
+
+	// ImportTargets are the additional route targets imported by the BGP VPN.
+	ImportTargets []string `json:"import_targets,omitempty"`
+
+	// ExportTargets are the additional route targets exported by the BGP VPN.
+	ExportTargets []string `json:"export_targets,omitempty"`
+
+	// LocalPref is the default BGP LOCAL_PREF of the routes advertised.
+	LocalPref *int `json:"local_pref,omitempty"`
+
+	// VNI is the globally assigned VXLAN network identifier.
+	VNI int `json:"vni,omitempty"`
+}
+
+// ToBGPVPNCreateMap casts a CreateOpts struct to a map.
+func (opts CreateOpts) ToBGPVPNCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "bgpvpn")
+}
+
+// Create accepts a CreateOpts struct and uses the values to create a new
+// BGP VPN.
+func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToBGPVPNCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(rootURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves a particular BGP VPN based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(resourceURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToBGPVPNListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the BGP VPN attributes you want to see returned. SortKey allows you to sort
+// by a particular BGP VPN attribute. SortDir sets the direction, and is
+// either `asc' or `desc'. Marker and Limit are used for pagination.
+type ListOpts struct {
+	ID        string `q:"id"`
+	TenantID  string `q:"tenant_id"`
+	ProjectID string `q:"project_id"`
+	Name      string `q:"name"`
+	Type      Type   `q:"type"`
+	Networks  string `q:"networks"`
+	Routers   string `q:"routers"`
+	Ports     string `q:"ports"`
+	LocalPref int    `q:"local_pref"`
+	VNI       int    `q:"vni"`
+	Marker    string `q:"marker"`
+	Limit     int    `q:"limit"`
+	SortKey   string `q:"sort_key"`
+	SortDir   string `q:"sort_dir"`
+}
+
+// ToBGPVPNListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToBGPVPNListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// BGP VPNs. It accepts a ListOpts struct, which allows you to filter and sort
+// the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToBGPVPNListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return BGPVPNPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Delete will permanently delete a particular BGP VPN based on its unique ID.
+func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(resourceURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToBGPVPNUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains the values used when updating a BGP VPN.
+type UpdateOpts struct {
+	Name                *string   `json:"name,omitempty"`
+	RouteDistinguishers *[]string `json:"route_distinguishers,omitempty"`
+	RouteTargets        *[]string `json:"route_targets,omitempty"`
+	ImportTargets       *[]string `json:"import_targets,omitempty"`
+	ExportTargets       *[]string `json:"export_targets,omitempty"`
+	LocalPref           *int      `json:"local_pref,omitempty"`
+}
+
+// ToBGPVPNUpdateMap casts an UpdateOpts struct to a map.
+func (opts UpdateOpts) ToBGPVPNUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "bgpvpn")
+}
+
+// Update allows BGP VPNs to be updated.
+func Update(c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToBGPVPNUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(resourceURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListAssociationsOptsBuilder allows extensions to add additional parameters
+// to the ListNetworkAssociations, ListRouterAssociations and
+// ListPortAssociations requests.
+type ListAssociationsOptsBuilder interface {
+	ToAssociationListQuery() (string, error)
+}
+
+// ListAssociationsOpts allows the filtering and sorting of paginated
+// collections of associations of a BGP VPN.
+type ListAssociationsOpts struct {
+	ID        string `q:"id"`
+	TenantID  string `q:"tenant_id"`
+	ProjectID string `q:"project_id"`
+	NetworkID string `q:"network_id"`
+	RouterID  string `q:"router_id"`
+	PortID    string `q:"port_id"`
+	Marker    string `q:"marker"`
+	Limit     int    `q:"limit"`
+	SortKey   string `q:"sort_key"`
+	SortDir   string `q:"sort_dir"`
+}
+
+// ToAssociationListQuery formats a ListAssociationsOpts into a query string.
+func (opts ListAssociationsOpts) ToAssociationListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+func listAssociationsURL(url string, opts ListAssociationsOptsBuilder) (string, error) {
+	if opts != nil {
+		query, err := opts.ToAssociationListQuery()
+		if err != nil {
+			return "", err
+		}
+		url += query
+	}
+	return url, nil
+}
+
+// ListNetworkAssociations returns a Pager which allows you to iterate over the
+// network associations of a BGP VPN.
+func ListNetworkAssociations(c *gophercloud.ServiceClient, bgpVpnID string, opts ListAssociationsOptsBuilder) pagination.Pager {
+	url, err := listAssociationsURL(networkAssociationsURL(c, bgpVpnID), opts)
+	if err != nil {
+		return pagination.Pager{Err: err}
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return NetworkAssociationPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateNetworkAssociationOptsBuilder allows extensions to add additional
+// parameters to the CreateNetworkAssociation request.
+type CreateNetworkAssociationOptsBuilder interface {
+	ToNetworkAssociationCreateMap() (map[string]interface{}, error)
+}
+
+// CreateNetworkAssociationOpts contains all the values needed to associate a
+// network with a BGP VPN.
+type CreateNetworkAssociationOpts struct {
+	// NetworkID is the ID of the network to associate.
+	NetworkID string `json:"network_id" required:"true"`
+
+	// TenantID specifies a tenant to own the association.
+	TenantID string `json:"tenant_id,omitempty"`
+
+	// ProjectID specifies a project to own the association.
+	ProjectID string `json:"project_id,omitempty"`
+}
+
+// ToNetworkAssociationCreateMap casts a CreateNetworkAssociationOpts struct to
+// a map.
+func (opts CreateNetworkAssociationOpts) ToNetworkAssociationCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "network_association")
+}
+
+// CreateNetworkAssociation associates a network with a BGP VPN.
+func CreateNetworkAssociation(c *gophercloud.ServiceClient, bgpVpnID string, opts CreateNetworkAssociationOptsBuilder) (r CreateNetworkAssociationResult) {
+	b, err := opts.ToNetworkAssociationCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(networkAssociationsURL(c, bgpVpnID), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetNetworkAssociation retrieves a particular network association of a
+// BGP VPN.
+func GetNetworkAssociation(c *gophercloud.ServiceClient, bgpVpnID, id string) (r GetNetworkAssociationResult) {
+	resp, err := c.Get(networkAssociationURL(c, bgpVpnID, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteNetworkAssociation removes a network association from a BGP VPN.
+func DeleteNetworkAssociation(c *gophercloud.ServiceClient, bgpVpnID, id string) (r DeleteNetworkAssociationResult) {
+	resp, err := c.Delete(networkAssociationURL(c, bgpVpnID, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListRouterAssociations returns a Pager which allows you to iterate over the
+// router associations of a BGP VPN.
+func ListRouterAssociations(c *gophercloud.ServiceClient, bgpVpnID string, opts ListAssociationsOptsBuilder) pagination.Pager {
+	url, err := listAssociationsURL(routerAssociationsURL(c, bgpVpnID), opts)
+	if err != nil {
+		return pagination.Pager{Err: err}
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return RouterAssociationPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateRouterAssociationOptsBuilder allows extensions to add additional
+// parameters to the CreateRouterAssociation request.
+type CreateRouterAssociationOptsBuilder interface {
+	ToRouterAssociationCreateMap() (map[string]interface{}, error)
+}
+
+// CreateRouterAssociationOpts contains all the values needed to associate a
+// router with a BGP VPN.
+type CreateRouterAssociationOpts struct {
+	// RouterID is the ID of the router to associate.
+	RouterID string `json:"router_id" required:"true"`
+
+	// TenantID specifies a tenant to own the association.
+	TenantID string `json:"tenant_id,omitempty"`
+
+	// ProjectID specifies a project to own the association.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// AdvertiseExtraRoutes sets whether the extra routes of the router are
+	// advertised in the BGP VPN.
+	AdvertiseExtraRoutes *bool `json:"advertise_extra_routes,omitempty"`
+}
+
+// ToRouterAssociationCreateMap casts a CreateRouterAssociationOpts struct to a
+// map.
+func (opts CreateRouterAssociationOpts) ToRouterAssociationCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "router_association")
+}
+
+// CreateRouterAssociation associates a router with a BGP VPN.
+func CreateRouterAssociation(c *gophercloud.ServiceClient, bgpVpnID string, opts CreateRouterAssociationOptsBuilder) (r CreateRouterAssociationResult) {
+	b, err := opts.ToRouterAssociationCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(routerAssociationsURL(c, bgpVpnID), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetRouterAssociation retrieves a particular router association of a
+// BGP VPN.
+func GetRouterAssociation(c *gophercloud.ServiceClient, bgpVpnID, id string) (r GetRouterAssociationResult) {
+	resp, err := c.Get(routerAssociationURL(c, bgpVpnID, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateRouterAssociationOptsBuilder allows extensions to add additional
+// parameters to the UpdateRouterAssociation request.
+type UpdateRouterAssociationOptsBuilder interface {
+	ToRouterAssociationUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateRouterAssociationOpts contains the values used when updating a router
+// association.
+type UpdateRouterAssociationOpts struct {
+	AdvertiseExtraRoutes *bool `json:"advertise_extra_routes,omitempty"`
+}
+
+// ToRouterAssociationUpdateMap casts an UpdateRouterAssociationOpts struct to
+// a map.
+func (opts UpdateRouterAssociationOpts) ToRouterAssociationUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "router_association")
+}
+
+// UpdateRouterAssociation updates a router association of a BGP VPN.
+func UpdateRouterAssociation(c *gophercloud.ServiceClient, bgpVpnID, id string, opts UpdateRouterAssociationOptsBuilder) (r UpdateRouterAssociationResult) {
+	b, err := opts.ToRouterAssociationUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(routerAssociationURL(c, bgpVpnID, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteRouterAssociation removes a router association from a BGP VPN.
+func DeleteRouterAssociation(c *gophercloud.ServiceClient, bgpVpnID, id string) (r DeleteRouterAssociationResult) {
+	resp, err := c.Delete(routerAssociationURL(c, bgpVpnID, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListPortAssociations returns a Pager which allows you to iterate over the
+// port associations of a BGP VPN.
+func ListPortAssociations(c *gophercloud.ServiceClient, bgpVpnID string, opts ListAssociationsOptsBuilder) pagination.Pager {
+	url, err := listAssociationsURL(portAssociationsURL(c, bgpVpnID), opts)
+	if err != nil {
+		return pagination.Pager{Err: err}
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return PortAssociationPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreatePortAssociationOptsBuilder allows extensions to add additional
+// parameters to the CreatePortAssociation request.
+type CreatePortAssociationOptsBuilder interface {
+	ToPortAssociationCreateMap() (map[string]interface{}, error)
+}
+
+// CreatePortAssociationOpts contains all the values needed to associate a port
+// with a BGP VPN.
+type CreatePortAssociationOpts struct {
+	// PortID is the ID of the port to associate.
+	PortID string `json:"port_id" required:"true"`
+
+	// TenantID specifies a tenant to own the association.
+	TenantID string `json:"tenant_id,omitempty"`
+
+	// ProjectID specifies a project to own the association.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// Routes are the routes to advertise in the BGP VPN on behalf of the port.
+	Routes []PortRoute `json:"routes,omitempty"`
+
+	// AdvertiseFixedIPs sets whether the fixed IPs of the port are advertised
+	// in the BGP VPN.
+	AdvertiseFixedIPs *bool `json:"advertise_fixed_ips,omitempty"`
+}
+
+// ToPortAssociationCreateMap casts a CreatePortAssociationOpts struct to a
+// map.
+func (opts CreatePortAssociationOpts) ToPortAssociationCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "port_association")
+}
+
+// CreatePortAssociation associates a port with a BGP VPN.
+func CreatePortAssociation(c *gophercloud.ServiceClient, bgpVpnID string, opts CreatePortAssociationOptsBuilder) (r CreatePortAssociationResult) {
+	b, err := opts.ToPortAssociationCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(portAssociationsURL(c, bgpVpnID), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetPortAssociation retrieves a particular port association of a BGP VPN.
+func GetPortAssociation(c *gophercloud.ServiceClient, bgpVpnID, id string) (r GetPortAssociationResult) {
+	resp, err := c.Get(portAssociationURL(c, bgpVpnID, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdatePortAssociationOptsBuilder allows extensions to add additional
+// parameters to the UpdatePortAssociation request.
+type UpdatePortAssociationOptsBuilder interface {
+	ToPortAssociationUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdatePortAssociationOpts contains the values used when updating a port
+// association. Routes replaces all the routes of the association.
+type UpdatePortAssociationOpts struct {
+	Routes            *[]PortRoute `json:"routes,omitempty"`
+	AdvertiseFixedIPs *bool        `json:"advertise_fixed_ips,omitempty"`
+}
+
+// ToPortAssociationUpdateMap casts an UpdatePortAssociationOpts struct to a
+// map.
+func (opts UpdatePortAssociationOpts) ToPortAssociationUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "port_association")
+}
+
+// UpdatePortAssociation updates a port association of a BGP VPN.
+func UpdatePortAssociation(c *gophercloud.ServiceClient, bgpVpnID, id string, opts UpdatePortAssociationOptsBuilder) (r UpdatePortAssociationResult) {
+	b, err := opts.ToPortAssociationUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(portAssociationURL(c, bgpVpnID, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeletePortAssociation removes a port association from a BGP VPN.
+func DeletePortAssociation(c *gophercloud.ServiceClient, bgpVpnID, id string) (r DeletePortAssociationResult) {
+	resp, err := c.Delete(portAssociationURL(c, bgpVpnID, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
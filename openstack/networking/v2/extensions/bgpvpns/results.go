@@ -0,0 +1,429 @@
+package bgpvpns
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// BGPVPN represents a BGP VPN, a set of route targets and distinguishers
+// interconnecting the networks, routers and ports associated with it.
+type BGPVPN struct {
+	// ID is the unique ID of the BGP VPN.
+	ID string `json:"id"`
+
+	// TenantID is the ID of the tenant owning the BGP VPN.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the ID of the project owning the BGP VPN.
+	ProjectID string `json:"project_id"`
+
+	// Name is the human readable name of the BGP VPN.
+	Name string `json:"name"`
+
+	// Type is the type of the BGP VPN, either l2 or l3.
+	Type Type `json:"type"`
+
+	// RouteDistinguishers are the route distinguishers advertised for the
+	// BGP VPN.
+	RouteDistinguishers []string `json:"route_distinguishers"`
+
+	// RouteTargets are the route targets imported and exported by the
+	// BGP VPN.
+	RouteTargets []string `json:"route_targets"`
+
+	// ImportTargets are the additional route targets imported by the BGP VPN.
+	ImportTargets []string `json:"import_targets"`
+
+	// ExportTargets are the additional route targets exported by the BGP VPN.
+	ExportTargets []string `json:"export_targets"`
+
+	// LocalPref is the default BGP LOCAL_PREF of the routes advertised.
+	LocalPref *int `json:"local_pref"`
+
+	// VNI is the globally assigned VXLAN network identifier.
+	VNI int `json:"vni"`
+
+	// Networks are the IDs of the networks associated with the BGP VPN.
+	Networks []string `json:"networks"`
+
+	// Routers are the IDs of the routers associated with the BGP VPN.
+	Routers []string `json:"routers"`
+
+	// Ports are the IDs of the ports associated with the BGP VPN.
+	Ports []string `json:"ports"`
+}
+
+// NetworkAssociation represents the association of a network with a BGP VPN.
+type NetworkAssociation struct {
+	// ID is the unique ID of the association.
+	ID string `json:"id"`
+
+	// NetworkID is the ID of the associated network.
+	NetworkID string `json:"network_id"`
+
+	// TenantID is the ID of the tenant owning the association.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the ID of the project owning the association.
+	ProjectID string `json:"project_id"`
+}
+
+// RouterAssociation represents the association of a router with a BGP VPN.
+type RouterAssociation struct {
+	// ID is the unique ID of the association.
+	ID string `json:"id"`
+
+	// RouterID is the ID of the associated router.
+	RouterID string `json:"router_id"`
+
+	// TenantID is the ID of the tenant owning the association.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the ID of the project owning the association.
+	ProjectID string `json:"project_id"`
+
+	// AdvertiseExtraRoutes reports whether the extra routes of the router are
+	// advertised in the BGP VPN.
+	AdvertiseExtraRoutes bool `json:"advertise_extra_routes"`
+}
+
+// PortAssociation represents the association of a port with a BGP VPN.
+type PortAssociation struct {
+	// ID is the unique ID of the association.
+	ID string `json:"id"`
+
+	// PortID is the ID of the associated port.
+	PortID string `json:"port_id"`
+
+	// TenantID is the ID of the tenant owning the association.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the ID of the project owning the association.
+	ProjectID string `json:"project_id"`
+
+	// Routes are the routes advertised in the BGP VPN on behalf of the port.
+	Routes []PortRoute `json:"routes"`
+
+	// AdvertiseFixedIPs reports whether the fixed IPs of the port are
+	// advertised in the BGP VPN.
+	AdvertiseFixedIPs bool `json:"advertise_fixed_ips"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a BGP VPN.
+func (r commonResult) Extract() (*BGPVPN, error) {
+	var s struct {
+		BGPVPN *BGPVPN `json:"bgpvpn"`
+	}
+	err := r.ExtractInto(&s)
+	return s.BGPVPN, err
+}
+
+// BGPVPNPage is the page returned by a pager when traversing over a
+// collection of BGP VPNs.
+type BGPVPNPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of BGP VPNs has reached
+// the end of a page and the pager seeks to traverse over a new one. In order
+// to do this, it needs to construct the next page's URL.
+func (r BGPVPNPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"bgpvpns_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a BGPVPNPage struct is empty.
+func (r BGPVPNPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractBGPVPNs(r)
+	return len(is) == 0, err
+}
+
+// ExtractBGPVPNs accepts a Page struct, specifically a BGPVPNPage struct, and
+// extracts the elements into a slice of BGPVPN structs. In other words, a
+// generic collection is mapped into a relevant slice.
+func ExtractBGPVPNs(r pagination.Page) ([]BGPVPN, error) {
+	var s struct {
+		BGPVPNs []BGPVPN `json:"bgpvpns"`
+	}
+	err := (r.(BGPVPNPage)).ExtractInto(&s)
+	return s.BGPVPNs, err
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a BGPVPN.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a BGPVPN.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult represents the results of a Delete operation. Call its
+// ExtractErr method to determine whether the operation succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a BGPVPN.
+type UpdateResult struct {
+	commonResult
+}
+
+type commonNetworkAssociationResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a network
+// association.
+func (r commonNetworkAssociationResult) Extract() (*NetworkAssociation, error) {
+	var s struct {
+		NetworkAssociation *NetworkAssociation `json:"network_association"`
+	}
+	err := r.ExtractInto(&s)
+	return s.NetworkAssociation, err
+}
+
+// NetworkAssociationPage is the page returned by a pager when traversing over
+// a collection of network associations.
+type NetworkAssociationPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of network associations
+// has reached the end of a page and the pager seeks to traverse over a new
+// one. In order to do this, it needs to construct the next page's URL.
+func (r NetworkAssociationPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"network_associations_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a NetworkAssociationPage struct is empty.
+func (r NetworkAssociationPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractNetworkAssociations(r)
+	return len(is) == 0, err
+}
+
+// ExtractNetworkAssociations accepts a Page struct, specifically a
+// NetworkAssociationPage struct, and extracts the elements into a slice of
+// NetworkAssociation structs.
+func ExtractNetworkAssociations(r pagination.Page) ([]NetworkAssociation, error) {
+	var s struct {
+		NetworkAssociations []NetworkAssociation `json:"network_associations"`
+	}
+	err := (r.(NetworkAssociationPage)).ExtractInto(&s)
+	return s.NetworkAssociations, err
+}
+
+// CreateNetworkAssociationResult represents the result of a create network
+// association operation. Call its Extract method to interpret it as a
+// NetworkAssociation.
+type CreateNetworkAssociationResult struct {
+	commonNetworkAssociationResult
+}
+
+// GetNetworkAssociationResult represents the result of a get network
+// association operation. Call its Extract method to interpret it as a
+// NetworkAssociation.
+type GetNetworkAssociationResult struct {
+	commonNetworkAssociationResult
+}
+
+// DeleteNetworkAssociationResult represents the result of a delete network
+// association operation. Call its ExtractErr method to determine whether the
+// operation succeeded or failed.
+type DeleteNetworkAssociationResult struct {
+	gophercloud.ErrResult
+}
+
+type commonRouterAssociationResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a router
+// association.
+func (r commonRouterAssociationResult) Extract() (*RouterAssociation, error) {
+	var s struct {
+		RouterAssociation *RouterAssociation `json:"router_association"`
+	}
+	err := r.ExtractInto(&s)
+	return s.RouterAssociation, err
+}
+
+// RouterAssociationPage is the page returned by a pager when traversing over
+// a collection of router associations.
+type RouterAssociationPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of router associations
+// has reached the end of a page and the pager seeks to traverse over a new
+// one. In order to do this, it needs to construct the next page's URL.
+func (r RouterAssociationPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"router_associations_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a RouterAssociationPage struct is empty.
+func (r RouterAssociationPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractRouterAssociations(r)
+	return len(is) == 0, err
+}
+
+// ExtractRouterAssociations accepts a Page struct, specifically a
+// RouterAssociationPage struct, and extracts the elements into a slice of
+// RouterAssociation structs.
+func ExtractRouterAssociations(r pagination.Page) ([]RouterAssociation, error) {
+	var s struct {
+		RouterAssociations []RouterAssociation `json:"router_associations"`
+	}
+	err := (r.(RouterAssociationPage)).ExtractInto(&s)
+	return s.RouterAssociations, err
+}
+
+// CreateRouterAssociationResult represents the result of a create router
+// association operation. Call its Extract method to interpret it as a
+// RouterAssociation.
+type CreateRouterAssociationResult struct {
+	commonRouterAssociationResult
+}
+
+// GetRouterAssociationResult represents the result of a get router
+// association operation. Call its Extract method to interpret it as a
+// RouterAssociation.
+type GetRouterAssociationResult struct {
+	commonRouterAssociationResult
+}
+
+// UpdateRouterAssociationResult represents the result of an update router
+// association operation. Call its Extract method to interpret it as a
+// RouterAssociation.
+type UpdateRouterAssociationResult struct {
+	commonRouterAssociationResult
+}
+
+// DeleteRouterAssociationResult represents the result of a delete router
+// association operation. Call its ExtractErr method to determine whether the
+// operation succeeded or failed.
+type DeleteRouterAssociationResult struct {
+	gophercloud.ErrResult
+}
+
+type commonPortAssociationResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a port
+// association.
+func (r commonPortAssociationResult) Extract() (*PortAssociation, error) {
+	var s struct {
+		PortAssociation *PortAssociation `json:"port_association"`
+	}
+	err := r.ExtractInto(&s)
+	return s.PortAssociation, err
+}
+
+// PortAssociationPage is the page returned by a pager when traversing over a
+// collection of port associations.
+type PortAssociationPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of port associations has
+// reached the end of a page and the pager seeks to traverse over a new one.
+// In order to do this, it needs to construct the next page's URL.
+func (r PortAssociationPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"port_associations_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a PortAssociationPage struct is empty.
+func (r PortAssociationPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractPortAssociations(r)
+	return len(is) == 0, err
+}
+
+// ExtractPortAssociations accepts a Page struct, specifically a
+// PortAssociationPage struct, and extracts the elements into a slice of
+// PortAssociation structs.
+func ExtractPortAssociations(r pagination.Page) ([]PortAssociation, error) {
+	var s struct {
+		PortAssociations []PortAssociation `json:"port_associations"`
+	}
+	err := (r.(PortAssociationPage)).ExtractInto(&s)
+	return s.PortAssociations, err
+}
+
+// CreatePortAssociationResult represents the result of a create port
+// association operation. Call its Extract method to interpret it as a
+// PortAssociation.
+type CreatePortAssociationResult struct {
+	commonPortAssociationResult
+}
+
+// GetPortAssociationResult represents the result of a get port association
+// operation. Call its Extract method to interpret it as a PortAssociation.
+type GetPortAssociationResult struct {
+	commonPortAssociationResult
+}
+
+// UpdatePortAssociationResult represents the result of an update port
+// association operation. Call its Extract method to interpret it as a
+// PortAssociation.
+type UpdatePortAssociationResult struct {
+	commonPortAssociationResult
+}
+
+// DeletePortAssociationResult represents the result of a delete port
+// association operation. Call its ExtractErr method to determine whether the
+// operation succeeded or failed.
+type DeletePortAssociationResult struct {
+	gophercloud.ErrResult
+}
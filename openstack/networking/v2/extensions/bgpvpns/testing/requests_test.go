@@ -0,0 +1,501 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/bgpvpns"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+const bgpVpnID = "460ac411-3dfb-45bb-8116-ed1a7233d143"
+
+const bgpVpnResult = `
+{
+    "bgpvpn": {
+        "id": "460ac411-3dfb-45bb-8116-ed1a7233d143",
+        "tenant_id": "b7549121395844bea941bb92feb3fad9",
+        "project_id": "b7549121395844bea941bb92feb3fad9",
+        "name": "vpn1",
+        "type": "l3",
+        "route_distinguishers": [],
+        "route_targets": ["64512:1444"],
+        "import_targets": [],
+        "export_targets": [],
+        "local_pref": null,
+        "vni": 1000,
+        "networks": [],
+        "routers": [],
+        "ports": []
+    }
+}
+`
+
+var bgpVpn = bgpvpns.BGPVPN{
+	ID:                  "460ac411-3dfb-45bb-8116-ed1a7233d143",
+	TenantID:            "b7549121395844bea941bb92feb3fad9",
+	ProjectID:           "b7549121395844bea941bb92feb3fad9",
+	Name:                "vpn1",
+	Type:                bgpvpns.TypeL3,
+	RouteDistinguishers: []string{},
+	RouteTargets:        []string{"64512:1444"},
+	ImportTargets:       []string{},
+	ExportTargets:       []string{},
+	VNI:                 1000,
+	Networks:            []string{},
+	Routers:             []string{},
+	Ports:               []string{},
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/bgpvpn/bgpvpns", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "bgpvpn": {
+        "name": "vpn1",
+        "route_targets": ["64512:1444"],
+        "vni": 1000
+    }
+}
+        `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, bgpVpnResult)
+	})
+
+	options := bgpvpns.CreateOpts{
+		Name:         "vpn1",
+		RouteTargets: []string{"64512:1444"},
+		VNI:          1000,
+	}
+	actual, err := bgpvpns.Create(fake.ServiceClient(), options).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, bgpVpn, *actual)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/bgpvpn/bgpvpns/"+bgpVpnID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, bgpVpnResult)
+	})
+
+	actual, err := bgpvpns.Get(fake.ServiceClient(), bgpVpnID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, bgpVpn, *actual)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/bgpvpn/bgpvpns", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"type": "l3"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `
+{
+    "bgpvpns": [
+        {
+            "id": "460ac411-3dfb-45bb-8116-ed1a7233d143",
+            "tenant_id": "b7549121395844bea941bb92feb3fad9",
+            "project_id": "b7549121395844bea941bb92feb3fad9",
+            "name": "vpn1",
+            "type": "l3",
+            "route_distinguishers": [],
+            "route_targets": ["64512:1444"],
+            "import_targets": [],
+            "export_targets": [],
+            "local_pref": null,
+            "vni": 1000,
+            "networks": [],
+            "routers": [],
+            "ports": []
+        }
+    ]
+}
+        `)
+	})
+
+	count := 0
+	err := bgpvpns.List(fake.ServiceClient(), bgpvpns.ListOpts{Type: bgpvpns.TypeL3}).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := bgpvpns.ExtractBGPVPNs(page)
+		if err != nil {
+			t.Errorf("Failed to extract BGP VPNs: %v", err)
+			return false, err
+		}
+		th.CheckDeepEquals(t, []bgpvpns.BGPVPN{bgpVpn}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/bgpvpn/bgpvpns/"+bgpVpnID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+    "bgpvpn": {
+        "name": "vpn2",
+        "local_pref": 200,
+        "import_targets": []
+    }
+}
+        `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `
+{
+    "bgpvpn": {
+        "id": "460ac411-3dfb-45bb-8116-ed1a7233d143",
+        "name": "vpn2",
+        "type": "l3",
+        "local_pref": 200
+    }
+}
+        `)
+	})
+
+	name := "vpn2"
+	localPref := 200
+	importTargets := []string{}
+	updateOpts := bgpvpns.UpdateOpts{
+		Name:          &name,
+		LocalPref:     &localPref,
+		ImportTargets: &importTargets,
+	}
+	actual, err := bgpvpns.Update(fake.ServiceClient(), bgpVpnID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "vpn2", actual.Name)
+	th.AssertEquals(t, 200, *actual.LocalPref)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/bgpvpn/bgpvpns/"+bgpVpnID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := bgpvpns.Delete(fake.ServiceClient(), bgpVpnID)
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestNetworkAssociations(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/bgpvpn/bgpvpns/"+bgpVpnID+"/network_associations", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+
+		switch r.Method {
+		case "POST":
+			th.TestJSONRequest(t, r, `
+{
+    "network_association": {
+        "network_id": "8c5d88dc-60ac-4b02-a65a-36b65888ddcd"
+    }
+}
+            `)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `
+{
+    "network_association": {
+        "id": "73238ca1-e05d-4c7a-b4d4-70407b4b8730",
+        "network_id": "8c5d88dc-60ac-4b02-a65a-36b65888ddcd",
+        "tenant_id": "b7549121395844bea941bb92feb3fad9",
+        "project_id": "b7549121395844bea941bb92feb3fad9"
+    }
+}
+            `)
+		case "GET":
+			th.TestFormValues(t, r, map[string]string{"network_id": "8c5d88dc-60ac-4b02-a65a-36b65888ddcd"})
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, `
+{
+    "network_associations": [
+        {
+            "id": "73238ca1-e05d-4c7a-b4d4-70407b4b8730",
+            "network_id": "8c5d88dc-60ac-4b02-a65a-36b65888ddcd",
+            "tenant_id": "b7549121395844bea941bb92feb3fad9",
+            "project_id": "b7549121395844bea941bb92feb3fad9"
+        }
+    ]
+}
+            `)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	th.Mux.HandleFunc("/v2.0/bgpvpn/bgpvpns/"+bgpVpnID+"/network_associations/73238ca1-e05d-4c7a-b4d4-70407b4b8730", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	expected := bgpvpns.NetworkAssociation{
+		ID:        "73238ca1-e05d-4c7a-b4d4-70407b4b8730",
+		NetworkID: "8c5d88dc-60ac-4b02-a65a-36b65888ddcd",
+		TenantID:  "b7549121395844bea941bb92feb3fad9",
+		ProjectID: "b7549121395844bea941bb92feb3fad9",
+	}
+
+	createOpts := bgpvpns.CreateNetworkAssociationOpts{
+		NetworkID: "8c5d88dc-60ac-4b02-a65a-36b65888ddcd",
+	}
+	actual, err := bgpvpns.CreateNetworkAssociation(fake.ServiceClient(), bgpVpnID, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, expected, *actual)
+
+	listOpts := bgpvpns.ListAssociationsOpts{
+		NetworkID: "8c5d88dc-60ac-4b02-a65a-36b65888ddcd",
+	}
+	allPages, err := bgpvpns.ListNetworkAssociations(fake.ServiceClient(), bgpVpnID, listOpts).AllPages()
+	th.AssertNoErr(t, err)
+	all, err := bgpvpns.ExtractNetworkAssociations(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []bgpvpns.NetworkAssociation{expected}, all)
+
+	err = bgpvpns.DeleteNetworkAssociation(fake.ServiceClient(), bgpVpnID, expected.ID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestRouterAssociations(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/bgpvpn/bgpvpns/"+bgpVpnID+"/router_associations", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+    "router_association": {
+        "router_id": "b0b7ff1b-8a41-4ba1-9cbf-c3c2d2d2e64f",
+        "advertise_extra_routes": true
+    }
+}
+        `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, `
+{
+    "router_association": {
+        "id": "95b1a4a4-c69b-4e1d-a7c3-1e0a7a3a7bbf",
+        "router_id": "b0b7ff1b-8a41-4ba1-9cbf-c3c2d2d2e64f",
+        "advertise_extra_routes": true
+    }
+}
+        `)
+	})
+
+	th.Mux.HandleFunc("/v2.0/bgpvpn/bgpvpns/"+bgpVpnID+"/router_associations/95b1a4a4-c69b-4e1d-a7c3-1e0a7a3a7bbf", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+    "router_association": {
+        "advertise_extra_routes": false
+    }
+}
+        `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `
+{
+    "router_association": {
+        "id": "95b1a4a4-c69b-4e1d-a7c3-1e0a7a3a7bbf",
+        "router_id": "b0b7ff1b-8a41-4ba1-9cbf-c3c2d2d2e64f",
+        "advertise_extra_routes": false
+    }
+}
+        `)
+	})
+
+	advertiseExtraRoutes := true
+	createOpts := bgpvpns.CreateRouterAssociationOpts{
+		RouterID:             "b0b7ff1b-8a41-4ba1-9cbf-c3c2d2d2e64f",
+		AdvertiseExtraRoutes: &advertiseExtraRoutes,
+	}
+	created, err := bgpvpns.CreateRouterAssociation(fake.ServiceClient(), bgpVpnID, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, created.AdvertiseExtraRoutes)
+
+	advertiseExtraRoutes = false
+	updateOpts := bgpvpns.UpdateRouterAssociationOpts{
+		AdvertiseExtraRoutes: &advertiseExtraRoutes,
+	}
+	updated, err := bgpvpns.UpdateRouterAssociation(fake.ServiceClient(), bgpVpnID, created.ID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, false, updated.AdvertiseExtraRoutes)
+}
+
+func TestPortAssociations(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/bgpvpn/bgpvpns/"+bgpVpnID+"/port_associations", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+    "port_association": {
+        "port_id": "5e2f4a8f-53de-4a70-a2fa-3b7b8ab7ee7c",
+        "advertise_fixed_ips": false,
+        "routes": [
+            {
+                "type": "prefix",
+                "prefix": "10.10.0.0/24",
+                "local_pref": 100
+            },
+            {
+                "type": "bgpvpn",
+                "bgpvpn_id": "f3c4b7ef-7d6b-4b53-84c4-2cda9e6e3e7e"
+            }
+        ]
+    }
+}
+        `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, `
+{
+    "port_association": {
+        "id": "a4c1e6b0-1d8d-4b3b-9d7e-5b8f8d0c9a3e",
+        "port_id": "5e2f4a8f-53de-4a70-a2fa-3b7b8ab7ee7c",
+        "tenant_id": "b7549121395844bea941bb92feb3fad9",
+        "project_id": "b7549121395844bea941bb92feb3fad9",
+        "advertise_fixed_ips": false,
+        "routes": [
+            {
+                "type": "prefix",
+                "prefix": "10.10.0.0/24",
+                "local_pref": 100
+            },
+            {
+                "type": "bgpvpn",
+                "bgpvpn_id": "f3c4b7ef-7d6b-4b53-84c4-2cda9e6e3e7e"
+            }
+        ]
+    }
+}
+        `)
+	})
+
+	th.Mux.HandleFunc("/v2.0/bgpvpn/bgpvpns/"+bgpVpnID+"/port_associations/a4c1e6b0-1d8d-4b3b-9d7e-5b8f8d0c9a3e", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+    "port_association": {
+        "routes": []
+    }
+}
+        `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `
+{
+    "port_association": {
+        "id": "a4c1e6b0-1d8d-4b3b-9d7e-5b8f8d0c9a3e",
+        "port_id": "5e2f4a8f-53de-4a70-a2fa-3b7b8ab7ee7c",
+        "advertise_fixed_ips": false,
+        "routes": []
+    }
+}
+        `)
+	})
+
+	localPref := 100
+	advertiseFixedIPs := false
+	createOpts := bgpvpns.CreatePortAssociationOpts{
+		PortID:            "5e2f4a8f-53de-4a70-a2fa-3b7b8ab7ee7c",
+		AdvertiseFixedIPs: &advertiseFixedIPs,
+		Routes: []bgpvpns.PortRoute{
+			{
+				Type:      bgpvpns.RouteTypePrefix,
+				Prefix:    "10.10.0.0/24",
+				LocalPref: &localPref,
+			},
+			{
+				Type:     bgpvpns.RouteTypeBGPVPN,
+				BGPVPNID: "f3c4b7ef-7d6b-4b53-84c4-2cda9e6e3e7e",
+			},
+		},
+	}
+	created, err := bgpvpns.CreatePortAssociation(fake.ServiceClient(), bgpVpnID, createOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := bgpvpns.PortAssociation{
+		ID:        "a4c1e6b0-1d8d-4b3b-9d7e-5b8f8d0c9a3e",
+		PortID:    "5e2f4a8f-53de-4a70-a2fa-3b7b8ab7ee7c",
+		TenantID:  "b7549121395844bea941bb92feb3fad9",
+		ProjectID: "b7549121395844bea941bb92feb3fad9",
+		Routes:    createOpts.Routes,
+	}
+	th.AssertDeepEquals(t, expected, *created)
+
+	routes := []bgpvpns.PortRoute{}
+	updateOpts := bgpvpns.UpdatePortAssociationOpts{
+		Routes: &routes,
+	}
+	updated, err := bgpvpns.UpdatePortAssociation(fake.ServiceClient(), bgpVpnID, created.ID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, len(updated.Routes))
+}
+
+func TestCreatePortAssociationRequiresRouteType(t *testing.T) {
+	createOpts := bgpvpns.CreatePortAssociationOpts{
+		PortID: "5e2f4a8f-53de-4a70-a2fa-3b7b8ab7ee7c",
+		Routes: []bgpvpns.PortRoute{
+			{Prefix: "10.10.0.0/24"},
+		},
+	}
+	_, err := createOpts.ToPortAssociationCreateMap()
+	if err == nil {
+		t.Fatal("Expected an error for a route without a type")
+	}
+}
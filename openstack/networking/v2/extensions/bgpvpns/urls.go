@@ -0,0 +1,44 @@
+package bgpvpns
+
+import "github.com/gophercloud/gophercloud"
+
+const (
+	rootPath     = "bgpvpn"
+	resourcePath = "bgpvpns"
+
+	networkAssociationsPath = "network_associations"
+	routerAssociationsPath  = "router_associations"
+	portAssociationsPath    = "port_associations"
+)
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath, resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, resourcePath, id)
+}
+
+func networkAssociationsURL(c *gophercloud.ServiceClient, bgpVpnID string) string {
+	return c.ServiceURL(rootPath, resourcePath, bgpVpnID, networkAssociationsPath)
+}
+
+func networkAssociationURL(c *gophercloud.ServiceClient, bgpVpnID, id string) string {
+	return c.ServiceURL(rootPath, resourcePath, bgpVpnID, networkAssociationsPath, id)
+}
+
+func routerAssociationsURL(c *gophercloud.ServiceClient, bgpVpnID string) string {
+	return c.ServiceURL(rootPath, resourcePath, bgpVpnID, routerAssociationsPath)
+}
+
+func routerAssociationURL(c *gophercloud.ServiceClient, bgpVpnID, id string) string {
+	return c.ServiceURL(rootPath, resourcePath, bgpVpnID, routerAssociationsPath, id)
+}
+
+func portAssociationsURL(c *gophercloud.ServiceClient, bgpVpnID string) string {
+	return c.ServiceURL(rootPath, resourcePath, bgpVpnID, portAssociationsPath)
+}
+
+func portAssociationURL(c *gophercloud.ServiceClient, bgpVpnID, id string) string {
+	return c.ServiceURL(rootPath, resourcePath, bgpVpnID, portAssociationsPath, id)
+}
@@ -0,0 +1,65 @@
+/*
+Package conntrackhelpers enables management and retrieval of the conntrack
+helpers of a router through the Neutron API. A conntrack helper enables the
+connection tracking of a protocol, such as FTP or TFTP, which negotiates
+related connections on other ports.
+
+Example to List the Conntrack Helpers of a Router
+
+	routerID := "0f6bd8a8-f9f8-4d9c-8d6d-1c1f2c0d6b4e"
+
+	allPages, err := conntrackhelpers.List(networkClient, routerID, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allHelpers, err := conntrackhelpers.ExtractConntrackHelpers(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, helper := range allHelpers {
+		fmt.Printf("%+v\n", helper)
+	}
+
+Example to Create a Conntrack Helper
+
+	routerID := "0f6bd8a8-f9f8-4d9c-8d6d-1c1f2c0d6b4e"
+
+	createOpts := conntrackhelpers.CreateOpts{
+		Protocol: "tcp",
+		Port:     21,
+		Helper:   "ftp",
+	}
+
+	helper, err := conntrackhelpers.Create(networkClient, routerID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Conntrack Helper
+
+	routerID := "0f6bd8a8-f9f8-4d9c-8d6d-1c1f2c0d6b4e"
+	helperID := "6a9a4b1c-8f2e-4f0b-a8c5-2c4b5d0f8e91"
+
+	port := 2121
+	updateOpts := conntrackhelpers.UpdateOpts{
+		Port: &port,
+	}
+
+	helper, err := conntrackhelpers.Update(networkClient, routerID, helperID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Conntrack Helper
+
+	routerID := "0f6bd8a8-f9f8-4d9c-8d6d-1c1f2c0d6b4e"
+	helperID := "6a9a4b1c-8f2e-4f0b-a8c5-2c4b5d0f8e91"
+
+	err := conntrackhelpers.Delete(networkClient, routerID, helperID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package conntrackhelpers
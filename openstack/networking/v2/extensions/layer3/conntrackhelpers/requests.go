@@ -0,0 +1,143 @@
+package conntrackhelpers
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToConntrackHelperListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the conntrack helper attributes you want to see returned. SortKey allows you
+// to sort by a particular conntrack helper attribute. SortDir sets the
+// direction, and is either `asc' or `desc'. Marker and Limit are used for
+// pagination.
+type ListOpts struct {
+	ID       string `q:"id"`
+	Protocol string `q:"protocol"`
+	Port     int    `q:"port"`
+	Helper   string `q:"helper"`
+	Marker   string `q:"marker"`
+	Limit    int    `q:"limit"`
+	SortKey  string `q:"sort_key"`
+	SortDir  string `q:"sort_dir"`
+}
+
+// ToConntrackHelperListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToConntrackHelperListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over the conntrack helpers
+// of a router. It accepts a ListOpts struct, which allows you to filter and
+// sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, routerID string, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c, routerID)
+	if opts != nil {
+		query, err := opts.ToConntrackHelperListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return ConntrackHelperPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific conntrack helper of a router based on its unique
+// ID.
+func Get(c *gophercloud.ServiceClient, routerID, id string) (r GetResult) {
+	resp, err := c.Get(getURL(c, routerID, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToConntrackHelperCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts represents options used to create a conntrack helper.
+type CreateOpts struct {
+	// Protocol is the network protocol of the traffic, such as "tcp" or "udp".
+	Protocol string `json:"protocol" required:"true"`
+
+	// Port is the network port of the traffic.
+	Port int `json:"port" required:"true"`
+
+	// Helper is the name of the netfilter conntrack helper module, such as
+	// "ftp" or "tftp".
+	Helper string `json:"helper" required:"true"`
+}
+
+// ToConntrackHelperCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToConntrackHelperCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "conntrack_helper")
+}
+
+// Create accepts a CreateOpts struct and creates a new conntrack helper on a
+// router using the values provided.
+func Create(c *gophercloud.ServiceClient, routerID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToConntrackHelperCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(createURL(c, routerID), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToConntrackHelperUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts represents options used to update a conntrack helper.
+type UpdateOpts struct {
+	// Protocol is the network protocol of the traffic.
+	Protocol string `json:"protocol,omitempty"`
+
+	// Port is the network port of the traffic.
+	Port *int `json:"port,omitempty"`
+
+	// Helper is the name of the netfilter conntrack helper module.
+	Helper string `json:"helper,omitempty"`
+}
+
+// ToConntrackHelperUpdateMap builds a request body from UpdateOpts.
+func (opts UpdateOpts) ToConntrackHelperUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "conntrack_helper")
+}
+
+// Update accepts a UpdateOpts struct and updates an existing conntrack helper
+// of a router using the values provided.
+func Update(c *gophercloud.ServiceClient, routerID, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToConntrackHelperUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(updateURL(c, routerID, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete accepts a unique ID and deletes the conntrack helper of a router
+// associated with it.
+func Delete(c *gophercloud.ServiceClient, routerID, id string) (r DeleteResult) {
+	resp, err := c.Delete(deleteURL(c, routerID, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,87 @@
+package testing
+
+import (
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/conntrackhelpers"
+)
+
+// ConntrackHelpersListResult represents raw response for the List request.
+const ConntrackHelpersListResult = `
+{
+    "conntrack_helpers": [
+        {
+            "id": "6a9a4b1c-8f2e-4f0b-a8c5-2c4b5d0f8e91",
+            "protocol": "tcp",
+            "port": 21,
+            "helper": "ftp"
+        },
+        {
+            "id": "b3c2f7d1-5a3e-4c8d-9e6f-7a1b2c3d4e5f",
+            "protocol": "udp",
+            "port": 69,
+            "helper": "tftp"
+        }
+    ]
+}
+`
+
+// ConntrackHelper1 is the first conntrack helper of
+// ConntrackHelpersListResult.
+var ConntrackHelper1 = conntrackhelpers.ConntrackHelper{
+	ID:       "6a9a4b1c-8f2e-4f0b-a8c5-2c4b5d0f8e91",
+	Protocol: "tcp",
+	Port:     21,
+	Helper:   "ftp",
+}
+
+// ConntrackHelper2 is the second conntrack helper of
+// ConntrackHelpersListResult.
+var ConntrackHelper2 = conntrackhelpers.ConntrackHelper{
+	ID:       "b3c2f7d1-5a3e-4c8d-9e6f-7a1b2c3d4e5f",
+	Protocol: "udp",
+	Port:     69,
+	Helper:   "tftp",
+}
+
+// ConntrackHelperGetResult represents raw response for the Get request.
+const ConntrackHelperGetResult = `
+{
+    "conntrack_helper": {
+        "id": "6a9a4b1c-8f2e-4f0b-a8c5-2c4b5d0f8e91",
+        "protocol": "tcp",
+        "port": 21,
+        "helper": "ftp"
+    }
+}
+`
+
+// ConntrackHelperCreateRequest represents raw request for the Create request.
+const ConntrackHelperCreateRequest = `
+{
+    "conntrack_helper": {
+        "protocol": "tcp",
+        "port": 21,
+        "helper": "ftp"
+    }
+}
+`
+
+// ConntrackHelperUpdateRequest represents raw request for the Update request.
+const ConntrackHelperUpdateRequest = `
+{
+    "conntrack_helper": {
+        "port": 2121
+    }
+}
+`
+
+// ConntrackHelperUpdateResult represents raw response for the Update request.
+const ConntrackHelperUpdateResult = `
+{
+    "conntrack_helper": {
+        "id": "6a9a4b1c-8f2e-4f0b-a8c5-2c4b5d0f8e91",
+        "protocol": "tcp",
+        "port": 2121,
+        "helper": "ftp"
+    }
+}
+`
@@ -0,0 +1,175 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/conntrackhelpers"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/routers/0f6bd8a8-f9f8-4d9c-8d6d-1c1f2c0d6b4e/conntrack_helpers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, ConntrackHelpersListResult)
+	})
+
+	count := 0
+
+	err := conntrackhelpers.List(fake.ServiceClient(), "0f6bd8a8-f9f8-4d9c-8d6d-1c1f2c0d6b4e", nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := conntrackhelpers.ExtractConntrackHelpers(page)
+		if err != nil {
+			t.Errorf("Failed to extract conntrack helpers: %v", err)
+			return false, nil
+		}
+
+		expected := []conntrackhelpers.ConntrackHelper{
+			ConntrackHelper1,
+			ConntrackHelper2,
+		}
+
+		th.CheckDeepEquals(t, expected, actual)
+
+		return true, nil
+	})
+
+	th.AssertNoErr(t, err)
+
+	if count != 1 {
+		t.Errorf("Expected 1 page, got %d", count)
+	}
+}
+
+func TestListWithOpts(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/routers/0f6bd8a8-f9f8-4d9c-8d6d-1c1f2c0d6b4e/conntrack_helpers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"helper": "ftp",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, ConntrackHelpersListResult)
+	})
+
+	listOpts := conntrackhelpers.ListOpts{
+		Helper: "ftp",
+	}
+	_, err := conntrackhelpers.List(fake.ServiceClient(), "0f6bd8a8-f9f8-4d9c-8d6d-1c1f2c0d6b4e", listOpts).AllPages()
+	th.AssertNoErr(t, err)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/routers/0f6bd8a8-f9f8-4d9c-8d6d-1c1f2c0d6b4e/conntrack_helpers/6a9a4b1c-8f2e-4f0b-a8c5-2c4b5d0f8e91", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, ConntrackHelperGetResult)
+	})
+
+	h, err := conntrackhelpers.Get(fake.ServiceClient(), "0f6bd8a8-f9f8-4d9c-8d6d-1c1f2c0d6b4e", "6a9a4b1c-8f2e-4f0b-a8c5-2c4b5d0f8e91").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, ConntrackHelper1, *h)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/routers/0f6bd8a8-f9f8-4d9c-8d6d-1c1f2c0d6b4e/conntrack_helpers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, ConntrackHelperCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, ConntrackHelperGetResult)
+	})
+
+	createOpts := conntrackhelpers.CreateOpts{
+		Protocol: "tcp",
+		Port:     21,
+		Helper:   "ftp",
+	}
+
+	h, err := conntrackhelpers.Create(fake.ServiceClient(), "0f6bd8a8-f9f8-4d9c-8d6d-1c1f2c0d6b4e", createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, ConntrackHelper1, *h)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	res := conntrackhelpers.Create(fake.ServiceClient(), "0f6bd8a8-f9f8-4d9c-8d6d-1c1f2c0d6b4e", conntrackhelpers.CreateOpts{
+		Protocol: "tcp",
+		Port:     21,
+	})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/routers/0f6bd8a8-f9f8-4d9c-8d6d-1c1f2c0d6b4e/conntrack_helpers/6a9a4b1c-8f2e-4f0b-a8c5-2c4b5d0f8e91", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, ConntrackHelperUpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, ConntrackHelperUpdateResult)
+	})
+
+	port := 2121
+	updateOpts := conntrackhelpers.UpdateOpts{
+		Port: &port,
+	}
+
+	h, err := conntrackhelpers.Update(fake.ServiceClient(), "0f6bd8a8-f9f8-4d9c-8d6d-1c1f2c0d6b4e", "6a9a4b1c-8f2e-4f0b-a8c5-2c4b5d0f8e91", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2121, h.Port)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/routers/0f6bd8a8-f9f8-4d9c-8d6d-1c1f2c0d6b4e/conntrack_helpers/6a9a4b1c-8f2e-4f0b-a8c5-2c4b5d0f8e91", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := conntrackhelpers.Delete(fake.ServiceClient(), "0f6bd8a8-f9f8-4d9c-8d6d-1c1f2c0d6b4e", "6a9a4b1c-8f2e-4f0b-a8c5-2c4b5d0f8e91")
+	th.AssertNoErr(t, res.Err)
+}
@@ -0,0 +1,36 @@
+package conntrackhelpers
+
+import "github.com/gophercloud/gophercloud"
+
+const (
+	routerPath   = "routers"
+	resourcePath = "conntrack_helpers"
+)
+
+func rootURL(c *gophercloud.ServiceClient, routerID string) string {
+	return c.ServiceURL(routerPath, routerID, resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, routerID, id string) string {
+	return c.ServiceURL(routerPath, routerID, resourcePath, id)
+}
+
+func listURL(c *gophercloud.ServiceClient, routerID string) string {
+	return rootURL(c, routerID)
+}
+
+func getURL(c *gophercloud.ServiceClient, routerID, id string) string {
+	return resourceURL(c, routerID, id)
+}
+
+func createURL(c *gophercloud.ServiceClient, routerID string) string {
+	return rootURL(c, routerID)
+}
+
+func updateURL(c *gophercloud.ServiceClient, routerID, id string) string {
+	return resourceURL(c, routerID, id)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, routerID, id string) string {
+	return resourceURL(c, routerID, id)
+}
@@ -0,0 +1,105 @@
+/*
+Package localips provides information and interaction with Local IPs for the
+OpenStack Networking service.
+
+A Local IP is a virtual IP address which can be shared by many ports, and
+which is only reachable from within the host of each port. Ports are bound to
+a Local IP through port associations.
+
+Example to List Local IPs
+
+	listOpts := localips.ListOpts{
+		NetworkID: "2e32b1ec-2d2c-4a7f-9f36-c6a6c8ec1d6b",
+	}
+
+	allPages, err := localips.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allLocalIPs, err := localips.ExtractLocalIPs(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, localIP := range allLocalIPs {
+		fmt.Printf("%+v\n", localIP)
+	}
+
+Example to Create a Local IP
+
+	createOpts := localips.CreateOpts{
+		Name:      "dns-cache",
+		NetworkID: "2e32b1ec-2d2c-4a7f-9f36-c6a6c8ec1d6b",
+		IPMode:    localips.IPModeTranslate,
+	}
+
+	localIP, err := localips.Create(networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Local IP
+
+	localIPID := "5c17bdb4-7b5e-4f4f-8d3c-8d6c0f0a3a12"
+
+	description := "Node local DNS cache"
+	updateOpts := localips.UpdateOpts{
+		Description: &description,
+	}
+
+	localIP, err := localips.Update(networkClient, localIPID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Local IP
+
+	localIPID := "5c17bdb4-7b5e-4f4f-8d3c-8d6c0f0a3a12"
+	err := localips.Delete(networkClient, localIPID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Associate a Port with a Local IP
+
+	localIPID := "5c17bdb4-7b5e-4f4f-8d3c-8d6c0f0a3a12"
+
+	createOpts := localips.CreatePortAssociationOpts{
+		FixedPortID: "a0a1b2c3-1f6e-4a3e-9b1e-5a1d8a2e6f4c",
+	}
+
+	association, err := localips.CreatePortAssociation(networkClient, localIPID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List the Port Associations of a Local IP
+
+	localIPID := "5c17bdb4-7b5e-4f4f-8d3c-8d6c0f0a3a12"
+
+	allPages, err := localips.ListPortAssociations(networkClient, localIPID, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allAssociations, err := localips.ExtractPortAssociations(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, association := range allAssociations {
+		fmt.Printf("%+v\n", association)
+	}
+
+Example to Disassociate a Port from a Local IP
+
+	localIPID := "5c17bdb4-7b5e-4f4f-8d3c-8d6c0f0a3a12"
+	portID := "a0a1b2c3-1f6e-4a3e-9b1e-5a1d8a2e6f4c"
+
+	err := localips.DeletePortAssociation(networkClient, localIPID, portID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package localips
@@ -0,0 +1,279 @@
+package localips
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// IPMode is the mode of a Local IP.
+type IPMode string
+
+const (
+	// IPModeTranslate translates the destination address of the traffic to
+	// the Local IP into the fixed IP address of the associated port.
+	IPModeTranslate IPMode = "translate"
+
+	// IPModePassthrough delivers the traffic to the associated port without
+	// translation.
+	IPModePassthrough IPMode = "passthrough"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToLocalIPListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the Local IP attributes you want to see returned. SortKey allows you to sort
+// by a particular Local IP attribute. SortDir sets the direction, and is
+// either `asc' or `desc'. Marker and Limit are used for pagination.
+type ListOpts struct {
+	ID             string `q:"id"`
+	Name           string `q:"name"`
+	Description    string `q:"description"`
+	ProjectID      string `q:"project_id"`
+	LocalPortID    string `q:"local_port_id"`
+	NetworkID      string `q:"network_id"`
+	LocalIPAddress string `q:"local_ip_address"`
+	IPMode         IPMode `q:"ip_mode"`
+	RevisionNumber *int   `q:"revision_number"`
+	Marker         string `q:"marker"`
+	Limit          int    `q:"limit"`
+	SortKey        string `q:"sort_key"`
+	SortDir        string `q:"sort_dir"`
+}
+
+// ToLocalIPListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToLocalIPListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// Local IPs. It accepts a ListOpts struct, which allows you to filter and sort
+// the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToLocalIPListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return LocalIPPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific Local IP based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(getURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToLocalIPCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts represents options used to create a Local IP. Either NetworkID
+// or LocalPortID must be set.
+type CreateOpts struct {
+	// Name is a human-readable name of the Local IP.
+	Name string `json:"name,omitempty"`
+
+	// Description of the Local IP.
+	Description string `json:"description,omitempty"`
+
+	// ProjectID is the UUID of the project who owns the Local IP.
+	// Only administrative users can specify a project UUID other than their
+	// own.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// NetworkID is the ID of the network the Local IP is allocated from. A
+	// port is created for the Local IP on this network.
+	NetworkID string `json:"network_id,omitempty"`
+
+	// LocalPortID is the ID of an existing port whose IP address is used as
+	// the Local IP.
+	LocalPortID string `json:"local_port_id,omitempty"`
+
+	// LocalIPAddress is the IP address of the Local IP. An IP address of the
+	// local port is used if empty.
+	LocalIPAddress string `json:"local_ip_address,omitempty"`
+
+	// IPMode is the mode of the Local IP. It defaults to "translate".
+	IPMode IPMode `json:"ip_mode,omitempty"`
+}
+
+// ToLocalIPCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToLocalIPCreateMap() (map[string]interface{}, error) {
+	if opts.NetworkID == "" && opts.LocalPortID == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "localips.CreateOpts.NetworkID/localips.CreateOpts.LocalPortID"
+		return nil, err
+	}
+	return gophercloud.BuildRequestBody(opts, "local_ip")
+}
+
+// Create accepts a CreateOpts struct and creates a new Local IP using the
+// values provided.
+func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToLocalIPCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(createURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToLocalIPUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts represents options used to update a Local IP.
+type UpdateOpts struct {
+	// Name is a human-readable name of the Local IP.
+	Name *string `json:"name,omitempty"`
+
+	// Description of the Local IP.
+	Description *string `json:"description,omitempty"`
+
+	// RevisionNumber implements extension:standard-attr-revisions. If != "" it
+	// will set revision_number=%s. If the revision number does not match, the
+	// update will fail.
+	RevisionNumber *int `json:"-" h:"If-Match"`
+}
+
+// ToLocalIPUpdateMap builds a request body from UpdateOpts.
+func (opts UpdateOpts) ToLocalIPUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "local_ip")
+}
+
+// Update accepts a UpdateOpts struct and updates an existing Local IP using
+// the values provided.
+func Update(c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToLocalIPUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	h, err := gophercloud.BuildHeaders(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	for k := range h {
+		if k == "If-Match" {
+			h[k] = fmt.Sprintf("revision_number=%s", h[k])
+		}
+	}
+	resp, err := c.Put(updateURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		MoreHeaders: h,
+		OkCodes:     []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete accepts a unique ID and deletes the Local IP associated with it.
+func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(deleteURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListPortAssociationsOptsBuilder allows extensions to add additional
+// parameters to the ListPortAssociations request.
+type ListPortAssociationsOptsBuilder interface {
+	ToPortAssociationListQuery() (string, error)
+}
+
+// ListPortAssociationsOpts allows the filtering and sorting of paginated
+// collections of port associations.
+type ListPortAssociationsOpts struct {
+	FixedPortID    string `q:"fixed_port_id"`
+	FixedIP        string `q:"fixed_ip"`
+	Host           string `q:"host"`
+	LocalIPAddress string `q:"local_ip_address"`
+	Marker         string `q:"marker"`
+	Limit          int    `q:"limit"`
+	SortKey        string `q:"sort_key"`
+	SortDir        string `q:"sort_dir"`
+}
+
+// ToPortAssociationListQuery formats a ListPortAssociationsOpts into a query
+// string.
+func (opts ListPortAssociationsOpts) ToPortAssociationListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// ListPortAssociations returns a Pager which allows you to iterate over the
+// port associations of a Local IP.
+func ListPortAssociations(c *gophercloud.ServiceClient, localIPID string, opts ListPortAssociationsOptsBuilder) pagination.Pager {
+	url := portAssociationRootURL(c, localIPID)
+	if opts != nil {
+		query, err := opts.ToPortAssociationListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return PortAssociationPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreatePortAssociationOptsBuilder allows extensions to add additional
+// parameters to the CreatePortAssociation request.
+type CreatePortAssociationOptsBuilder interface {
+	ToPortAssociationCreateMap() (map[string]interface{}, error)
+}
+
+// CreatePortAssociationOpts represents options used to associate a port with
+// a Local IP.
+type CreatePortAssociationOpts struct {
+	// FixedPortID is the ID of the port to associate.
+	FixedPortID string `json:"fixed_port_id" required:"true"`
+
+	// FixedIP is the IP address of the port the Local IP is translated to. It
+	// is required if the port has several IP addresses.
+	FixedIP string `json:"fixed_ip,omitempty"`
+}
+
+// ToPortAssociationCreateMap builds a request body from
+// CreatePortAssociationOpts.
+func (opts CreatePortAssociationOpts) ToPortAssociationCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "port_association")
+}
+
+// CreatePortAssociation associates a port with a Local IP.
+func CreatePortAssociation(c *gophercloud.ServiceClient, localIPID string, opts CreatePortAssociationOptsBuilder) (r CreatePortAssociationResult) {
+	b, err := opts.ToPortAssociationCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(portAssociationRootURL(c, localIPID), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeletePortAssociation disassociates a port from a Local IP.
+func DeletePortAssociation(c *gophercloud.ServiceClient, localIPID, portID string) (r DeletePortAssociationResult) {
+	resp, err := c.Delete(portAssociationResourceURL(c, localIPID, portID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,257 @@
+package localips
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a Local IP
+// resource.
+func (r commonResult) Extract() (*LocalIP, error) {
+	var s LocalIP
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r commonResult) ExtractInto(v interface{}) error {
+	return r.Result.ExtractIntoStructPtr(v, "local_ip")
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a LocalIP.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a LocalIP.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a LocalIP.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// LocalIP represents a virtual IP address which is only reachable from within
+// the host of the ports associated with it.
+type LocalIP struct {
+	// ID is the unique ID of the Local IP.
+	ID string `json:"id"`
+
+	// Name is the human-readable name of the Local IP.
+	Name string `json:"name"`
+
+	// Description of the Local IP.
+	Description string `json:"description"`
+
+	// ProjectID is the project owner of the Local IP.
+	ProjectID string `json:"project_id"`
+
+	// LocalPortID is the ID of the port holding the IP address of the Local IP.
+	LocalPortID string `json:"local_port_id"`
+
+	// NetworkID is the ID of the network of the Local IP.
+	NetworkID string `json:"network_id"`
+
+	// LocalIPAddress is the IP address of the Local IP.
+	LocalIPAddress string `json:"local_ip_address"`
+
+	// IPMode is the mode of the Local IP.
+	IPMode IPMode `json:"ip_mode"`
+
+	// UpdatedAt and CreatedAt contain ISO-8601 timestamps of when the state of
+	// the Local IP last changed, and when it was created.
+	UpdatedAt time.Time `json:"-"`
+	CreatedAt time.Time `json:"-"`
+
+	// RevisionNumber optionally set via extensions/standard-attr-revisions
+	RevisionNumber int `json:"revision_number"`
+}
+
+func (r *LocalIP) UnmarshalJSON(b []byte) error {
+	type tmp LocalIP
+
+	// Support for older neutron time format
+	var s1 struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339NoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339NoZ `json:"updated_at"`
+	}
+
+	err := json.Unmarshal(b, &s1)
+	if err == nil {
+		*r = LocalIP(s1.tmp)
+		r.CreatedAt = time.Time(s1.CreatedAt)
+		r.UpdatedAt = time.Time(s1.UpdatedAt)
+
+		return nil
+	}
+
+	// Support for newer neutron time format
+	var s2 struct {
+		tmp
+		CreatedAt time.Time `json:"created_at"`
+		UpdatedAt time.Time `json:"updated_at"`
+	}
+
+	err = json.Unmarshal(b, &s2)
+	if err != nil {
+		return err
+	}
+
+	*r = LocalIP(s2.tmp)
+	r.CreatedAt = time.Time(s2.CreatedAt)
+	r.UpdatedAt = time.Time(s2.UpdatedAt)
+
+	return nil
+}
+
+// LocalIPPage is the page returned by a pager when traversing over a
+// collection of Local IPs.
+type LocalIPPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of Local IPs has reached
+// the end of a page and the pager seeks to traverse over a new one. In order
+// to do this, it needs to construct the next page's URL.
+func (r LocalIPPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"local_ips_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a LocalIPPage struct is empty.
+func (r LocalIPPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractLocalIPs(r)
+	return len(is) == 0, err
+}
+
+// ExtractLocalIPs accepts a Page struct, specifically a LocalIPPage struct,
+// and extracts the elements into a slice of LocalIP structs. In other words,
+// a generic collection is mapped into a relevant slice.
+func ExtractLocalIPs(r pagination.Page) ([]LocalIP, error) {
+	var s []LocalIP
+	err := ExtractLocalIPsInto(r, &s)
+	return s, err
+}
+
+// ExtractLocalIPsInto extracts the elements into a slice of LocalIP structs.
+func ExtractLocalIPsInto(r pagination.Page, v interface{}) error {
+	return r.(LocalIPPage).Result.ExtractIntoSlicePtr(v, "local_ips")
+}
+
+// PortAssociation represents the association of a port with a Local IP.
+type PortAssociation struct {
+	// LocalIPID is the ID of the Local IP.
+	LocalIPID string `json:"local_ip_id"`
+
+	// LocalIPAddress is the IP address of the Local IP.
+	LocalIPAddress string `json:"local_ip_address"`
+
+	// FixedPortID is the ID of the associated port.
+	FixedPortID string `json:"fixed_port_id"`
+
+	// FixedIP is the IP address of the associated port the Local IP is
+	// translated to.
+	FixedIP string `json:"fixed_ip"`
+
+	// Host is the host of the associated port.
+	Host string `json:"host"`
+}
+
+// CreatePortAssociationResult represents the result of a create port
+// association operation. Call its Extract method to interpret it as a
+// PortAssociation.
+type CreatePortAssociationResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a port
+// association.
+func (r CreatePortAssociationResult) Extract() (*PortAssociation, error) {
+	var s PortAssociation
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r CreatePortAssociationResult) ExtractInto(v interface{}) error {
+	return r.Result.ExtractIntoStructPtr(v, "port_association")
+}
+
+// DeletePortAssociationResult represents the result of a delete port
+// association operation. Call its ExtractErr method to determine if the
+// request succeeded or failed.
+type DeletePortAssociationResult struct {
+	gophercloud.ErrResult
+}
+
+// PortAssociationPage is the page returned by a pager when traversing over a
+// collection of port associations.
+type PortAssociationPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of port associations has
+// reached the end of a page and the pager seeks to traverse over a new one.
+// In order to do this, it needs to construct the next page's URL.
+func (r PortAssociationPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"port_associations_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a PortAssociationPage struct is empty.
+func (r PortAssociationPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractPortAssociations(r)
+	return len(is) == 0, err
+}
+
+// ExtractPortAssociations accepts a Page struct, specifically a
+// PortAssociationPage struct, and extracts the elements into a slice of
+// PortAssociation structs.
+func ExtractPortAssociations(r pagination.Page) ([]PortAssociation, error) {
+	var s []PortAssociation
+	err := ExtractPortAssociationsInto(r, &s)
+	return s, err
+}
+
+// ExtractPortAssociationsInto extracts the elements into a slice of
+// PortAssociation structs.
+func ExtractPortAssociationsInto(r pagination.Page, v interface{}) error {
+	return r.(PortAssociationPage).Result.ExtractIntoSlicePtr(v, "port_associations")
+}
@@ -0,0 +1,196 @@
+package testing
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/localips"
+)
+
+// LocalIPsListResult represents raw response for the List request.
+const LocalIPsListResult = `
+{
+    "local_ips": [
+        {
+            "id": "5c17bdb4-7b5e-4f4f-8d3c-8d6c0f0a3a12",
+            "name": "dns-cache",
+            "description": "",
+            "project_id": "45977fa2dbd7482098dd68d0d8970117",
+            "local_port_id": "3f9f0a1d-31b5-4b43-b1e1-7e9f0b2b5c9e",
+            "network_id": "2e32b1ec-2d2c-4a7f-9f36-c6a6c8ec1d6b",
+            "local_ip_address": "172.24.4.100",
+            "ip_mode": "translate",
+            "revision_number": 1,
+            "created_at": "2022-03-10T09:12:45Z",
+            "updated_at": "2022-03-10T09:12:45Z"
+        },
+        {
+            "id": "d6b1b6b0-0c0f-4e1b-8c4b-9b8f4f7f5a21",
+            "name": "metrics",
+            "description": "Node local metrics endpoint",
+            "project_id": "45977fa2dbd7482098dd68d0d8970117",
+            "local_port_id": "76c9f8e7-1e0f-4d3b-9a8e-2b7a9d0c1e3f",
+            "network_id": "2e32b1ec-2d2c-4a7f-9f36-c6a6c8ec1d6b",
+            "local_ip_address": "172.24.4.101",
+            "ip_mode": "passthrough",
+            "revision_number": 0,
+            "created_at": "2022-03-10T09:20:11",
+            "updated_at": "2022-03-10T09:20:11"
+        }
+    ]
+}
+`
+
+// LocalIP1 is the first Local IP of LocalIPsListResult.
+var LocalIP1 = localips.LocalIP{
+	ID:             "5c17bdb4-7b5e-4f4f-8d3c-8d6c0f0a3a12",
+	Name:           "dns-cache",
+	ProjectID:      "45977fa2dbd7482098dd68d0d8970117",
+	LocalPortID:    "3f9f0a1d-31b5-4b43-b1e1-7e9f0b2b5c9e",
+	NetworkID:      "2e32b1ec-2d2c-4a7f-9f36-c6a6c8ec1d6b",
+	LocalIPAddress: "172.24.4.100",
+	IPMode:         localips.IPModeTranslate,
+	RevisionNumber: 1,
+	CreatedAt:      time.Date(2022, 3, 10, 9, 12, 45, 0, time.UTC),
+	UpdatedAt:      time.Date(2022, 3, 10, 9, 12, 45, 0, time.UTC),
+}
+
+// LocalIP2 is the second Local IP of LocalIPsListResult.
+var LocalIP2 = localips.LocalIP{
+	ID:             "d6b1b6b0-0c0f-4e1b-8c4b-9b8f4f7f5a21",
+	Name:           "metrics",
+	Description:    "Node local metrics endpoint",
+	ProjectID:      "45977fa2dbd7482098dd68d0d8970117",
+	LocalPortID:    "76c9f8e7-1e0f-4d3b-9a8e-2b7a9d0c1e3f",
+	NetworkID:      "2e32b1ec-2d2c-4a7f-9f36-c6a6c8ec1d6b",
+	LocalIPAddress: "172.24.4.101",
+	IPMode:         localips.IPModePassthrough,
+	CreatedAt:      time.Date(2022, 3, 10, 9, 20, 11, 0, time.UTC),
+	UpdatedAt:      time.Date(2022, 3, 10, 9, 20, 11, 0, time.UTC),
+}
+
+// LocalIPGetResult represents raw response for the Get request.
+const LocalIPGetResult = `
+{
+    "local_ip": {
+        "id": "5c17bdb4-7b5e-4f4f-8d3c-8d6c0f0a3a12",
+        "name": "dns-cache",
+        "description": "",
+        "project_id": "45977fa2dbd7482098dd68d0d8970117",
+        "local_port_id": "3f9f0a1d-31b5-4b43-b1e1-7e9f0b2b5c9e",
+        "network_id": "2e32b1ec-2d2c-4a7f-9f36-c6a6c8ec1d6b",
+        "local_ip_address": "172.24.4.100",
+        "ip_mode": "translate",
+        "revision_number": 1,
+        "created_at": "2022-03-10T09:12:45Z",
+        "updated_at": "2022-03-10T09:12:45Z"
+    }
+}
+`
+
+// LocalIPCreateRequest represents raw request for the Create request.
+const LocalIPCreateRequest = `
+{
+    "local_ip": {
+        "name": "dns-cache",
+        "network_id": "2e32b1ec-2d2c-4a7f-9f36-c6a6c8ec1d6b",
+        "local_ip_address": "172.24.4.100",
+        "ip_mode": "translate"
+    }
+}
+`
+
+// LocalIPUpdateRequest represents raw request for the Update request.
+const LocalIPUpdateRequest = `
+{
+    "local_ip": {
+        "description": "Node local DNS cache"
+    }
+}
+`
+
+// LocalIPUpdateResult represents raw response for the Update request.
+const LocalIPUpdateResult = `
+{
+    "local_ip": {
+        "id": "5c17bdb4-7b5e-4f4f-8d3c-8d6c0f0a3a12",
+        "name": "dns-cache",
+        "description": "Node local DNS cache",
+        "project_id": "45977fa2dbd7482098dd68d0d8970117",
+        "local_port_id": "3f9f0a1d-31b5-4b43-b1e1-7e9f0b2b5c9e",
+        "network_id": "2e32b1ec-2d2c-4a7f-9f36-c6a6c8ec1d6b",
+        "local_ip_address": "172.24.4.100",
+        "ip_mode": "translate",
+        "revision_number": 2,
+        "created_at": "2022-03-10T09:12:45Z",
+        "updated_at": "2022-03-10T10:01:02Z"
+    }
+}
+`
+
+// PortAssociationsListResult represents raw response for the
+// ListPortAssociations request.
+const PortAssociationsListResult = `
+{
+    "port_associations": [
+        {
+            "local_ip_id": "5c17bdb4-7b5e-4f4f-8d3c-8d6c0f0a3a12",
+            "local_ip_address": "172.24.4.100",
+            "fixed_port_id": "a0a1b2c3-1f6e-4a3e-9b1e-5a1d8a2e6f4c",
+            "fixed_ip": "10.0.0.5",
+            "host": "compute-1"
+        },
+        {
+            "local_ip_id": "5c17bdb4-7b5e-4f4f-8d3c-8d6c0f0a3a12",
+            "local_ip_address": "172.24.4.100",
+            "fixed_port_id": "b1b2c3d4-2a7f-4b4f-8c2f-6b2e9b3f7a5d",
+            "fixed_ip": "10.0.0.6",
+            "host": "compute-2"
+        }
+    ]
+}
+`
+
+// PortAssociation1 is the first port association of
+// PortAssociationsListResult.
+var PortAssociation1 = localips.PortAssociation{
+	LocalIPID:      "5c17bdb4-7b5e-4f4f-8d3c-8d6c0f0a3a12",
+	LocalIPAddress: "172.24.4.100",
+	FixedPortID:    "a0a1b2c3-1f6e-4a3e-9b1e-5a1d8a2e6f4c",
+	FixedIP:        "10.0.0.5",
+	Host:           "compute-1",
+}
+
+// PortAssociation2 is the second port association of
+// PortAssociationsListResult.
+var PortAssociation2 = localips.PortAssociation{
+	LocalIPID:      "5c17bdb4-7b5e-4f4f-8d3c-8d6c0f0a3a12",
+	LocalIPAddress: "172.24.4.100",
+	FixedPortID:    "b1b2c3d4-2a7f-4b4f-8c2f-6b2e9b3f7a5d",
+	FixedIP:        "10.0.0.6",
+	Host:           "compute-2",
+}
+
+// PortAssociationCreateRequest represents raw request for the
+// CreatePortAssociation request.
+const PortAssociationCreateRequest = `
+{
+    "port_association": {
+        "fixed_port_id": "a0a1b2c3-1f6e-4a3e-9b1e-5a1d8a2e6f4c",
+        "fixed_ip": "10.0.0.5"
+    }
+}
+`
+
+// PortAssociationCreateResult represents raw response for the
+// CreatePortAssociation request.
+const PortAssociationCreateResult = `
+{
+    "port_association": {
+        "local_ip_id": "5c17bdb4-7b5e-4f4f-8d3c-8d6c0f0a3a12",
+        "local_ip_address": "172.24.4.100",
+        "fixed_port_id": "a0a1b2c3-1f6e-4a3e-9b1e-5a1d8a2e6f4c",
+        "fixed_ip": "10.0.0.5",
+        "host": "compute-1"
+    }
+}
+`
@@ -0,0 +1,237 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/localips"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/local_ips", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"network_id": "2e32b1ec-2d2c-4a7f-9f36-c6a6c8ec1d6b",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, LocalIPsListResult)
+	})
+
+	count := 0
+
+	listOpts := localips.ListOpts{
+		NetworkID: "2e32b1ec-2d2c-4a7f-9f36-c6a6c8ec1d6b",
+	}
+	err := localips.List(fake.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := localips.ExtractLocalIPs(page)
+		if err != nil {
+			t.Errorf("Failed to extract local IPs: %v", err)
+			return false, nil
+		}
+
+		expected := []localips.LocalIP{
+			LocalIP1,
+			LocalIP2,
+		}
+
+		th.CheckDeepEquals(t, expected, actual)
+
+		return true, nil
+	})
+
+	th.AssertNoErr(t, err)
+
+	if count != 1 {
+		t.Errorf("Expected 1 page, got %d", count)
+	}
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/local_ips/5c17bdb4-7b5e-4f4f-8d3c-8d6c0f0a3a12", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, LocalIPGetResult)
+	})
+
+	l, err := localips.Get(fake.ServiceClient(), "5c17bdb4-7b5e-4f4f-8d3c-8d6c0f0a3a12").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, LocalIP1, *l)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/local_ips", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, LocalIPCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, LocalIPGetResult)
+	})
+
+	createOpts := localips.CreateOpts{
+		Name:           "dns-cache",
+		NetworkID:      "2e32b1ec-2d2c-4a7f-9f36-c6a6c8ec1d6b",
+		LocalIPAddress: "172.24.4.100",
+		IPMode:         localips.IPModeTranslate,
+	}
+
+	l, err := localips.Create(fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, LocalIP1, *l)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	res := localips.Create(fake.ServiceClient(), localips.CreateOpts{
+		Name: "dns-cache",
+	})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/local_ips/5c17bdb4-7b5e-4f4f-8d3c-8d6c0f0a3a12", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "If-Match", "revision_number=1")
+		th.TestJSONRequest(t, r, LocalIPUpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, LocalIPUpdateResult)
+	})
+
+	description := "Node local DNS cache"
+	revisionNumber := 1
+	updateOpts := localips.UpdateOpts{
+		Description:    &description,
+		RevisionNumber: &revisionNumber,
+	}
+
+	l, err := localips.Update(fake.ServiceClient(), "5c17bdb4-7b5e-4f4f-8d3c-8d6c0f0a3a12", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "Node local DNS cache", l.Description)
+	th.AssertEquals(t, 2, l.RevisionNumber)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/local_ips/5c17bdb4-7b5e-4f4f-8d3c-8d6c0f0a3a12", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := localips.Delete(fake.ServiceClient(), "5c17bdb4-7b5e-4f4f-8d3c-8d6c0f0a3a12")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestListPortAssociations(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/local_ips/5c17bdb4-7b5e-4f4f-8d3c-8d6c0f0a3a12/port_associations", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, PortAssociationsListResult)
+	})
+
+	allPages, err := localips.ListPortAssociations(fake.ServiceClient(), "5c17bdb4-7b5e-4f4f-8d3c-8d6c0f0a3a12", nil).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := localips.ExtractPortAssociations(allPages)
+	th.AssertNoErr(t, err)
+
+	expected := []localips.PortAssociation{
+		PortAssociation1,
+		PortAssociation2,
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestCreatePortAssociation(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/local_ips/5c17bdb4-7b5e-4f4f-8d3c-8d6c0f0a3a12/port_associations", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, PortAssociationCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, PortAssociationCreateResult)
+	})
+
+	createOpts := localips.CreatePortAssociationOpts{
+		FixedPortID: "a0a1b2c3-1f6e-4a3e-9b1e-5a1d8a2e6f4c",
+		FixedIP:     "10.0.0.5",
+	}
+
+	a, err := localips.CreatePortAssociation(fake.ServiceClient(), "5c17bdb4-7b5e-4f4f-8d3c-8d6c0f0a3a12", createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, PortAssociation1, *a)
+}
+
+func TestRequiredCreatePortAssociationOpts(t *testing.T) {
+	res := localips.CreatePortAssociation(fake.ServiceClient(), "5c17bdb4-7b5e-4f4f-8d3c-8d6c0f0a3a12", localips.CreatePortAssociationOpts{})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestDeletePortAssociation(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/local_ips/5c17bdb4-7b5e-4f4f-8d3c-8d6c0f0a3a12/port_associations/a0a1b2c3-1f6e-4a3e-9b1e-5a1d8a2e6f4c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := localips.DeletePortAssociation(fake.ServiceClient(), "5c17bdb4-7b5e-4f4f-8d3c-8d6c0f0a3a12", "a0a1b2c3-1f6e-4a3e-9b1e-5a1d8a2e6f4c")
+	th.AssertNoErr(t, res.Err)
+}
@@ -0,0 +1,44 @@
+package localips
+
+import "github.com/gophercloud/gophercloud"
+
+const (
+	resourcePath        = "local_ips"
+	portAssociationPath = "port_associations"
+)
+
+func resourceURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id)
+}
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func getURL(c *gophercloud.ServiceClient, id string) string {
+	return resourceURL(c, id)
+}
+
+func createURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func updateURL(c *gophercloud.ServiceClient, id string) string {
+	return resourceURL(c, id)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, id string) string {
+	return resourceURL(c, id)
+}
+
+func portAssociationRootURL(c *gophercloud.ServiceClient, localIPID string) string {
+	return c.ServiceURL(resourcePath, localIPID, portAssociationPath)
+}
+
+func portAssociationResourceURL(c *gophercloud.ServiceClient, localIPID, portID string) string {
+	return c.ServiceURL(resourcePath, localIPID, portAssociationPath, portID)
+}
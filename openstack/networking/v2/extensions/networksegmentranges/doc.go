@@ -0,0 +1,75 @@
+/*
+Package networksegmentranges provides the ability to retrieve and manage network
+segment ranges through the Neutron API. Network segment ranges are the pools
+of segmentation IDs, such as VLAN IDs, from which project networks are
+allocated.
+
+Example of Listing Network Segment Ranges
+
+	listOpts := networksegmentranges.ListOpts{
+		NetworkType: "vlan",
+	}
+
+	allPages, err := networksegmentranges.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allRanges, err := networksegmentranges.ExtractNetworkSegmentRanges(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, r := range allRanges {
+		fmt.Printf("%+v\n", r)
+	}
+
+Example to Get a Network Segment Range
+
+	rangeID := "e7f4c5ef-1ae6-44b0-9a8a-5d6f7a1b2c3d"
+	r, err := networksegmentranges.Get(networkClient, rangeID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Network Segment Range
+
+	shared := false
+	createOpts := networksegmentranges.CreateOpts{
+		Name:            "project-vlans",
+		NetworkType:     "vlan",
+		PhysicalNetwork: "physnet1",
+		Minimum:         100,
+		Maximum:         199,
+		Shared:          &shared,
+		ProjectID:       "7011dc8e4e0a4b3b8dcbc3b6d5c4fb7b",
+	}
+
+	r, err := networksegmentranges.Create(networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Network Segment Range
+
+	rangeID := "e7f4c5ef-1ae6-44b0-9a8a-5d6f7a1b2c3d"
+
+	maximum := 299
+	updateOpts := networksegmentranges.UpdateOpts{
+		Maximum: &maximum,
+	}
+
+	r, err := networksegmentranges.Update(networkClient, rangeID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Network Segment Range
+
+	rangeID := "e7f4c5ef-1ae6-44b0-9a8a-5d6f7a1b2c3d"
+	err := networksegmentranges.Delete(networkClient, rangeID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package networksegmentranges
@@ -0,0 +1,184 @@
+package networksegmentranges
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a network segment
+// range resource.
+func (r commonResult) Extract() (*NetworkSegmentRange, error) {
+	var s NetworkSegmentRange
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r commonResult) ExtractInto(v interface{}) error {
+	return r.Result.ExtractIntoStructPtr(v, "network_segment_range")
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a NetworkSegmentRange.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a NetworkSegmentRange.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a NetworkSegmentRange.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// NetworkSegmentRange represents a range of segmentation IDs from which
+// project networks are allocated.
+type NetworkSegmentRange struct {
+	// ID is the unique ID of the range.
+	ID string `json:"id"`
+
+	// Name is the human-readable name of the range.
+	Name string `json:"name"`
+
+	// Description of the range.
+	Description string `json:"description"`
+
+	// Default indicates whether the range is the default range, loaded from
+	// the configuration of the server.
+	Default bool `json:"default"`
+
+	// Shared indicates whether the range is available to all projects.
+	Shared bool `json:"shared"`
+
+	// ProjectID is the ID of the project the range is reserved for.
+	ProjectID string `json:"project_id"`
+
+	// NetworkType is the type of the physical network.
+	NetworkType string `json:"network_type"`
+
+	// PhysicalNetwork is the name of the physical network of a VLAN range.
+	PhysicalNetwork string `json:"physical_network"`
+
+	// Minimum is the lowest segmentation ID of the range.
+	Minimum int `json:"minimum"`
+
+	// Maximum is the highest segmentation ID of the range.
+	Maximum int `json:"maximum"`
+
+	// Available are the segmentation IDs of the range not yet allocated.
+	Available []int `json:"available"`
+
+	// Used maps the allocated segmentation IDs of the range to the ID of the
+	// project they are allocated to.
+	Used map[string]string `json:"used"`
+
+	// UpdatedAt and CreatedAt contain ISO-8601 timestamps of when the state of
+	// the range last changed, and when it was created.
+	UpdatedAt time.Time `json:"-"`
+	CreatedAt time.Time `json:"-"`
+
+	// Tags optionally set via extensions/attributestags
+	Tags []string `json:"tags"`
+
+	// RevisionNumber optionally set via extensions/standard-attr-revisions
+	RevisionNumber int `json:"revision_number"`
+}
+
+func (r *NetworkSegmentRange) UnmarshalJSON(b []byte) error {
+	type tmp NetworkSegmentRange
+
+	// Support for older neutron time format
+	var s1 struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339NoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339NoZ `json:"updated_at"`
+	}
+
+	err := json.Unmarshal(b, &s1)
+	if err == nil {
+		*r = NetworkSegmentRange(s1.tmp)
+		r.CreatedAt = time.Time(s1.CreatedAt)
+		r.UpdatedAt = time.Time(s1.UpdatedAt)
+
+		return nil
+	}
+
+	// Support for newer neutron time format
+	var s2 struct {
+		tmp
+		CreatedAt time.Time `json:"created_at"`
+		UpdatedAt time.Time `json:"updated_at"`
+	}
+
+	err = json.Unmarshal(b, &s2)
+	if err != nil {
+		return err
+	}
+
+	*r = NetworkSegmentRange(s2.tmp)
+	r.CreatedAt = time.Time(s2.CreatedAt)
+	r.UpdatedAt = time.Time(s2.UpdatedAt)
+
+	return nil
+}
+
+// NetworkSegmentRangePage is the page returned by a pager when traversing over
+// a collection of network segment ranges.
+type NetworkSegmentRangePage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of network segment ranges
+// has reached the end of a page and the pager seeks to traverse over a new one.
+// In order to do this, it needs to construct the next page's URL.
+func (r NetworkSegmentRangePage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"network_segment_ranges_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a NetworkSegmentRangePage struct is empty.
+func (r NetworkSegmentRangePage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractNetworkSegmentRanges(r)
+	return len(is) == 0, err
+}
+
+// ExtractNetworkSegmentRanges accepts a Page struct, specifically a
+// NetworkSegmentRangePage struct, and extracts the elements into a slice of
+// NetworkSegmentRange structs. In other words, a generic collection is mapped
+// into a relevant slice.
+func ExtractNetworkSegmentRanges(r pagination.Page) ([]NetworkSegmentRange, error) {
+	var s []NetworkSegmentRange
+	err := ExtractNetworkSegmentRangesInto(r, &s)
+	return s, err
+}
+
+func ExtractNetworkSegmentRangesInto(r pagination.Page, v interface{}) error {
+	return r.(NetworkSegmentRangePage).Result.ExtractIntoSlicePtr(v, "network_segment_ranges")
+}
@@ -0,0 +1,171 @@
+package testing
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/networksegmentranges"
+)
+
+// NetworkSegmentRangesListResult represents raw response for the List request.
+const NetworkSegmentRangesListResult = `
+{
+    "network_segment_ranges": [
+        {
+            "id": "e7f4c5ef-1ae6-44b0-9a8a-5d6f7a1b2c3d",
+            "name": "project-vlans",
+            "description": "",
+            "default": false,
+            "shared": false,
+            "project_id": "7011dc8e4e0a4b3b8dcbc3b6d5c4fb7b",
+            "network_type": "vlan",
+            "physical_network": "physnet1",
+            "minimum": 100,
+            "maximum": 103,
+            "available": [101, 103],
+            "used": {
+                "100": "7011dc8e4e0a4b3b8dcbc3b6d5c4fb7b",
+                "102": "7011dc8e4e0a4b3b8dcbc3b6d5c4fb7b"
+            },
+            "tags": ["tenant"],
+            "revision_number": 1,
+            "created_at": "2019-06-30T04:15:37Z",
+            "updated_at": "2019-06-30T05:18:49Z"
+        },
+        {
+            "id": "2a7c3d1f-5b8e-4e6f-9d3c-1b2a3c4d5e6f",
+            "name": "",
+            "description": "",
+            "default": true,
+            "shared": true,
+            "project_id": "",
+            "network_type": "vxlan",
+            "physical_network": null,
+            "minimum": 1,
+            "maximum": 1000,
+            "available": [],
+            "used": {},
+            "tags": [],
+            "revision_number": 0,
+            "created_at": "2019-06-30T04:00:00",
+            "updated_at": "2019-06-30T04:00:00"
+        }
+    ]
+}
+`
+
+// NetworkSegmentRange1 is the first range of NetworkSegmentRangesListResult.
+var NetworkSegmentRange1 = networksegmentranges.NetworkSegmentRange{
+	ID:              "e7f4c5ef-1ae6-44b0-9a8a-5d6f7a1b2c3d",
+	Name:            "project-vlans",
+	ProjectID:       "7011dc8e4e0a4b3b8dcbc3b6d5c4fb7b",
+	NetworkType:     "vlan",
+	PhysicalNetwork: "physnet1",
+	Minimum:         100,
+	Maximum:         103,
+	Available:       []int{101, 103},
+	Used: map[string]string{
+		"100": "7011dc8e4e0a4b3b8dcbc3b6d5c4fb7b",
+		"102": "7011dc8e4e0a4b3b8dcbc3b6d5c4fb7b",
+	},
+	Tags:           []string{"tenant"},
+	RevisionNumber: 1,
+	CreatedAt:      time.Date(2019, 6, 30, 4, 15, 37, 0, time.UTC),
+	UpdatedAt:      time.Date(2019, 6, 30, 5, 18, 49, 0, time.UTC),
+}
+
+// NetworkSegmentRange2 is the second range of NetworkSegmentRangesListResult.
+var NetworkSegmentRange2 = networksegmentranges.NetworkSegmentRange{
+	ID:          "2a7c3d1f-5b8e-4e6f-9d3c-1b2a3c4d5e6f",
+	Default:     true,
+	Shared:      true,
+	NetworkType: "vxlan",
+	Minimum:     1,
+	Maximum:     1000,
+	Available:   []int{},
+	Used:        map[string]string{},
+	Tags:        []string{},
+	CreatedAt:   time.Date(2019, 6, 30, 4, 0, 0, 0, time.UTC),
+	UpdatedAt:   time.Date(2019, 6, 30, 4, 0, 0, 0, time.UTC),
+}
+
+// NetworkSegmentRangeGetResult represents raw response for the Get request.
+const NetworkSegmentRangeGetResult = `
+{
+    "network_segment_range": {
+        "id": "e7f4c5ef-1ae6-44b0-9a8a-5d6f7a1b2c3d",
+        "name": "project-vlans",
+        "description": "",
+        "default": false,
+        "shared": false,
+        "project_id": "7011dc8e4e0a4b3b8dcbc3b6d5c4fb7b",
+        "network_type": "vlan",
+        "physical_network": "physnet1",
+        "minimum": 100,
+        "maximum": 103,
+        "available": [101, 103],
+        "used": {
+            "100": "7011dc8e4e0a4b3b8dcbc3b6d5c4fb7b",
+            "102": "7011dc8e4e0a4b3b8dcbc3b6d5c4fb7b"
+        },
+        "tags": ["tenant"],
+        "revision_number": 1,
+        "created_at": "2019-06-30T04:15:37Z",
+        "updated_at": "2019-06-30T05:18:49Z"
+    }
+}
+`
+
+// NetworkSegmentRangeCreateRequest represents raw request for the Create
+// request.
+const NetworkSegmentRangeCreateRequest = `
+{
+    "network_segment_range": {
+        "name": "project-vlans",
+        "shared": false,
+        "project_id": "7011dc8e4e0a4b3b8dcbc3b6d5c4fb7b",
+        "network_type": "vlan",
+        "physical_network": "physnet1",
+        "minimum": 100,
+        "maximum": 103
+    }
+}
+`
+
+// NetworkSegmentRangeUpdateRequest represents raw request for the Update
+// request.
+const NetworkSegmentRangeUpdateRequest = `
+{
+    "network_segment_range": {
+        "name": "project-vlans-2",
+        "maximum": 110
+    }
+}
+`
+
+// NetworkSegmentRangeUpdateResult represents raw response for the Update
+// request.
+const NetworkSegmentRangeUpdateResult = `
+{
+    "network_segment_range": {
+        "id": "e7f4c5ef-1ae6-44b0-9a8a-5d6f7a1b2c3d",
+        "name": "project-vlans-2",
+        "description": "",
+        "default": false,
+        "shared": false,
+        "project_id": "7011dc8e4e0a4b3b8dcbc3b6d5c4fb7b",
+        "network_type": "vlan",
+        "physical_network": "physnet1",
+        "minimum": 100,
+        "maximum": 110,
+        "available": [101, 103, 104, 105, 106, 107, 108, 109, 110],
+        "used": {
+            "100": "7011dc8e4e0a4b3b8dcbc3b6d5c4fb7b",
+            "102": "7011dc8e4e0a4b3b8dcbc3b6d5c4fb7b"
+        },
+        "tags": ["tenant"],
+        "revision_number": 2,
+        "created_at": "2019-06-30T04:15:37Z",
+        "updated_at": "2019-07-01T08:00:00Z"
+    }
+}
+`
@@ -0,0 +1,160 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/networksegmentranges"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/network_segment_ranges", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"shared":   "false",
+			"not-tags": "legacy",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, NetworkSegmentRangesListResult)
+	})
+
+	count := 0
+
+	shared := false
+	listOpts := networksegmentranges.ListOpts{
+		Shared:  &shared,
+		NotTags: "legacy",
+	}
+	err := networksegmentranges.List(fake.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := networksegmentranges.ExtractNetworkSegmentRanges(page)
+		if err != nil {
+			t.Errorf("Failed to extract network segment ranges: %v", err)
+			return false, nil
+		}
+
+		expected := []networksegmentranges.NetworkSegmentRange{
+			NetworkSegmentRange1,
+			NetworkSegmentRange2,
+		}
+
+		th.CheckDeepEquals(t, expected, actual)
+
+		return true, nil
+	})
+
+	th.AssertNoErr(t, err)
+
+	if count != 1 {
+		t.Errorf("Expected 1 page, got %d", count)
+	}
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/network_segment_ranges/e7f4c5ef-1ae6-44b0-9a8a-5d6f7a1b2c3d", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, NetworkSegmentRangeGetResult)
+	})
+
+	s, err := networksegmentranges.Get(fake.ServiceClient(), "e7f4c5ef-1ae6-44b0-9a8a-5d6f7a1b2c3d").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, NetworkSegmentRange1, *s)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/network_segment_ranges", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, NetworkSegmentRangeCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, NetworkSegmentRangeGetResult)
+	})
+
+	shared := false
+	createOpts := networksegmentranges.CreateOpts{
+		Name:            "project-vlans",
+		Shared:          &shared,
+		ProjectID:       "7011dc8e4e0a4b3b8dcbc3b6d5c4fb7b",
+		NetworkType:     "vlan",
+		PhysicalNetwork: "physnet1",
+		Minimum:         100,
+		Maximum:         103,
+	}
+
+	s, err := networksegmentranges.Create(fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, NetworkSegmentRange1, *s)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/network_segment_ranges/e7f4c5ef-1ae6-44b0-9a8a-5d6f7a1b2c3d", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, NetworkSegmentRangeUpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, NetworkSegmentRangeUpdateResult)
+	})
+
+	name := "project-vlans-2"
+	maximum := 110
+	updateOpts := networksegmentranges.UpdateOpts{
+		Name:    &name,
+		Maximum: &maximum,
+	}
+
+	s, err := networksegmentranges.Update(fake.ServiceClient(), "e7f4c5ef-1ae6-44b0-9a8a-5d6f7a1b2c3d", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "project-vlans-2", s.Name)
+	th.AssertEquals(t, 110, s.Maximum)
+	th.AssertEquals(t, 9, len(s.Available))
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/network_segment_ranges/e7f4c5ef-1ae6-44b0-9a8a-5d6f7a1b2c3d", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := networksegmentranges.Delete(fake.ServiceClient(), "e7f4c5ef-1ae6-44b0-9a8a-5d6f7a1b2c3d")
+	th.AssertNoErr(t, res.Err)
+}
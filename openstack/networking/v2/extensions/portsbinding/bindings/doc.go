@@ -0,0 +1,69 @@
+/*
+Package bindings provides access to the bindings of a port, as exposed by the
+binding-extended extension of the OpenStack Networking service.
+
+A port has a single active binding to the host it runs on. During a live
+migration, an inactive binding is created for the destination host, and then
+activated once the migration succeeded, which deactivates the binding of the
+source host. The inactive binding is deleted if the migration fails.
+
+Example to List the Bindings of a Port
+
+	portID := "46d4bfb9-b26e-41f3-bd2e-e6dcc1ccedb2"
+
+	allPages, err := bindings.List(networkClient, portID, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allBindings, err := bindings.ExtractBindings(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, binding := range allBindings {
+		fmt.Printf("%+v\n", binding)
+	}
+
+Example to Create an Inactive Binding for an SR-IOV Port
+
+	portID := "46d4bfb9-b26e-41f3-bd2e-e6dcc1ccedb2"
+
+	profile, err := portsbinding.SRIOVProfile{
+		PCISlot:         "0000:03:10.1",
+		PhysicalNetwork: "physnet-sriov",
+	}.ToBindingProfileMap()
+	if err != nil {
+		panic(err)
+	}
+
+	createOpts := bindings.CreateOpts{
+		Host:     "compute-2",
+		VNICType: portsbinding.VNICTypeDirect,
+		Profile:  profile,
+	}
+
+	binding, err := bindings.Create(networkClient, portID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Activate the Binding of a Host
+
+	portID := "46d4bfb9-b26e-41f3-bd2e-e6dcc1ccedb2"
+
+	binding, err := bindings.Activate(networkClient, portID, "compute-2").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete the Inactive Binding of a Host
+
+	portID := "46d4bfb9-b26e-41f3-bd2e-e6dcc1ccedb2"
+
+	err := bindings.Delete(networkClient, portID, "compute-2").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package bindings
@@ -0,0 +1,98 @@
+package bindings
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToBindingListQuery() (string, error)
+}
+
+// ListOpts allows the filtering of the bindings of a port.
+type ListOpts struct {
+	Host     string `q:"host"`
+	VIFType  string `q:"vif_type"`
+	VNICType string `q:"vnic_type"`
+	Status   string `q:"status"`
+}
+
+// ToBindingListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToBindingListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over the bindings of a
+// port.
+func List(c *gophercloud.ServiceClient, portID string, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c, portID)
+	if opts != nil {
+		query, err := opts.ToBindingListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return BindingPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToBindingCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts represents options used to create an inactive binding of a port
+// to a host.
+type CreateOpts struct {
+	// Host is the host to bind the port to.
+	Host string `json:"host" required:"true"`
+
+	// VNICType is the type of vNIC the port is bound to. It defaults to the
+	// vNIC type of the active binding.
+	VNICType string `json:"vnic_type,omitempty"`
+
+	// Profile enables the application running on the host to pass
+	// port-specific information to the plug-in. The typed profiles of the
+	// portsbinding package can be used to build it.
+	Profile map[string]interface{} `json:"profile,omitempty"`
+}
+
+// ToBindingCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToBindingCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "binding")
+}
+
+// Create creates an inactive binding of a port to a host.
+func Create(c *gophercloud.ServiceClient, portID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToBindingCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(createURL(c, portID), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Activate activates the inactive binding of a port to a host, and
+// deactivates the binding which was active.
+func Activate(c *gophercloud.ServiceClient, portID, host string) (r ActivateResult) {
+	resp, err := c.Put(activateURL(c, portID, host), nil, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete deletes the inactive binding of a port to a host.
+func Delete(c *gophercloud.ServiceClient, portID, host string) (r DeleteResult) {
+	resp, err := c.Delete(deleteURL(c, portID, host), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
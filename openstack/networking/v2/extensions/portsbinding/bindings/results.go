@@ -0,0 +1,97 @@
+package bindings
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Statuses of a binding.
+const (
+	StatusActive   = "ACTIVE"
+	StatusInactive = "INACTIVE"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a binding
+// resource.
+func (r commonResult) Extract() (*Binding, error) {
+	var s Binding
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r commonResult) ExtractInto(v interface{}) error {
+	return r.Result.ExtractIntoStructPtr(v, "binding")
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a Binding.
+type CreateResult struct {
+	commonResult
+}
+
+// ActivateResult represents the result of an activate operation. Call its
+// Extract method to interpret it as a Binding.
+type ActivateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// Binding represents the binding of a port to a host.
+type Binding struct {
+	// Host is the host the port is bound to.
+	Host string `json:"host"`
+
+	// Status is the status of the binding, either "ACTIVE" or "INACTIVE".
+	Status string `json:"status"`
+
+	// VIFType is the type of VIF of the binding.
+	VIFType string `json:"vif_type"`
+
+	// VIFDetails are the details of the VIF, which depend on its type.
+	VIFDetails map[string]interface{} `json:"vif_details"`
+
+	// VNICType is the type of vNIC the port is bound to.
+	VNICType string `json:"vnic_type"`
+
+	// Profile is the port-specific information passed to the plug-in.
+	Profile map[string]interface{} `json:"profile"`
+}
+
+// BindingPage is the page returned by a pager when traversing over the
+// bindings of a port.
+type BindingPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty checks whether a BindingPage struct is empty.
+func (r BindingPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractBindings(r)
+	return len(is) == 0, err
+}
+
+// ExtractBindings accepts a Page struct, specifically a BindingPage struct,
+// and extracts the elements into a slice of Binding structs. In other words,
+// a generic collection is mapped into a relevant slice.
+func ExtractBindings(r pagination.Page) ([]Binding, error) {
+	var s []Binding
+	err := ExtractBindingsInto(r, &s)
+	return s, err
+}
+
+// ExtractBindingsInto extracts the elements into a slice of Binding structs.
+func ExtractBindingsInto(r pagination.Page, v interface{}) error {
+	return r.(BindingPage).Result.ExtractIntoSlicePtr(v, "bindings")
+}
@@ -0,0 +1,2 @@
+// bindings unit tests
+package testing
@@ -0,0 +1,134 @@
+package testing
+
+import (
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/portsbinding/bindings"
+)
+
+// BindingsListResult represents raw response for the List request.
+const BindingsListResult = `
+{
+    "bindings": [
+        {
+            "host": "compute-1",
+            "status": "ACTIVE",
+            "vif_type": "hw_veb",
+            "vif_details": {
+                "port_filter": false,
+                "vlan": "100"
+            },
+            "vnic_type": "direct",
+            "profile": {
+                "pci_slot": "0000:03:10.1",
+                "pci_vendor_info": "15b3:1018",
+                "physical_network": "physnet-sriov"
+            }
+        },
+        {
+            "host": "compute-2",
+            "status": "INACTIVE",
+            "vif_type": "hw_veb",
+            "vif_details": {
+                "port_filter": false,
+                "vlan": "100"
+            },
+            "vnic_type": "direct",
+            "profile": {
+                "pci_slot": "0000:04:10.3",
+                "pci_vendor_info": "15b3:1018",
+                "physical_network": "physnet-sriov"
+            }
+        }
+    ]
+}
+`
+
+// Binding1 is the first binding of BindingsListResult.
+var Binding1 = bindings.Binding{
+	Host:    "compute-1",
+	Status:  bindings.StatusActive,
+	VIFType: "hw_veb",
+	VIFDetails: map[string]interface{}{
+		"port_filter": false,
+		"vlan":        "100",
+	},
+	VNICType: "direct",
+	Profile: map[string]interface{}{
+		"pci_slot":         "0000:03:10.1",
+		"pci_vendor_info":  "15b3:1018",
+		"physical_network": "physnet-sriov",
+	},
+}
+
+// Binding2 is the second binding of BindingsListResult.
+var Binding2 = bindings.Binding{
+	Host:    "compute-2",
+	Status:  bindings.StatusInactive,
+	VIFType: "hw_veb",
+	VIFDetails: map[string]interface{}{
+		"port_filter": false,
+		"vlan":        "100",
+	},
+	VNICType: "direct",
+	Profile: map[string]interface{}{
+		"pci_slot":         "0000:04:10.3",
+		"pci_vendor_info":  "15b3:1018",
+		"physical_network": "physnet-sriov",
+	},
+}
+
+// BindingCreateRequest represents raw request for the Create request.
+const BindingCreateRequest = `
+{
+    "binding": {
+        "host": "compute-2",
+        "vnic_type": "direct",
+        "profile": {
+            "pci_slot": "0000:04:10.3",
+            "pci_vendor_info": "15b3:1018",
+            "physical_network": "physnet-sriov"
+        }
+    }
+}
+`
+
+// BindingCreateResult represents raw response for the Create request.
+const BindingCreateResult = `
+{
+    "binding": {
+        "host": "compute-2",
+        "status": "INACTIVE",
+        "vif_type": "hw_veb",
+        "vif_details": {
+            "port_filter": false,
+            "vlan": "100"
+        },
+        "vnic_type": "direct",
+        "profile": {
+            "pci_slot": "0000:04:10.3",
+            "pci_vendor_info": "15b3:1018",
+            "physical_network": "physnet-sriov"
+        }
+    }
+}
+`
+
+// BindingActivateResult represents raw response for the Activate request.
+const BindingActivateResult = `
+{
+    "binding": {
+        "host": "compute-2",
+        "status": "ACTIVE",
+        "vif_type": "hw_veb",
+        "vif_details": {
+            "port_filter": false,
+            "vlan": "100"
+        },
+        "vnic_type": "direct",
+        "profile": {
+            "pci_slot": "0000:04:10.3",
+            "pci_vendor_info": "15b3:1018",
+            "physical_network": "physnet-sriov"
+        }
+    }
+}
+`
@@ -0,0 +1,33 @@
+package bindings
+
+import "github.com/gophercloud/gophercloud"
+
+const (
+	portPath     = "ports"
+	resourcePath = "bindings"
+	activatePath = "activate"
+)
+
+func rootURL(c *gophercloud.ServiceClient, portID string) string {
+	return c.ServiceURL(portPath, portID, resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, portID, host string) string {
+	return c.ServiceURL(portPath, portID, resourcePath, host)
+}
+
+func listURL(c *gophercloud.ServiceClient, portID string) string {
+	return rootURL(c, portID)
+}
+
+func createURL(c *gophercloud.ServiceClient, portID string) string {
+	return rootURL(c, portID)
+}
+
+func activateURL(c *gophercloud.ServiceClient, portID, host string) string {
+	return c.ServiceURL(portPath, portID, resourcePath, host, activatePath)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, portID, host string) string {
+	return resourceURL(c, portID, host)
+}
@@ -1,3 +1,29 @@
-// Package portsbinding provides information and interaction with the port
-// binding extension for the OpenStack Networking service.
+/*
+Package portsbinding provides information and interaction with the port
+binding extension for the OpenStack Networking service.
+
+The binding:profile of a port can be built from the typed SRIOVProfile and
+OVSHardwareOffloadProfile helpers. The bindings of a port to several hosts,
+used during live migrations, are managed by the bindings package.
+
+Example to Create an OVS Hardware Offloaded Port
+
+	profile, err := portsbinding.OVSHardwareOffloadProfile{}.ToBindingProfileMap()
+	if err != nil {
+		panic(err)
+	}
+
+	createOpts := portsbinding.CreateOptsExt{
+		CreateOptsBuilder: ports.CreateOpts{
+			NetworkID: "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+		},
+		VNICType: portsbinding.VNICTypeDirect,
+		Profile:  profile,
+	}
+
+	port, err := ports.Create(networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
 package portsbinding
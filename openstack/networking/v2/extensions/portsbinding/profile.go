@@ -0,0 +1,124 @@
+package portsbinding
+
+import (
+	"encoding/json"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// VNIC types which can be requested through binding:vnic_type.
+const (
+	VNICTypeNormal          = "normal"
+	VNICTypeMacvtap         = "macvtap"
+	VNICTypeDirect          = "direct"
+	VNICTypeDirectPhysical  = "direct-physical"
+	VNICTypeBaremetal       = "baremetal"
+	VNICTypeVirtioForwarder = "virtio-forwarder"
+	VNICTypeSmartNIC        = "smart-nic"
+	VNICTypeRemoteManaged   = "remote-managed"
+)
+
+// CapabilitySwitchdev is the binding:profile capability of a port offloaded to
+// a NIC in switchdev mode by OVS hardware offload.
+const CapabilitySwitchdev = "switchdev"
+
+// ProfileBuilder allows typed binding:profile helpers to be turned into the
+// map expected by the Profile fields.
+type ProfileBuilder interface {
+	ToBindingProfileMap() (map[string]interface{}, error)
+}
+
+// SRIOVProfile is the binding:profile of an SR-IOV port, whose vNIC type is
+// "direct", "direct-physical" or "macvtap". It is usually filled in by the
+// Compute service when it allocates the virtual function.
+type SRIOVProfile struct {
+	// PCISlot is the PCI address of the virtual function, such as
+	// "0000:03:10.1".
+	PCISlot string `json:"pci_slot" required:"true"`
+
+	// PCIVendorInfo is the vendor and product ID of the virtual function,
+	// such as "15b3:1018".
+	PCIVendorInfo string `json:"pci_vendor_info,omitempty"`
+
+	// PhysicalNetwork is the physical network the virtual function is
+	// attached to.
+	PhysicalNetwork string `json:"physical_network" required:"true"`
+}
+
+// ToBindingProfileMap builds a binding:profile from an SRIOVProfile.
+func (p SRIOVProfile) ToBindingProfileMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(p, "")
+}
+
+// OVSHardwareOffloadProfile is the binding:profile of a "direct" port
+// offloaded by OVS to a NIC in switchdev mode. Only the capability is required
+// when the port is created, the other attributes are filled in by the Compute
+// service.
+type OVSHardwareOffloadProfile struct {
+	// PCISlot is the PCI address of the virtual function.
+	PCISlot string `json:"pci_slot,omitempty"`
+
+	// PCIVendorInfo is the vendor and product ID of the virtual function.
+	PCIVendorInfo string `json:"pci_vendor_info,omitempty"`
+
+	// PhysicalNetwork is the physical network the virtual function is
+	// attached to.
+	PhysicalNetwork string `json:"physical_network,omitempty"`
+}
+
+// ToBindingProfileMap builds a binding:profile from an
+// OVSHardwareOffloadProfile, with the switchdev capability.
+func (p OVSHardwareOffloadProfile) ToBindingProfileMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(p, "")
+	if err != nil {
+		return nil, err
+	}
+
+	b["capabilities"] = []string{CapabilitySwitchdev}
+
+	return b, nil
+}
+
+// ExtractSRIOVProfile interprets a binding:profile as an SRIOVProfile. It
+// does not check that the profile is the one of an SR-IOV port.
+func ExtractSRIOVProfile(profile map[string]interface{}) (*SRIOVProfile, error) {
+	var s SRIOVProfile
+	b, err := json.Marshal(profile)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(b, &s)
+	return &s, err
+}
+
+// ProfileCapabilities returns the capabilities of a binding:profile.
+func ProfileCapabilities(profile map[string]interface{}) []string {
+	v, ok := profile["capabilities"]
+	if !ok {
+		return nil
+	}
+
+	var capabilities []string
+	switch c := v.(type) {
+	case []string:
+		capabilities = append(capabilities, c...)
+	case []interface{}:
+		for _, i := range c {
+			if s, ok := i.(string); ok {
+				capabilities = append(capabilities, s)
+			}
+		}
+	}
+	return capabilities
+}
+
+// IsHardwareOffloaded returns true if a binding:profile has the switchdev
+// capability of OVS hardware offload.
+func IsHardwareOffloaded(profile map[string]interface{}) bool {
+	for _, c := range ProfileCapabilities(profile) {
+		if c == CapabilitySwitchdev {
+			return true
+		}
+	}
+	return false
+}
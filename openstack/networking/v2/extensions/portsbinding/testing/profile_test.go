@@ -0,0 +1,91 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/portsbinding"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestSRIOVProfile(t *testing.T) {
+	profile, err := portsbinding.SRIOVProfile{
+		PCISlot:         "0000:03:10.1",
+		PCIVendorInfo:   "15b3:1018",
+		PhysicalNetwork: "physnet-sriov",
+	}.ToBindingProfileMap()
+	th.AssertNoErr(t, err)
+
+	th.AssertDeepEquals(t, map[string]interface{}{
+		"pci_slot":         "0000:03:10.1",
+		"pci_vendor_info":  "15b3:1018",
+		"physical_network": "physnet-sriov",
+	}, profile)
+
+	_, err = portsbinding.SRIOVProfile{PCISlot: "0000:03:10.1"}.ToBindingProfileMap()
+	if err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestOVSHardwareOffloadProfile(t *testing.T) {
+	profile, err := portsbinding.OVSHardwareOffloadProfile{}.ToBindingProfileMap()
+	th.AssertNoErr(t, err)
+
+	th.AssertDeepEquals(t, map[string]interface{}{
+		"capabilities": []string{"switchdev"},
+	}, profile)
+	th.AssertEquals(t, true, portsbinding.IsHardwareOffloaded(profile))
+}
+
+func TestCreateWithProfile(t *testing.T) {
+	profile, err := portsbinding.OVSHardwareOffloadProfile{}.ToBindingProfileMap()
+	th.AssertNoErr(t, err)
+
+	createOpts := portsbinding.CreateOptsExt{
+		CreateOptsBuilder: ports.CreateOpts{
+			NetworkID: "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+		},
+		VNICType: portsbinding.VNICTypeDirect,
+		Profile:  profile,
+	}
+	b, err := createOpts.ToPortCreateMap()
+	th.AssertNoErr(t, err)
+
+	th.AssertJSONEquals(t, `
+{
+    "port": {
+        "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+        "binding:vnic_type": "direct",
+        "binding:profile": {
+            "capabilities": ["switchdev"]
+        }
+    }
+}
+`, b)
+}
+
+func TestExtractProfile(t *testing.T) {
+	// A binding:profile as decoded from a JSON response.
+	profile := map[string]interface{}{
+		"pci_slot":         "0000:03:10.1",
+		"pci_vendor_info":  "15b3:1018",
+		"physical_network": "physnet-sriov",
+		"capabilities":     []interface{}{"switchdev"},
+	}
+
+	sriov, err := portsbinding.ExtractSRIOVProfile(profile)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, portsbinding.SRIOVProfile{
+		PCISlot:         "0000:03:10.1",
+		PCIVendorInfo:   "15b3:1018",
+		PhysicalNetwork: "physnet-sriov",
+	}, *sriov)
+
+	th.AssertDeepEquals(t, []string{"switchdev"}, portsbinding.ProfileCapabilities(profile))
+	th.AssertEquals(t, true, portsbinding.IsHardwareOffloaded(profile))
+
+	delete(profile, "capabilities")
+	th.AssertEquals(t, 0, len(portsbinding.ProfileCapabilities(profile)))
+	th.AssertEquals(t, false, portsbinding.IsHardwareOffloaded(profile))
+}
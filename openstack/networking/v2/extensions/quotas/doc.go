@@ -1,6 +1,22 @@
 /*
 Package quotas provides the ability to retrieve and manage Networking quotas through the Neutron API.
 
+Example to List all projects quotas
+
+	allPages, err := quotas.List(networkClient).AllPages()
+	if err != nil {
+	    log.Fatal(err)
+	}
+
+	allQuotas, err := quotas.ExtractQuotas(allPages)
+	if err != nil {
+	    log.Fatal(err)
+	}
+
+	for _, quota := range allQuotas {
+	    fmt.Printf("%s: %#v\n", quota.ProjectID, quota)
+	}
+
 Example to Get project quotas
 
 	projectID = "23d5d3f79dfa4f73b72b8b0b0063ec55"
@@ -21,6 +37,16 @@ Example to Get a Detailed Quota Set
 
 	fmt.Printf("quotas: %#v\n", quotasInfo)
 
+Example to Get the default quotas
+
+	projectID = "23d5d3f79dfa4f73b72b8b0b0063ec55"
+	quotasInfo, err := quotas.GetDefault(networkClient, projectID).Extract()
+	if err != nil {
+	    log.Fatal(err)
+	}
+
+	fmt.Printf("quotas: %#v\n", quotasInfo)
+
 Example to Update project quotas
 
 	projectID = "23d5d3f79dfa4f73b72b8b0b0063ec55"
@@ -43,5 +69,13 @@ Example to Update project quotas
 	}
 
 	fmt.Printf("quotas: %#v\n", quotasInfo)
+
+Example to Reset project quotas to the defaults
+
+	projectID = "23d5d3f79dfa4f73b72b8b0b0063ec55"
+	err := quotas.Delete(networkClient, projectID).ExtractErr()
+	if err != nil {
+	    log.Fatal(err)
+	}
 */
 package quotas
@@ -1,6 +1,17 @@
 package quotas
 
-import "github.com/gophercloud/gophercloud"
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// List returns the Networking Quotas of the projects whose quotas differ from
+// the default ones.
+func List(client *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(client, listURL(client), func(r pagination.PageResult) pagination.Page {
+		return QuotaPage{pagination.SinglePageBase(r)}
+	})
+}
 
 // Get returns Networking Quotas for a project.
 func Get(client *gophercloud.ServiceClient, projectID string) (r GetResult) {
@@ -16,6 +27,14 @@ func GetDetail(client *gophercloud.ServiceClient, projectID string) (r GetDetail
 	return
 }
 
+// GetDefault returns the default Networking Quotas, which apply to a project
+// whose quotas have not been set.
+func GetDefault(client *gophercloud.ServiceClient, projectID string) (r GetResult) {
+	resp, err := client.Get(getDefaultURL(client, projectID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
 // UpdateOptsBuilder allows extensions to add additional parameters to the
 // Update request.
 type UpdateOptsBuilder interface {
@@ -74,3 +93,10 @@ func Update(c *gophercloud.ServiceClient, projectID string, opts UpdateOptsBuild
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// Delete resets the Networking Quotas of a project to the default ones.
+func Delete(c *gophercloud.ServiceClient, projectID string) (r DeleteResult) {
+	resp, err := c.Delete(deleteURL(c, projectID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
 )
 
 type commonResult struct {
@@ -52,8 +53,42 @@ type UpdateResult struct {
 	commonResult
 }
 
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// QuotaPage stores a single page of all Quota results from a List call.
+type QuotaPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not a QuotaPage is empty.
+func (r QuotaPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	quotas, err := ExtractQuotas(r)
+	return len(quotas) == 0, err
+}
+
+// ExtractQuotas interprets a page of results as a slice of Quotas.
+func ExtractQuotas(r pagination.Page) ([]Quota, error) {
+	var s struct {
+		Quotas []Quota `json:"quotas"`
+	}
+	err := (r.(QuotaPage)).ExtractInto(&s)
+	return s.Quotas, err
+}
+
 // Quota contains Networking quotas for a project.
 type Quota struct {
+	// ProjectID is the ID of the project the quotas apply to. It is only set
+	// by List.
+	ProjectID string `json:"project_id"`
+
 	// FloatingIP represents a number of floating IPs. A "-1" value means no limit.
 	FloatingIP int `json:"floatingip"`
 
@@ -140,3 +140,99 @@ var UpdateResponse = quotas.Quota{
 	SubnetPool:        0,
 	Trunk:             5,
 }
+
+// ListResponseRaw is a sample response to a List call.
+const ListResponseRaw = `
+{
+    "quotas": [
+        {
+            "project_id": "0a73845280574ad389c292f6a74afa76",
+            "floatingip": 15,
+            "network": 20,
+            "port": 25,
+            "rbac_policy": -1,
+            "router": 30,
+            "security_group": 35,
+            "security_group_rule": 40,
+            "subnet": 45,
+            "subnetpool": -1,
+            "trunk": 50
+        },
+        {
+            "project_id": "b6a1e1e7a9c24e7c9f3a1d9fe9c34e2b",
+            "floatingip": 0,
+            "network": -1,
+            "port": 5,
+            "rbac_policy": 10,
+            "router": 15,
+            "security_group": 20,
+            "security_group_rule": -1,
+            "subnet": 25,
+            "subnetpool": 0,
+            "trunk": 5
+        }
+    ]
+}
+`
+
+// ListResponse is the expected result of ListResponseRaw.
+var ListResponse = []quotas.Quota{
+	{
+		ProjectID:         "0a73845280574ad389c292f6a74afa76",
+		FloatingIP:        15,
+		Network:           20,
+		Port:              25,
+		RBACPolicy:        -1,
+		Router:            30,
+		SecurityGroup:     35,
+		SecurityGroupRule: 40,
+		Subnet:            45,
+		SubnetPool:        -1,
+		Trunk:             50,
+	},
+	{
+		ProjectID:         "b6a1e1e7a9c24e7c9f3a1d9fe9c34e2b",
+		FloatingIP:        0,
+		Network:           -1,
+		Port:              5,
+		RBACPolicy:        10,
+		Router:            15,
+		SecurityGroup:     20,
+		SecurityGroupRule: -1,
+		Subnet:            25,
+		SubnetPool:        0,
+		Trunk:             5,
+	},
+}
+
+// GetDefaultResponseRaw is a sample response to a GetDefault call.
+const GetDefaultResponseRaw = `
+{
+    "quota": {
+        "floatingip": 50,
+        "network": 100,
+        "port": 500,
+        "rbac_policy": 10,
+        "router": 10,
+        "security_group": 10,
+        "security_group_rule": 100,
+        "subnet": 100,
+        "subnetpool": -1,
+        "trunk": -1
+    }
+}
+`
+
+// GetDefaultResponse is the expected result of GetDefaultResponseRaw.
+var GetDefaultResponse = quotas.Quota{
+	FloatingIP:        50,
+	Network:           100,
+	Port:              500,
+	RBACPolicy:        10,
+	Router:            10,
+	SecurityGroup:     10,
+	SecurityGroupRule: 100,
+	Subnet:            100,
+	SubnetPool:        -1,
+	Trunk:             -1,
+}
@@ -4,6 +4,11 @@ import "github.com/gophercloud/gophercloud"
 
 const resourcePath = "quotas"
 const resourcePathDetail = "details.json"
+const resourcePathDefault = "default"
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
 
 func resourceURL(c *gophercloud.ServiceClient, projectID string) string {
 	return c.ServiceURL(resourcePath, projectID)
@@ -13,6 +18,14 @@ func resourceDetailURL(c *gophercloud.ServiceClient, projectID string) string {
 	return c.ServiceURL(resourcePath, projectID, resourcePathDetail)
 }
 
+func resourceDefaultURL(c *gophercloud.ServiceClient, projectID string) string {
+	return c.ServiceURL(resourcePath, projectID, resourcePathDefault)
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
 func getURL(c *gophercloud.ServiceClient, projectID string) string {
 	return resourceURL(c, projectID)
 }
@@ -24,3 +37,11 @@ func getDetailURL(c *gophercloud.ServiceClient, projectID string) string {
 func updateURL(c *gophercloud.ServiceClient, projectID string) string {
 	return resourceURL(c, projectID)
 }
+
+func getDefaultURL(c *gophercloud.ServiceClient, projectID string) string {
+	return resourceDefaultURL(c, projectID)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, projectID string) string {
+	return resourceURL(c, projectID)
+}
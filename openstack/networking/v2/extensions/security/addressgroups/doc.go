@@ -0,0 +1,84 @@
+/*
+Package addressgroups provides information and interaction with Address Groups
+for the OpenStack Networking service.
+
+An address group is a set of IP addresses and CIDRs, which a security group
+rule can match through its RemoteAddressGroupID.
+
+Example to List Address Groups
+
+	listOpts := addressgroups.ListOpts{
+		ProjectID: "966b3c7d36a24facaf20b7e458bf2192",
+	}
+
+	allPages, err := addressgroups.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allAddressGroups, err := addressgroups.ExtractAddressGroups(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, addressGroup := range allAddressGroups {
+		fmt.Printf("%+v\n", addressGroup)
+	}
+
+Example to Create an Address Group
+
+	createOpts := addressgroups.CreateOpts{
+		Name:        "backends",
+		Description: "Backend servers",
+		Addresses: []string{
+			"10.0.0.10/32",
+			"10.0.1.0/24",
+		},
+	}
+
+	addressGroup, err := addressgroups.Create(networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update an Address Group
+
+	addressGroupID := "8722e0e0-9cc9-4490-9660-8c9a5732fbb0"
+
+	name := "frontends"
+	updateOpts := addressgroups.UpdateOpts{
+		Name: &name,
+	}
+
+	addressGroup, err := addressgroups.Update(networkClient, addressGroupID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Add and Remove Addresses of an Address Group
+
+	addressGroupID := "8722e0e0-9cc9-4490-9660-8c9a5732fbb0"
+
+	addressGroup, err := addressgroups.AddAddresses(networkClient, addressGroupID, addressgroups.AddressesOpts{
+		Addresses: []string{"10.0.2.0/24"},
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	addressGroup, err = addressgroups.RemoveAddresses(networkClient, addressGroupID, addressgroups.AddressesOpts{
+		Addresses: []string{"10.0.0.10/32"},
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete an Address Group
+
+	addressGroupID := "8722e0e0-9cc9-4490-9660-8c9a5732fbb0"
+	err := addressgroups.Delete(networkClient, addressGroupID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package addressgroups
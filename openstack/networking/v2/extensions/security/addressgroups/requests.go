@@ -0,0 +1,188 @@
+package addressgroups
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToAddressGroupListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the address group attributes you want to see returned. SortKey allows you to
+// sort by a particular address group attribute. SortDir sets the direction,
+// and is either `asc' or `desc'. Marker and Limit are used for pagination.
+type ListOpts struct {
+	ID          string `q:"id"`
+	Name        string `q:"name"`
+	Description string `q:"description"`
+	ProjectID   string `q:"project_id"`
+	Marker      string `q:"marker"`
+	Limit       int    `q:"limit"`
+	SortKey     string `q:"sort_key"`
+	SortDir     string `q:"sort_dir"`
+}
+
+// ToAddressGroupListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToAddressGroupListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// address groups. It accepts a ListOpts struct, which allows you to filter
+// and sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToAddressGroupListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return AddressGroupPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific address group based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(getURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToAddressGroupCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts represents options used to create an address group.
+type CreateOpts struct {
+	// Name is a human-readable name of the address group.
+	Name string `json:"name,omitempty"`
+
+	// Description of the address group.
+	Description string `json:"description,omitempty"`
+
+	// ProjectID is the UUID of the project who owns the address group.
+	// Only administrative users can specify a project UUID other than their
+	// own.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// Addresses are the IP addresses or CIDRs of the address group.
+	Addresses []string `json:"addresses,omitempty"`
+}
+
+// ToAddressGroupCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToAddressGroupCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "address_group")
+}
+
+// Create accepts a CreateOpts struct and creates a new address group using
+// the values provided.
+func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToAddressGroupCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(createURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToAddressGroupUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts represents options used to update an address group. Its
+// addresses are updated with AddAddresses and RemoveAddresses.
+type UpdateOpts struct {
+	// Name is a human-readable name of the address group.
+	Name *string `json:"name,omitempty"`
+
+	// Description of the address group.
+	Description *string `json:"description,omitempty"`
+}
+
+// ToAddressGroupUpdateMap builds a request body from UpdateOpts.
+func (opts UpdateOpts) ToAddressGroupUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "address_group")
+}
+
+// Update accepts a UpdateOpts struct and updates an existing address group
+// using the values provided.
+func Update(c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToAddressGroupUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(updateURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete accepts a unique ID and deletes the address group associated with it.
+func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(deleteURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// AddressesOptsBuilder allows extensions to add additional parameters to the
+// AddAddresses and RemoveAddresses requests.
+type AddressesOptsBuilder interface {
+	ToAddressGroupAddressesMap() (map[string]interface{}, error)
+}
+
+// AddressesOpts represents the addresses to add to or remove from an address
+// group.
+type AddressesOpts struct {
+	// Addresses are the IP addresses or CIDRs to add or remove.
+	Addresses []string `json:"addresses" required:"true"`
+}
+
+// ToAddressGroupAddressesMap builds a request body from AddressesOpts.
+func (opts AddressesOpts) ToAddressGroupAddressesMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// AddAddresses adds addresses to an existing address group.
+func AddAddresses(c *gophercloud.ServiceClient, id string, opts AddressesOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToAddressGroupAddressesMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(addAddressesURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// RemoveAddresses removes addresses from an existing address group.
+func RemoveAddresses(c *gophercloud.ServiceClient, id string, opts AddressesOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToAddressGroupAddressesMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(removeAddressesURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
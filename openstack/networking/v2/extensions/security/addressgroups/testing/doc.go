@@ -0,0 +1,2 @@
+// addressgroups unit tests
+package testing
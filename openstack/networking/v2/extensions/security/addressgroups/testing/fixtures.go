@@ -0,0 +1,159 @@
+package testing
+
+import (
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/addressgroups"
+)
+
+// AddressGroupsListResult represents raw response for the List request.
+const AddressGroupsListResult = `
+{
+    "address_groups": [
+        {
+            "id": "8722e0e0-9cc9-4490-9660-8c9a5732fbb0",
+            "name": "backends",
+            "description": "Backend servers",
+            "project_id": "45977fa2dbd7482098dd68d0d8970117",
+            "addresses": [
+                "10.0.0.10/32",
+                "10.0.1.0/24"
+            ]
+        },
+        {
+            "id": "1f5a8c1e-9b1d-4b7c-a7c2-6b34e8e9a9b4",
+            "name": "admins",
+            "description": "",
+            "project_id": "45977fa2dbd7482098dd68d0d8970117",
+            "addresses": [
+                "2001:db8::/64"
+            ]
+        }
+    ]
+}
+`
+
+// AddressGroup1 is the first address group of AddressGroupsListResult.
+var AddressGroup1 = addressgroups.AddressGroup{
+	ID:          "8722e0e0-9cc9-4490-9660-8c9a5732fbb0",
+	Name:        "backends",
+	Description: "Backend servers",
+	ProjectID:   "45977fa2dbd7482098dd68d0d8970117",
+	Addresses:   []string{"10.0.0.10/32", "10.0.1.0/24"},
+}
+
+// AddressGroup2 is the second address group of AddressGroupsListResult.
+var AddressGroup2 = addressgroups.AddressGroup{
+	ID:        "1f5a8c1e-9b1d-4b7c-a7c2-6b34e8e9a9b4",
+	Name:      "admins",
+	ProjectID: "45977fa2dbd7482098dd68d0d8970117",
+	Addresses: []string{"2001:db8::/64"},
+}
+
+// AddressGroupGetResult represents raw response for the Get request.
+const AddressGroupGetResult = `
+{
+    "address_group": {
+        "id": "8722e0e0-9cc9-4490-9660-8c9a5732fbb0",
+        "name": "backends",
+        "description": "Backend servers",
+        "project_id": "45977fa2dbd7482098dd68d0d8970117",
+        "addresses": [
+            "10.0.0.10/32",
+            "10.0.1.0/24"
+        ]
+    }
+}
+`
+
+// AddressGroupCreateRequest represents raw request for the Create request.
+const AddressGroupCreateRequest = `
+{
+    "address_group": {
+        "name": "backends",
+        "description": "Backend servers",
+        "addresses": [
+            "10.0.0.10/32",
+            "10.0.1.0/24"
+        ]
+    }
+}
+`
+
+// AddressGroupUpdateRequest represents raw request for the Update request.
+const AddressGroupUpdateRequest = `
+{
+    "address_group": {
+        "name": "frontends",
+        "description": ""
+    }
+}
+`
+
+// AddressGroupUpdateResult represents raw response for the Update request.
+const AddressGroupUpdateResult = `
+{
+    "address_group": {
+        "id": "8722e0e0-9cc9-4490-9660-8c9a5732fbb0",
+        "name": "frontends",
+        "description": "",
+        "project_id": "45977fa2dbd7482098dd68d0d8970117",
+        "addresses": [
+            "10.0.0.10/32",
+            "10.0.1.0/24"
+        ]
+    }
+}
+`
+
+// AddressGroupAddAddressesRequest represents raw request for the
+// AddAddresses request.
+const AddressGroupAddAddressesRequest = `
+{
+    "addresses": [
+        "10.0.2.0/24"
+    ]
+}
+`
+
+// AddressGroupAddAddressesResult represents raw response for the
+// AddAddresses request.
+const AddressGroupAddAddressesResult = `
+{
+    "address_group": {
+        "id": "8722e0e0-9cc9-4490-9660-8c9a5732fbb0",
+        "name": "backends",
+        "description": "Backend servers",
+        "project_id": "45977fa2dbd7482098dd68d0d8970117",
+        "addresses": [
+            "10.0.0.10/32",
+            "10.0.1.0/24",
+            "10.0.2.0/24"
+        ]
+    }
+}
+`
+
+// AddressGroupRemoveAddressesRequest represents raw request for the
+// RemoveAddresses request.
+const AddressGroupRemoveAddressesRequest = `
+{
+    "addresses": [
+        "10.0.0.10/32"
+    ]
+}
+`
+
+// AddressGroupRemoveAddressesResult represents raw response for the
+// RemoveAddresses request.
+const AddressGroupRemoveAddressesResult = `
+{
+    "address_group": {
+        "id": "8722e0e0-9cc9-4490-9660-8c9a5732fbb0",
+        "name": "backends",
+        "description": "Backend servers",
+        "project_id": "45977fa2dbd7482098dd68d0d8970117",
+        "addresses": [
+            "10.0.1.0/24"
+        ]
+    }
+}
+`
@@ -0,0 +1,210 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/addressgroups"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/address-groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"project_id": "45977fa2dbd7482098dd68d0d8970117",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, AddressGroupsListResult)
+	})
+
+	count := 0
+
+	listOpts := addressgroups.ListOpts{
+		ProjectID: "45977fa2dbd7482098dd68d0d8970117",
+	}
+	err := addressgroups.List(fake.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := addressgroups.ExtractAddressGroups(page)
+		if err != nil {
+			t.Errorf("Failed to extract address groups: %v", err)
+			return false, nil
+		}
+
+		expected := []addressgroups.AddressGroup{
+			AddressGroup1,
+			AddressGroup2,
+		}
+
+		th.CheckDeepEquals(t, expected, actual)
+
+		return true, nil
+	})
+
+	th.AssertNoErr(t, err)
+
+	if count != 1 {
+		t.Errorf("Expected 1 page, got %d", count)
+	}
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/address-groups/8722e0e0-9cc9-4490-9660-8c9a5732fbb0", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, AddressGroupGetResult)
+	})
+
+	ag, err := addressgroups.Get(fake.ServiceClient(), "8722e0e0-9cc9-4490-9660-8c9a5732fbb0").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, AddressGroup1, *ag)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/address-groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, AddressGroupCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, AddressGroupGetResult)
+	})
+
+	createOpts := addressgroups.CreateOpts{
+		Name:        "backends",
+		Description: "Backend servers",
+		Addresses:   []string{"10.0.0.10/32", "10.0.1.0/24"},
+	}
+
+	ag, err := addressgroups.Create(fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, AddressGroup1, *ag)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/address-groups/8722e0e0-9cc9-4490-9660-8c9a5732fbb0", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, AddressGroupUpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, AddressGroupUpdateResult)
+	})
+
+	name := "frontends"
+	description := ""
+	updateOpts := addressgroups.UpdateOpts{
+		Name:        &name,
+		Description: &description,
+	}
+
+	ag, err := addressgroups.Update(fake.ServiceClient(), "8722e0e0-9cc9-4490-9660-8c9a5732fbb0", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "frontends", ag.Name)
+	th.AssertEquals(t, "", ag.Description)
+}
+
+func TestAddAddresses(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/address-groups/8722e0e0-9cc9-4490-9660-8c9a5732fbb0/add_addresses", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, AddressGroupAddAddressesRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, AddressGroupAddAddressesResult)
+	})
+
+	opts := addressgroups.AddressesOpts{
+		Addresses: []string{"10.0.2.0/24"},
+	}
+
+	ag, err := addressgroups.AddAddresses(fake.ServiceClient(), "8722e0e0-9cc9-4490-9660-8c9a5732fbb0", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{"10.0.0.10/32", "10.0.1.0/24", "10.0.2.0/24"}, ag.Addresses)
+}
+
+func TestRemoveAddresses(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/address-groups/8722e0e0-9cc9-4490-9660-8c9a5732fbb0/remove_addresses", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, AddressGroupRemoveAddressesRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, AddressGroupRemoveAddressesResult)
+	})
+
+	opts := addressgroups.AddressesOpts{
+		Addresses: []string{"10.0.0.10/32"},
+	}
+
+	ag, err := addressgroups.RemoveAddresses(fake.ServiceClient(), "8722e0e0-9cc9-4490-9660-8c9a5732fbb0", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{"10.0.1.0/24"}, ag.Addresses)
+}
+
+func TestRequiredAddressesOpts(t *testing.T) {
+	res := addressgroups.AddAddresses(fake.ServiceClient(), "8722e0e0-9cc9-4490-9660-8c9a5732fbb0", addressgroups.AddressesOpts{})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/address-groups/8722e0e0-9cc9-4490-9660-8c9a5732fbb0", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := addressgroups.Delete(fake.ServiceClient(), "8722e0e0-9cc9-4490-9660-8c9a5732fbb0")
+	th.AssertNoErr(t, res.Err)
+}
@@ -4,7 +4,8 @@
 // Security groups and security group rules allows administrators and tenants
 // the ability to specify the type of traffic and direction (ingress/egress)
 // that is allowed to pass through a port. A security group is a container for
-// security group rules.
+// security group rules. Security group rules can match a set of IP addresses
+// and CIDRs by referencing an address group.
 //
 // When a port is created in Networking it is associated with a security group.
 // If a security group is not specified the port is associated with a 'default'
@@ -39,6 +39,23 @@ Example to Create a Security Group Rule
 		panic(err)
 	}
 
+Example to Create a Security Group Rule Matching an Address Group
+
+	createOpts := rules.CreateOpts{
+		Direction:            "ingress",
+		PortRangeMin:         443,
+		EtherType:            rules.EtherType4,
+		PortRangeMax:         443,
+		Protocol:             "tcp",
+		RemoteAddressGroupID: "8722e0e0-9cc9-4490-9660-8c9a5732fbb0",
+		SecGroupID:           "a7734e61-b545-452d-a3cd-0189cbd9747a",
+	}
+
+	rule, err := rules.Create(networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Delete a Security Group Rule
 
 	ruleID := "37d94f8a-d136-465c-ae46-144f0d8ef141"
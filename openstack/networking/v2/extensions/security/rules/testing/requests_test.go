@@ -162,6 +162,67 @@ func TestCreate(t *testing.T) {
 	th.AssertNoErr(t, err)
 }
 
+func TestCreateWithRemoteAddressGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/security-group-rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "security_group_rule": {
+        "direction": "ingress",
+        "port_range_min": 443,
+        "ethertype": "IPv4",
+        "port_range_max": 443,
+        "protocol": "tcp",
+        "remote_address_group_id": "8722e0e0-9cc9-4490-9660-8c9a5732fbb0",
+        "security_group_id": "a7734e61-b545-452d-a3cd-0189cbd9747a"
+    }
+}
+      `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, `
+{
+    "security_group_rule": {
+        "description": "",
+        "direction": "ingress",
+        "ethertype": "IPv4",
+        "id": "2bc0accf-312e-429a-956e-e4407625eb62",
+        "port_range_max": 443,
+        "port_range_min": 443,
+        "protocol": "tcp",
+        "remote_group_id": null,
+        "remote_ip_prefix": null,
+        "remote_address_group_id": "8722e0e0-9cc9-4490-9660-8c9a5732fbb0",
+        "security_group_id": "a7734e61-b545-452d-a3cd-0189cbd9747a",
+        "tenant_id": "e4f50856753b4dc6afee5fa6b9b6c550"
+    }
+}
+    `)
+	})
+
+	opts := rules.CreateOpts{
+		Direction:            "ingress",
+		PortRangeMin:         443,
+		EtherType:            rules.EtherType4,
+		PortRangeMax:         443,
+		Protocol:             "tcp",
+		RemoteAddressGroupID: "8722e0e0-9cc9-4490-9660-8c9a5732fbb0",
+		SecGroupID:           "a7734e61-b545-452d-a3cd-0189cbd9747a",
+	}
+	rule, err := rules.Create(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "8722e0e0-9cc9-4490-9660-8c9a5732fbb0", rule.RemoteAddressGroupID)
+	th.AssertEquals(t, "", rule.RemoteGroupID)
+}
+
 func TestRequiredCreateOpts(t *testing.T) {
 	res := rules.Create(fake.ServiceClient(), rules.CreateOpts{Direction: rules.DirIngress})
 	if res.Err == nil {
@@ -0,0 +1,84 @@
+/*
+Package segments provides the ability to retrieve and manage network segments
+through the Neutron API. Segments are the layer 2 domains of routed provider
+networks; subnets are associated with a segment through their SegmentID.
+
+Example of Listing Segments
+
+	listOpts := segments.ListOpts{
+		NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+	}
+
+	allPages, err := segments.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allSegments, err := segments.ExtractSegments(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, segment := range allSegments {
+		fmt.Printf("%+v\n", segment)
+	}
+
+Example to Get a Segment
+
+	segmentID := "83a59912-a473-4d1b-a2c7-b4b6a6f0a2a4"
+	segment, err := segments.Get(networkClient, segmentID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Segment and a Subnet in It
+
+	segmentationID := 2016
+	createOpts := segments.CreateOpts{
+		NetworkID:       "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+		Name:            "rack-2",
+		NetworkType:     "vlan",
+		PhysicalNetwork: "physnet-rack2",
+		SegmentationID:  &segmentationID,
+	}
+
+	segment, err := segments.Create(networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	subnetOpts := subnets.CreateOpts{
+		NetworkID: segment.NetworkID,
+		SegmentID: segment.ID,
+		CIDR:      "203.0.113.0/24",
+		IPVersion: 4,
+	}
+
+	subnet, err := subnets.Create(networkClient, subnetOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Segment
+
+	segmentID := "83a59912-a473-4d1b-a2c7-b4b6a6f0a2a4"
+
+	name := "rack-2-vlan"
+	updateOpts := segments.UpdateOpts{
+		Name: &name,
+	}
+
+	segment, err := segments.Update(networkClient, segmentID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Segment
+
+	segmentID := "83a59912-a473-4d1b-a2c7-b4b6a6f0a2a4"
+	err := segments.Delete(networkClient, segmentID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package segments
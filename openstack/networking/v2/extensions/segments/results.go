@@ -0,0 +1,165 @@
+package segments
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a segment resource.
+func (r commonResult) Extract() (*Segment, error) {
+	var s Segment
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r commonResult) ExtractInto(v interface{}) error {
+	return r.Result.ExtractIntoStructPtr(v, "segment")
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a Segment.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a Segment.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a Segment.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// Segment represents a layer 2 segment of a network.
+type Segment struct {
+	// ID is the unique ID of the segment.
+	ID string `json:"id"`
+
+	// NetworkID is the ID of the network the segment belongs to.
+	NetworkID string `json:"network_id"`
+
+	// Name is the human-readable name of the segment.
+	Name string `json:"name"`
+
+	// Description of the segment.
+	Description string `json:"description"`
+
+	// PhysicalNetwork is the name of the physical network of the segment.
+	PhysicalNetwork string `json:"physical_network"`
+
+	// NetworkType is the type of the physical network.
+	NetworkType string `json:"network_type"`
+
+	// SegmentationID is the ID of the segment on the physical network. It is
+	// nil for flat segments.
+	SegmentationID *int `json:"segmentation_id"`
+
+	// UpdatedAt and CreatedAt contain ISO-8601 timestamps of when the state of
+	// the segment last changed, and when it was created.
+	UpdatedAt time.Time `json:"-"`
+	CreatedAt time.Time `json:"-"`
+
+	// Tags optionally set via extensions/attributestags
+	Tags []string `json:"tags"`
+
+	// RevisionNumber optionally set via extensions/standard-attr-revisions
+	RevisionNumber int `json:"revision_number"`
+}
+
+func (r *Segment) UnmarshalJSON(b []byte) error {
+	type tmp Segment
+
+	// Support for older neutron time format
+	var s1 struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339NoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339NoZ `json:"updated_at"`
+	}
+
+	err := json.Unmarshal(b, &s1)
+	if err == nil {
+		*r = Segment(s1.tmp)
+		r.CreatedAt = time.Time(s1.CreatedAt)
+		r.UpdatedAt = time.Time(s1.UpdatedAt)
+
+		return nil
+	}
+
+	// Support for newer neutron time format
+	var s2 struct {
+		tmp
+		CreatedAt time.Time `json:"created_at"`
+		UpdatedAt time.Time `json:"updated_at"`
+	}
+
+	err = json.Unmarshal(b, &s2)
+	if err != nil {
+		return err
+	}
+
+	*r = Segment(s2.tmp)
+	r.CreatedAt = time.Time(s2.CreatedAt)
+	r.UpdatedAt = time.Time(s2.UpdatedAt)
+
+	return nil
+}
+
+// SegmentPage is the page returned by a pager when traversing over a
+// collection of segments.
+type SegmentPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of segments has reached
+// the end of a page and the pager seeks to traverse over a new one. In order
+// to do this, it needs to construct the next page's URL.
+func (r SegmentPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"segments_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a SegmentPage struct is empty.
+func (r SegmentPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractSegments(r)
+	return len(is) == 0, err
+}
+
+// ExtractSegments accepts a Page struct, specifically a SegmentPage struct,
+// and extracts the elements into a slice of Segment structs. In other words,
+// a generic collection is mapped into a relevant slice.
+func ExtractSegments(r pagination.Page) ([]Segment, error) {
+	var s []Segment
+	err := ExtractSegmentsInto(r, &s)
+	return s, err
+}
+
+func ExtractSegmentsInto(r pagination.Page, v interface{}) error {
+	return r.(SegmentPage).Result.ExtractIntoSlicePtr(v, "segments")
+}
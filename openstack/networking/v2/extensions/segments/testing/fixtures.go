@@ -0,0 +1,131 @@
+package testing
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/segments"
+)
+
+// SegmentsListResult represents raw response for the List request.
+const SegmentsListResult = `
+{
+    "segments": [
+        {
+            "id": "83a59912-a473-4d1b-a2c7-b4b6a6f0a2a4",
+            "network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+            "name": "rack-1",
+            "description": "",
+            "physical_network": "physnet-rack1",
+            "network_type": "vlan",
+            "segmentation_id": 2016,
+            "tags": ["routed"],
+            "revision_number": 1,
+            "created_at": "2019-06-30T04:15:37Z",
+            "updated_at": "2019-06-30T05:18:49Z"
+        },
+        {
+            "id": "a4ab1c1e-8a6e-4d1a-9a0b-5e7b5e2c9d1f",
+            "network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+            "name": "rack-2",
+            "description": "flat segment",
+            "physical_network": "physnet-rack2",
+            "network_type": "flat",
+            "segmentation_id": null,
+            "tags": [],
+            "revision_number": 0,
+            "created_at": "2019-06-30T04:16:01",
+            "updated_at": "2019-06-30T04:16:01"
+        }
+    ]
+}
+`
+
+var segmentationID = 2016
+
+// Segment1 is the first segment of SegmentsListResult.
+var Segment1 = segments.Segment{
+	ID:              "83a59912-a473-4d1b-a2c7-b4b6a6f0a2a4",
+	NetworkID:       "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+	Name:            "rack-1",
+	PhysicalNetwork: "physnet-rack1",
+	NetworkType:     "vlan",
+	SegmentationID:  &segmentationID,
+	Tags:            []string{"routed"},
+	RevisionNumber:  1,
+	CreatedAt:       time.Date(2019, 6, 30, 4, 15, 37, 0, time.UTC),
+	UpdatedAt:       time.Date(2019, 6, 30, 5, 18, 49, 0, time.UTC),
+}
+
+// Segment2 is the second segment of SegmentsListResult.
+var Segment2 = segments.Segment{
+	ID:              "a4ab1c1e-8a6e-4d1a-9a0b-5e7b5e2c9d1f",
+	NetworkID:       "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+	Name:            "rack-2",
+	Description:     "flat segment",
+	PhysicalNetwork: "physnet-rack2",
+	NetworkType:     "flat",
+	Tags:            []string{},
+	CreatedAt:       time.Date(2019, 6, 30, 4, 16, 1, 0, time.UTC),
+	UpdatedAt:       time.Date(2019, 6, 30, 4, 16, 1, 0, time.UTC),
+}
+
+// SegmentGetResult represents raw response for the Get request.
+const SegmentGetResult = `
+{
+    "segment": {
+        "id": "83a59912-a473-4d1b-a2c7-b4b6a6f0a2a4",
+        "network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+        "name": "rack-1",
+        "description": "",
+        "physical_network": "physnet-rack1",
+        "network_type": "vlan",
+        "segmentation_id": 2016,
+        "tags": ["routed"],
+        "revision_number": 1,
+        "created_at": "2019-06-30T04:15:37Z",
+        "updated_at": "2019-06-30T05:18:49Z"
+    }
+}
+`
+
+// SegmentCreateRequest represents raw request for the Create request.
+const SegmentCreateRequest = `
+{
+    "segment": {
+        "network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+        "name": "rack-1",
+        "physical_network": "physnet-rack1",
+        "network_type": "vlan",
+        "segmentation_id": 2016
+    }
+}
+`
+
+// SegmentUpdateRequest represents raw request for the Update request.
+const SegmentUpdateRequest = `
+{
+    "segment": {
+        "name": "rack-1-vlan",
+        "description": "first rack"
+    }
+}
+`
+
+// SegmentUpdateResult represents raw response for the Update request.
+const SegmentUpdateResult = `
+{
+    "segment": {
+        "id": "83a59912-a473-4d1b-a2c7-b4b6a6f0a2a4",
+        "network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+        "name": "rack-1-vlan",
+        "description": "first rack",
+        "physical_network": "physnet-rack1",
+        "network_type": "vlan",
+        "segmentation_id": 2016,
+        "tags": ["routed"],
+        "revision_number": 2,
+        "created_at": "2019-06-30T04:15:37Z",
+        "updated_at": "2019-07-01T08:00:00Z"
+    }
+}
+`
@@ -0,0 +1,169 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/segments"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/segments", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+			"tags":       "routed",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, SegmentsListResult)
+	})
+
+	count := 0
+
+	listOpts := segments.ListOpts{
+		NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+		Tags:      "routed",
+	}
+	err := segments.List(fake.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := segments.ExtractSegments(page)
+		if err != nil {
+			t.Errorf("Failed to extract segments: %v", err)
+			return false, nil
+		}
+
+		expected := []segments.Segment{
+			Segment1,
+			Segment2,
+		}
+
+		th.CheckDeepEquals(t, expected, actual)
+
+		return true, nil
+	})
+
+	th.AssertNoErr(t, err)
+
+	if count != 1 {
+		t.Errorf("Expected 1 page, got %d", count)
+	}
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/segments/83a59912-a473-4d1b-a2c7-b4b6a6f0a2a4", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, SegmentGetResult)
+	})
+
+	s, err := segments.Get(fake.ServiceClient(), "83a59912-a473-4d1b-a2c7-b4b6a6f0a2a4").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, Segment1, *s)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/segments", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, SegmentCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, SegmentGetResult)
+	})
+
+	segmentationID := 2016
+	createOpts := segments.CreateOpts{
+		NetworkID:       "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+		Name:            "rack-1",
+		PhysicalNetwork: "physnet-rack1",
+		NetworkType:     "vlan",
+		SegmentationID:  &segmentationID,
+	}
+
+	s, err := segments.Create(fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, Segment1, *s)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	res := segments.Create(fake.ServiceClient(), segments.CreateOpts{
+		NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+	})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/segments/83a59912-a473-4d1b-a2c7-b4b6a6f0a2a4", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "If-Match", "revision_number=1")
+		th.TestJSONRequest(t, r, SegmentUpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, SegmentUpdateResult)
+	})
+
+	name := "rack-1-vlan"
+	description := "first rack"
+	revisionNumber := 1
+	updateOpts := segments.UpdateOpts{
+		Name:           &name,
+		Description:    &description,
+		RevisionNumber: &revisionNumber,
+	}
+
+	s, err := segments.Update(fake.ServiceClient(), "83a59912-a473-4d1b-a2c7-b4b6a6f0a2a4", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "rack-1-vlan", s.Name)
+	th.AssertEquals(t, "first rack", s.Description)
+	th.AssertEquals(t, 2, s.RevisionNumber)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/segments/83a59912-a473-4d1b-a2c7-b4b6a6f0a2a4", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := segments.Delete(fake.ServiceClient(), "83a59912-a473-4d1b-a2c7-b4b6a6f0a2a4")
+	th.AssertNoErr(t, res.Err)
+}
@@ -0,0 +1,3 @@
+// Package sfc provides information and interaction with the Service Function
+// Chaining (networking-sfc) extension for the OpenStack Networking service.
+package sfc
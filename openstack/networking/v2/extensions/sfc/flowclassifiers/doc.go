@@ -0,0 +1,50 @@
+/*
+Package flowclassifiers allows management of the flow classifiers of service
+function chains in the Openstack Network Service. A flow classifier selects
+the traffic steered into a port chain.
+
+Example to List Flow Classifiers
+
+	listOpts := flowclassifiers.ListOpts{
+		LogicalSourcePort: "563a2f1c-4b6a-4a0e-9a4c-2b1f5c6e7d8a",
+	}
+
+	allPages, err := flowclassifiers.List(client, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allClassifiers, err := flowclassifiers.ExtractFlowClassifiers(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, classifier := range allClassifiers {
+		fmt.Printf("%+v\n", classifier)
+	}
+
+Example to Create a Flow Classifier
+
+	createOpts := flowclassifiers.CreateOpts{
+		Name:                    "http",
+		EtherType:               flowclassifiers.EtherType4,
+		Protocol:                "tcp",
+		DestinationPortRangeMin: 80,
+		DestinationPortRangeMax: 80,
+		SourceIPPrefix:          "10.0.0.0/24",
+		LogicalSourcePort:       "563a2f1c-4b6a-4a0e-9a4c-2b1f5c6e7d8a",
+	}
+
+	classifier, err := flowclassifiers.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Flow Classifier
+
+	err := flowclassifiers.Delete(client, "4a334cd4-fe9c-4fae-af4b-321c5e2eb051").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package flowclassifiers
@@ -0,0 +1,188 @@
+package flowclassifiers
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// EtherType is the L3 protocol of a flow classifier.
+type EtherType string
+
+const (
+	EtherType4 EtherType = "IPv4"
+	EtherType6 EtherType = "IPv6"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToFlowClassifierCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains all the values needed to create a new flow classifier.
+type CreateOpts struct {
+	// TenantID specifies a tenant to own the flow classifier. The caller must
+	// have an admin role in order to set this. Otherwise, this field is left
+	// unset and the caller will be the owner.
+	TenantID string `json:"tenant_id,omitempty"`
+
+	// ProjectID specifies a project to own the flow classifier.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// Name is the human readable name of the flow classifier.
+	Name string `json:"name,omitempty"`
+
+	// Description is the human readable description of the flow classifier.
+	Description string `json:"description,omitempty"`
+
+	// EtherType is the L3 protocol. It defaults to IPv4.
+	EtherType EtherType `json:"ethertype,omitempty"`
+
+	// Protocol is the IP protocol, such as "tcp", "udp" or "icmp".
+	Protocol string `json:"protocol,omitempty"`
+
+	// SourcePortRangeMin is the lowest source port.
+	SourcePortRangeMin int `json:"source_port_range_min,omitempty"`
+
+	// SourcePortRangeMax is the highest source port.
+	SourcePortRangeMax int `json:"source_port_range_max,omitempty"`
+
+	// DestinationPortRangeMin is the lowest destination port.
+	DestinationPortRangeMin int `json:"destination_port_range_min,omitempty"`
+
+	// DestinationPortRangeMax is the highest destination port.
+	DestinationPortRangeMax int `json:"destination_port_range_max,omitempty"`
+
+	// SourceIPPrefix is the source IP prefix, in CIDR notation.
+	SourceIPPrefix string `json:"source_ip_prefix,omitempty"`
+
+	// DestinationIPPrefix is the destination IP prefix, in CIDR notation.
+	DestinationIPPrefix string `json:"destination_ip_prefix,omitempty"`
+
+	// LogicalSourcePort is the ID of the port the traffic originates from.
+	// The Open vSwitch driver requires it.
+	LogicalSourcePort string `json:"logical_source_port,omitempty"`
+
+	// LogicalDestinationPort is the ID of the port the traffic is destined to.
+	LogicalDestinationPort string `json:"logical_destination_port,omitempty"`
+
+	// L7Parameters are the L7 parameters of the classification.
+	L7Parameters map[string]interface{} `json:"l7_parameters,omitempty"`
+}
+
+// ToFlowClassifierCreateMap casts a CreateOpts struct to a map.
+func (opts CreateOpts) ToFlowClassifierCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "flow_classifier")
+}
+
+// Create accepts a CreateOpts struct and uses the values to create a new
+// flow classifier.
+func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToFlowClassifierCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(rootURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves a particular flow classifier based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(resourceURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToFlowClassifierListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the flow classifier attributes you want to see returned. SortKey allows you
+// to sort by a particular attribute. SortDir sets the direction, and is
+// either `asc' or `desc'. Marker and Limit are used for pagination.
+type ListOpts struct {
+	ID                     string `q:"id"`
+	TenantID               string `q:"tenant_id"`
+	ProjectID              string `q:"project_id"`
+	Name                   string `q:"name"`
+	Description            string `q:"description"`
+	EtherType              string `q:"ethertype"`
+	Protocol               string `q:"protocol"`
+	SourceIPPrefix         string `q:"source_ip_prefix"`
+	DestinationIPPrefix    string `q:"destination_ip_prefix"`
+	LogicalSourcePort      string `q:"logical_source_port"`
+	LogicalDestinationPort string `q:"logical_destination_port"`
+	Marker                 string `q:"marker"`
+	Limit                  int    `q:"limit"`
+	SortKey                string `q:"sort_key"`
+	SortDir                string `q:"sort_dir"`
+}
+
+// ToFlowClassifierListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToFlowClassifierListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// flow classifiers. It accepts a ListOpts struct, which allows you to filter
+// and sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToFlowClassifierListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return FlowClassifierPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Delete will permanently delete a particular flow classifier based on its
+// unique ID.
+func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(resourceURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToFlowClassifierUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains the values used when updating a flow classifier. The
+// classification itself cannot be updated.
+type UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ToFlowClassifierUpdateMap casts an UpdateOpts struct to a map.
+func (opts UpdateOpts) ToFlowClassifierUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "flow_classifier")
+}
+
+// Update allows flow classifiers to be updated.
+func Update(c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToFlowClassifierUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(resourceURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
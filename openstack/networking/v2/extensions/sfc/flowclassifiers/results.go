@@ -0,0 +1,136 @@
+package flowclassifiers
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// FlowClassifier selects the traffic steered into a port chain.
+type FlowClassifier struct {
+	// ID is the unique ID of the flow classifier.
+	ID string `json:"id"`
+
+	// TenantID is the ID of the tenant owning the flow classifier.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the ID of the project owning the flow classifier.
+	ProjectID string `json:"project_id"`
+
+	// Name is the human readable name of the flow classifier.
+	Name string `json:"name"`
+
+	// Description is the human readable description of the flow classifier.
+	Description string `json:"description"`
+
+	// EtherType is the L3 protocol, either IPv4 or IPv6.
+	EtherType string `json:"ethertype"`
+
+	// Protocol is the IP protocol.
+	Protocol string `json:"protocol"`
+
+	// SourcePortRangeMin is the lowest source port.
+	SourcePortRangeMin int `json:"source_port_range_min"`
+
+	// SourcePortRangeMax is the highest source port.
+	SourcePortRangeMax int `json:"source_port_range_max"`
+
+	// DestinationPortRangeMin is the lowest destination port.
+	DestinationPortRangeMin int `json:"destination_port_range_min"`
+
+	// DestinationPortRangeMax is the highest destination port.
+	DestinationPortRangeMax int `json:"destination_port_range_max"`
+
+	// SourceIPPrefix is the source IP prefix, in CIDR notation.
+	SourceIPPrefix string `json:"source_ip_prefix"`
+
+	// DestinationIPPrefix is the destination IP prefix, in CIDR notation.
+	DestinationIPPrefix string `json:"destination_ip_prefix"`
+
+	// LogicalSourcePort is the ID of the port the traffic originates from.
+	LogicalSourcePort string `json:"logical_source_port"`
+
+	// LogicalDestinationPort is the ID of the port the traffic is destined to.
+	LogicalDestinationPort string `json:"logical_destination_port"`
+
+	// L7Parameters are the L7 parameters of the classification.
+	L7Parameters map[string]interface{} `json:"l7_parameters"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a flow classifier.
+func (r commonResult) Extract() (*FlowClassifier, error) {
+	var s struct {
+		FlowClassifier *FlowClassifier `json:"flow_classifier"`
+	}
+	err := r.ExtractInto(&s)
+	return s.FlowClassifier, err
+}
+
+// FlowClassifierPage is the page returned by a pager when traversing over a
+// collection of flow classifiers.
+type FlowClassifierPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of flow classifiers has
+// reached the end of a page and the pager seeks to traverse over a new one. In
+// order to do this, it needs to construct the next page's URL.
+func (r FlowClassifierPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"flow_classifiers_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a FlowClassifierPage struct is empty.
+func (r FlowClassifierPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractFlowClassifiers(r)
+	return len(is) == 0, err
+}
+
+// ExtractFlowClassifiers accepts a Page struct, specifically a
+// FlowClassifierPage struct, and extracts the elements into a slice of
+// FlowClassifier structs. In other words, a generic collection is mapped into a
+// relevant slice.
+func ExtractFlowClassifiers(r pagination.Page) ([]FlowClassifier, error) {
+	var s struct {
+		FlowClassifiers []FlowClassifier `json:"flow_classifiers"`
+	}
+	err := (r.(FlowClassifierPage)).ExtractInto(&s)
+	return s.FlowClassifiers, err
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a FlowClassifier.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract method
+// to interpret it as a FlowClassifier.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult represents the results of a Delete operation. Call its
+// ExtractErr method to determine whether the operation succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a FlowClassifier.
+type UpdateResult struct {
+	commonResult
+}
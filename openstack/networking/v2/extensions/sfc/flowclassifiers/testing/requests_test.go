@@ -0,0 +1,231 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/sfc/flowclassifiers"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+const flowClassifierResult = `
+{
+    "flow_classifier": {
+        "id": "4a334cd4-fe9c-4fae-af4b-321c5e2eb051",
+        "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+        "project_id": "d382007aa9904763a801f68ecf065cf5",
+        "name": "http",
+        "description": "HTTP traffic",
+        "ethertype": "IPv4",
+        "protocol": "tcp",
+        "source_port_range_min": null,
+        "source_port_range_max": null,
+        "destination_port_range_min": 80,
+        "destination_port_range_max": 80,
+        "source_ip_prefix": "10.0.0.0/24",
+        "destination_ip_prefix": null,
+        "logical_source_port": "563a2f1c-4b6a-4a0e-9a4c-2b1f5c6e7d8a",
+        "logical_destination_port": null,
+        "l7_parameters": {}
+    }
+}
+`
+
+var flowClassifier = flowclassifiers.FlowClassifier{
+	ID:                      "4a334cd4-fe9c-4fae-af4b-321c5e2eb051",
+	TenantID:                "d382007aa9904763a801f68ecf065cf5",
+	ProjectID:               "d382007aa9904763a801f68ecf065cf5",
+	Name:                    "http",
+	Description:             "HTTP traffic",
+	EtherType:               "IPv4",
+	Protocol:                "tcp",
+	DestinationPortRangeMin: 80,
+	DestinationPortRangeMax: 80,
+	SourceIPPrefix:          "10.0.0.0/24",
+	LogicalSourcePort:       "563a2f1c-4b6a-4a0e-9a4c-2b1f5c6e7d8a",
+	L7Parameters:            map[string]interface{}{},
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/flow_classifiers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "flow_classifier": {
+        "name": "http",
+        "description": "HTTP traffic",
+        "ethertype": "IPv4",
+        "protocol": "tcp",
+        "destination_port_range_min": 80,
+        "destination_port_range_max": 80,
+        "source_ip_prefix": "10.0.0.0/24",
+        "logical_source_port": "563a2f1c-4b6a-4a0e-9a4c-2b1f5c6e7d8a"
+    }
+}
+        `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, flowClassifierResult)
+	})
+
+	options := flowclassifiers.CreateOpts{
+		Name:                    "http",
+		Description:             "HTTP traffic",
+		EtherType:               flowclassifiers.EtherType4,
+		Protocol:                "tcp",
+		DestinationPortRangeMin: 80,
+		DestinationPortRangeMax: 80,
+		SourceIPPrefix:          "10.0.0.0/24",
+		LogicalSourcePort:       "563a2f1c-4b6a-4a0e-9a4c-2b1f5c6e7d8a",
+	}
+	actual, err := flowclassifiers.Create(fake.ServiceClient(), options).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, flowClassifier, *actual)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/flow_classifiers/4a334cd4-fe9c-4fae-af4b-321c5e2eb051", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, flowClassifierResult)
+	})
+
+	actual, err := flowclassifiers.Get(fake.ServiceClient(), "4a334cd4-fe9c-4fae-af4b-321c5e2eb051").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, flowClassifier, *actual)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/flow_classifiers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"protocol":            "tcp",
+			"logical_source_port": "563a2f1c-4b6a-4a0e-9a4c-2b1f5c6e7d8a",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `
+{
+    "flow_classifiers": [
+        {
+            "id": "4a334cd4-fe9c-4fae-af4b-321c5e2eb051",
+            "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+            "project_id": "d382007aa9904763a801f68ecf065cf5",
+            "name": "http",
+            "description": "HTTP traffic",
+            "ethertype": "IPv4",
+            "protocol": "tcp",
+            "source_port_range_min": null,
+            "source_port_range_max": null,
+            "destination_port_range_min": 80,
+            "destination_port_range_max": 80,
+            "source_ip_prefix": "10.0.0.0/24",
+            "destination_ip_prefix": null,
+            "logical_source_port": "563a2f1c-4b6a-4a0e-9a4c-2b1f5c6e7d8a",
+            "logical_destination_port": null,
+            "l7_parameters": {}
+        }
+    ]
+}
+        `)
+	})
+
+	listOpts := flowclassifiers.ListOpts{
+		Protocol:          "tcp",
+		LogicalSourcePort: "563a2f1c-4b6a-4a0e-9a4c-2b1f5c6e7d8a",
+	}
+
+	count := 0
+	err := flowclassifiers.List(fake.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := flowclassifiers.ExtractFlowClassifiers(page)
+		if err != nil {
+			t.Errorf("Failed to extract flow classifiers: %v", err)
+			return false, err
+		}
+		th.CheckDeepEquals(t, []flowclassifiers.FlowClassifier{flowClassifier}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/flow_classifiers/4a334cd4-fe9c-4fae-af4b-321c5e2eb051", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "flow_classifier": {
+        "description": "Web traffic"
+    }
+}
+        `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `
+{
+    "flow_classifier": {
+        "id": "4a334cd4-fe9c-4fae-af4b-321c5e2eb051",
+        "name": "http",
+        "description": "Web traffic",
+        "ethertype": "IPv4",
+        "protocol": "tcp"
+    }
+}
+        `)
+	})
+
+	description := "Web traffic"
+	updateOpts := flowclassifiers.UpdateOpts{
+		Description: &description,
+	}
+	actual, err := flowclassifiers.Update(fake.ServiceClient(), "4a334cd4-fe9c-4fae-af4b-321c5e2eb051", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "Web traffic", actual.Description)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/flow_classifiers/4a334cd4-fe9c-4fae-af4b-321c5e2eb051", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := flowclassifiers.Delete(fake.ServiceClient(), "4a334cd4-fe9c-4fae-af4b-321c5e2eb051")
+	th.AssertNoErr(t, res.Err)
+}
@@ -0,0 +1,16 @@
+package flowclassifiers
+
+import "github.com/gophercloud/gophercloud"
+
+const (
+	rootPath     = "sfc"
+	resourcePath = "flow_classifiers"
+)
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath, resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, resourcePath, id)
+}
@@ -0,0 +1,66 @@
+/*
+Package portchains allows management of service function chains in the
+Openstack Network Service. A port chain steers the traffic selected by its
+flow classifiers through its port pair groups, in order.
+
+Example to List Port Chains
+
+	allPages, err := portchains.List(client, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allChains, err := portchains.ExtractPortChains(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, chain := range allChains {
+		fmt.Printf("%+v\n", chain)
+	}
+
+Example to Create a Port Chain
+
+	createOpts := portchains.CreateOpts{
+		Name: "web-chain",
+		PortPairGroups: []string{
+			"4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+			"4a634d49-76dc-4fae-af4b-321c5e23d651",
+		},
+		FlowClassifiers: []string{
+			"4a334cd4-fe9c-4fae-af4b-321c5e2eb051",
+		},
+		ChainParameters: &portchains.ChainParameters{
+			Correlation: portchains.CorrelationMPLS,
+			Symmetric:   true,
+		},
+	}
+
+	chain, err := portchains.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update the Flow Classifiers of a Port Chain
+
+	flowClassifiers := []string{
+		"4a334cd4-fe9c-4fae-af4b-321c5e2eb051",
+		"105a4b0a-73d6-11e8-8f5d-0f1e5a8e2a3b",
+	}
+	updateOpts := portchains.UpdateOpts{
+		FlowClassifiers: &flowClassifiers,
+	}
+
+	chain, err := portchains.Update(client, "1278dcd4-459f-62ed-754b-87fc5e4a6751", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Port Chain
+
+	err := portchains.Delete(client, "1278dcd4-459f-62ed-754b-87fc5e4a6751").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package portchains
@@ -0,0 +1,172 @@
+package portchains
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Correlation is the type of the chain header of a port chain.
+type Correlation string
+
+const (
+	CorrelationMPLS Correlation = "mpls"
+	CorrelationNSH  Correlation = "nsh"
+)
+
+// ChainParameters are the parameters of a port chain.
+type ChainParameters struct {
+	// Correlation is the type of the chain header. It defaults to "mpls".
+	Correlation Correlation `json:"correlation,omitempty"`
+
+	// Symmetric also steers the reverse traffic through the chain.
+	Symmetric bool `json:"symmetric,omitempty"`
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToPortChainCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains all the values needed to create a new port chain.
+type CreateOpts struct {
+	// TenantID specifies a tenant to own the port chain. The caller must have
+	// an admin role in order to set this. Otherwise, this field is left unset
+	// and the caller will be the owner.
+	TenantID string `json:"tenant_id,omitempty"`
+
+	// ProjectID specifies a project to own the port chain.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// Name is the human readable name of the port chain.
+	Name string `json:"name,omitempty"`
+
+	// Description is the human readable description of the port chain.
+	Description string `json:"description,omitempty"`
+
+	// PortPairGroups are the IDs of the port pair groups of the chain, in
+	// order.
+	PortPairGroups []string `json:"port_pair_groups" required:"true"`
+
+	// FlowClassifiers are the IDs of the flow classifiers of the chain.
+	FlowClassifiers []string `json:"flow_classifiers,omitempty"`
+
+	// ChainParameters are the parameters of the chain.
+	ChainParameters *ChainParameters `json:"chain_parameters,omitempty"`
+
+	// ChainID is the numeric ID of the chain. One is allocated if unset.
+	ChainID int `json:"chain_id,omitempty"`
+}
+
+// ToPortChainCreateMap casts a CreateOpts struct to a map.
+func (opts CreateOpts) ToPortChainCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "port_chain")
+}
+
+// Create accepts a CreateOpts struct and uses the values to create a new
+// port chain.
+func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToPortChainCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(rootURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves a particular port chain based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(resourceURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToPortChainListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the port chain attributes you want to see returned. SortKey allows you to
+// sort by a particular port chain attribute. SortDir sets the direction, and
+// is either `asc' or `desc'. Marker and Limit are used for pagination.
+type ListOpts struct {
+	ID          string `q:"id"`
+	TenantID    string `q:"tenant_id"`
+	ProjectID   string `q:"project_id"`
+	Name        string `q:"name"`
+	Description string `q:"description"`
+	ChainID     int    `q:"chain_id"`
+	Marker      string `q:"marker"`
+	Limit       int    `q:"limit"`
+	SortKey     string `q:"sort_key"`
+	SortDir     string `q:"sort_dir"`
+}
+
+// ToPortChainListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToPortChainListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// port chains. It accepts a ListOpts struct, which allows you to filter and
+// sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToPortChainListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return PortChainPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Delete will permanently delete a particular port chain based on its unique
+// ID.
+func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(resourceURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToPortChainUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains the values used when updating a port chain.
+type UpdateOpts struct {
+	Name            *string   `json:"name,omitempty"`
+	Description     *string   `json:"description,omitempty"`
+	PortPairGroups  *[]string `json:"port_pair_groups,omitempty"`
+	FlowClassifiers *[]string `json:"flow_classifiers,omitempty"`
+}
+
+// ToPortChainUpdateMap casts an UpdateOpts struct to a map.
+func (opts UpdateOpts) ToPortChainUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "port_chain")
+}
+
+// Update allows port chains to be updated.
+func Update(c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToPortChainUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(resourceURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,117 @@
+package portchains
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// PortChain steers the traffic selected by its flow classifiers through its
+// port pair groups, in order.
+type PortChain struct {
+	// ID is the unique ID of the port chain.
+	ID string `json:"id"`
+
+	// ChainID is the numeric ID of the port chain, used as the path ID in the
+	// chain headers.
+	ChainID int `json:"chain_id"`
+
+	// TenantID is the ID of the tenant owning the port chain.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the ID of the project owning the port chain.
+	ProjectID string `json:"project_id"`
+
+	// Name is the human readable name of the port chain.
+	Name string `json:"name"`
+
+	// Description is the human readable description of the port chain.
+	Description string `json:"description"`
+
+	// PortPairGroups are the IDs of the port pair groups of the chain, in
+	// order.
+	PortPairGroups []string `json:"port_pair_groups"`
+
+	// FlowClassifiers are the IDs of the flow classifiers of the chain.
+	FlowClassifiers []string `json:"flow_classifiers"`
+
+	// ChainParameters are the parameters of the chain.
+	ChainParameters ChainParameters `json:"chain_parameters"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a port chain.
+func (r commonResult) Extract() (*PortChain, error) {
+	var s struct {
+		PortChain *PortChain `json:"port_chain"`
+	}
+	err := r.ExtractInto(&s)
+	return s.PortChain, err
+}
+
+// PortChainPage is the page returned by a pager when traversing over a
+// collection of port chains.
+type PortChainPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of port chains has reached
+// the end of a page and the pager seeks to traverse over a new one. In order to
+// do this, it needs to construct the next page's URL.
+func (r PortChainPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"port_chains_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a PortChainPage struct is empty.
+func (r PortChainPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractPortChains(r)
+	return len(is) == 0, err
+}
+
+// ExtractPortChains accepts a Page struct, specifically a PortChainPage struct,
+// and extracts the elements into a slice of PortChain structs. In other words,
+// a generic collection is mapped into a relevant slice.
+func ExtractPortChains(r pagination.Page) ([]PortChain, error) {
+	var s struct {
+		PortChains []PortChain `json:"port_chains"`
+	}
+	err := (r.(PortChainPage)).ExtractInto(&s)
+	return s.PortChains, err
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a PortChain.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract method
+// to interpret it as a PortChain.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult represents the results of a Delete operation. Call its
+// ExtractErr method to determine whether the operation succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a PortChain.
+type UpdateResult struct {
+	commonResult
+}
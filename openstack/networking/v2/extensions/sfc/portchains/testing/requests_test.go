@@ -0,0 +1,262 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/sfc/portchains"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+const portChainResult = `
+{
+    "port_chain": {
+        "id": "1278dcd4-459f-62ed-754b-87fc5e4a6751",
+        "chain_id": 7,
+        "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+        "project_id": "d382007aa9904763a801f68ecf065cf5",
+        "name": "web-chain",
+        "description": "Steers HTTP through the firewalls",
+        "port_pair_groups": [
+            "4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+            "4a634d49-76dc-4fae-af4b-321c5e23d651"
+        ],
+        "flow_classifiers": [
+            "4a334cd4-fe9c-4fae-af4b-321c5e2eb051"
+        ],
+        "chain_parameters": {
+            "correlation": "mpls",
+            "symmetric": true
+        }
+    }
+}
+`
+
+var portChain = portchains.PortChain{
+	ID:          "1278dcd4-459f-62ed-754b-87fc5e4a6751",
+	ChainID:     7,
+	TenantID:    "d382007aa9904763a801f68ecf065cf5",
+	ProjectID:   "d382007aa9904763a801f68ecf065cf5",
+	Name:        "web-chain",
+	Description: "Steers HTTP through the firewalls",
+	PortPairGroups: []string{
+		"4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+		"4a634d49-76dc-4fae-af4b-321c5e23d651",
+	},
+	FlowClassifiers: []string{
+		"4a334cd4-fe9c-4fae-af4b-321c5e2eb051",
+	},
+	ChainParameters: portchains.ChainParameters{
+		Correlation: portchains.CorrelationMPLS,
+		Symmetric:   true,
+	},
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_chains", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "port_chain": {
+        "name": "web-chain",
+        "description": "Steers HTTP through the firewalls",
+        "port_pair_groups": [
+            "4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+            "4a634d49-76dc-4fae-af4b-321c5e23d651"
+        ],
+        "flow_classifiers": [
+            "4a334cd4-fe9c-4fae-af4b-321c5e2eb051"
+        ],
+        "chain_parameters": {
+            "correlation": "mpls",
+            "symmetric": true
+        }
+    }
+}
+        `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, portChainResult)
+	})
+
+	options := portchains.CreateOpts{
+		Name:        "web-chain",
+		Description: "Steers HTTP through the firewalls",
+		PortPairGroups: []string{
+			"4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+			"4a634d49-76dc-4fae-af4b-321c5e23d651",
+		},
+		FlowClassifiers: []string{
+			"4a334cd4-fe9c-4fae-af4b-321c5e2eb051",
+		},
+		ChainParameters: &portchains.ChainParameters{
+			Correlation: portchains.CorrelationMPLS,
+			Symmetric:   true,
+		},
+	}
+	actual, err := portchains.Create(fake.ServiceClient(), options).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, portChain, *actual)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	res := portchains.Create(fake.ServiceClient(), portchains.CreateOpts{Name: "web-chain"})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_chains/1278dcd4-459f-62ed-754b-87fc5e4a6751", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, portChainResult)
+	})
+
+	actual, err := portchains.Get(fake.ServiceClient(), "1278dcd4-459f-62ed-754b-87fc5e4a6751").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, portChain, *actual)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_chains", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"sort_key": "name",
+			"sort_dir": "asc",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `
+{
+    "port_chains": [
+        {
+            "id": "1278dcd4-459f-62ed-754b-87fc5e4a6751",
+            "chain_id": 7,
+            "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+            "project_id": "d382007aa9904763a801f68ecf065cf5",
+            "name": "web-chain",
+            "description": "Steers HTTP through the firewalls",
+            "port_pair_groups": [
+                "4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+                "4a634d49-76dc-4fae-af4b-321c5e23d651"
+            ],
+            "flow_classifiers": [
+                "4a334cd4-fe9c-4fae-af4b-321c5e2eb051"
+            ],
+            "chain_parameters": {
+                "correlation": "mpls",
+                "symmetric": true
+            }
+        }
+    ]
+}
+        `)
+	})
+
+	listOpts := portchains.ListOpts{
+		SortKey: "name",
+		SortDir: "asc",
+	}
+
+	count := 0
+	err := portchains.List(fake.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := portchains.ExtractPortChains(page)
+		if err != nil {
+			t.Errorf("Failed to extract port chains: %v", err)
+			return false, err
+		}
+		th.CheckDeepEquals(t, []portchains.PortChain{portChain}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_chains/1278dcd4-459f-62ed-754b-87fc5e4a6751", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "port_chain": {
+        "flow_classifiers": []
+    }
+}
+        `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `
+{
+    "port_chain": {
+        "id": "1278dcd4-459f-62ed-754b-87fc5e4a6751",
+        "chain_id": 7,
+        "name": "web-chain",
+        "port_pair_groups": [
+            "4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+            "4a634d49-76dc-4fae-af4b-321c5e23d651"
+        ],
+        "flow_classifiers": [],
+        "chain_parameters": {
+            "correlation": "mpls",
+            "symmetric": true
+        }
+    }
+}
+        `)
+	})
+
+	flowClassifiers := []string{}
+	updateOpts := portchains.UpdateOpts{
+		FlowClassifiers: &flowClassifiers,
+	}
+	actual, err := portchains.Update(fake.ServiceClient(), "1278dcd4-459f-62ed-754b-87fc5e4a6751", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, len(actual.FlowClassifiers))
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_chains/1278dcd4-459f-62ed-754b-87fc5e4a6751", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := portchains.Delete(fake.ServiceClient(), "1278dcd4-459f-62ed-754b-87fc5e4a6751")
+	th.AssertNoErr(t, res.Err)
+}
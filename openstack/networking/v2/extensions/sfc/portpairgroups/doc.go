@@ -0,0 +1,59 @@
+/*
+Package portpairgroups allows management of the port pair groups of service
+function chains in the Openstack Network Service. The traffic of a chain is
+load balanced over the port pairs of each of its groups.
+
+Example to List Port Pair Groups
+
+	allPages, err := portpairgroups.List(client, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allGroups, err := portpairgroups.ExtractPortPairGroups(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, group := range allGroups {
+		fmt.Printf("%+v\n", group)
+	}
+
+Example to Create a Port Pair Group
+
+	createOpts := portpairgroups.CreateOpts{
+		Name: "firewalls",
+		PortPairs: []string{
+			"78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae",
+			"d11e9190-73d4-11e8-b7ef-7b31f2ff9e55",
+		},
+		PortPairGroupParameters: &portpairgroups.PortPairGroupParameters{
+			LBFields: []string{"ip_src", "ip_dst"},
+		},
+	}
+
+	group, err := portpairgroups.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Replace the Port Pairs of a Port Pair Group
+
+	portPairs := []string{"78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae"}
+	updateOpts := portpairgroups.UpdateOpts{
+		PortPairs: &portPairs,
+	}
+
+	group, err := portpairgroups.Update(client, "4512d643-24fc-4fae-af4b-321c5e2eb3d1", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Port Pair Group
+
+	err := portpairgroups.Delete(client, "4512d643-24fc-4fae-af4b-321c5e2eb3d1").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package portpairgroups
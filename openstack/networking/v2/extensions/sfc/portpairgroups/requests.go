@@ -0,0 +1,169 @@
+package portpairgroups
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// NTupleMapping rewrites the n-tuple of the packets entering or leaving the
+// service functions of a group, for service functions that modify it, such
+// as NAT. Keys are among source_ip_prefix_ingress, source_port_range_min,
+// destination_ip_prefix, and so forth.
+type NTupleMapping struct {
+	IngressNTuple map[string]interface{} `json:"ingress_n_tuple,omitempty"`
+	EgressNTuple  map[string]interface{} `json:"egress_n_tuple,omitempty"`
+}
+
+// PortPairGroupParameters are the parameters of a port pair group.
+type PortPairGroupParameters struct {
+	// LBFields are the fields the traffic is load balanced on, such as
+	// "ip_src" or "tcp_dst".
+	LBFields []string `json:"lb_fields,omitempty"`
+
+	// NTupleMapping is the n-tuple mapping of the group.
+	NTupleMapping *NTupleMapping `json:"ppg_n_tuple_mapping,omitempty"`
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToPortPairGroupCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains all the values needed to create a new port pair group.
+type CreateOpts struct {
+	// TenantID specifies a tenant to own the port pair group. The caller must
+	// have an admin role in order to set this. Otherwise, this field is left
+	// unset and the caller will be the owner.
+	TenantID string `json:"tenant_id,omitempty"`
+
+	// ProjectID specifies a project to own the port pair group.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// Name is the human readable name of the port pair group.
+	Name string `json:"name,omitempty"`
+
+	// Description is the human readable description of the port pair group.
+	Description string `json:"description,omitempty"`
+
+	// PortPairs are the IDs of the port pairs of the group.
+	PortPairs []string `json:"port_pairs"`
+
+	// PortPairGroupParameters are the parameters of the group.
+	PortPairGroupParameters *PortPairGroupParameters `json:"port_pair_group_parameters,omitempty"`
+
+	// TapEnabled makes the port pairs of the group passive taps.
+	TapEnabled *bool `json:"tap_enabled,omitempty"`
+}
+
+// ToPortPairGroupCreateMap casts a CreateOpts struct to a map.
+func (opts CreateOpts) ToPortPairGroupCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "port_pair_group")
+}
+
+// Create accepts a CreateOpts struct and uses the values to create a new
+// port pair group.
+func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToPortPairGroupCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(rootURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves a particular port pair group based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(resourceURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToPortPairGroupListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the port pair group attributes you want to see returned. SortKey allows you
+// to sort by a particular attribute. SortDir sets the direction, and is
+// either `asc' or `desc'. Marker and Limit are used for pagination.
+type ListOpts struct {
+	ID          string `q:"id"`
+	TenantID    string `q:"tenant_id"`
+	ProjectID   string `q:"project_id"`
+	Name        string `q:"name"`
+	Description string `q:"description"`
+	TapEnabled  *bool  `q:"tap_enabled"`
+	Marker      string `q:"marker"`
+	Limit       int    `q:"limit"`
+	SortKey     string `q:"sort_key"`
+	SortDir     string `q:"sort_dir"`
+}
+
+// ToPortPairGroupListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToPortPairGroupListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// port pair groups. It accepts a ListOpts struct, which allows you to filter
+// and sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToPortPairGroupListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return PortPairGroupPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Delete will permanently delete a particular port pair group based on its
+// unique ID.
+func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(resourceURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToPortPairGroupUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains the values used when updating a port pair group.
+type UpdateOpts struct {
+	Name        *string   `json:"name,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	PortPairs   *[]string `json:"port_pairs,omitempty"`
+}
+
+// ToPortPairGroupUpdateMap casts an UpdateOpts struct to a map.
+func (opts UpdateOpts) ToPortPairGroupUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "port_pair_group")
+}
+
+// Update allows port pair groups to be updated.
+func Update(c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToPortPairGroupUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(resourceURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
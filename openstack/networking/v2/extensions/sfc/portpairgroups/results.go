@@ -0,0 +1,116 @@
+package portpairgroups
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// PortPairGroup is a group of port pairs providing the same service function,
+// over which the traffic of a chain is load balanced.
+type PortPairGroup struct {
+	// ID is the unique ID of the port pair group.
+	ID string `json:"id"`
+
+	// GroupID is the numeric ID of the port pair group.
+	GroupID int `json:"group_id"`
+
+	// TenantID is the ID of the tenant owning the port pair group.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the ID of the project owning the port pair group.
+	ProjectID string `json:"project_id"`
+
+	// Name is the human readable name of the port pair group.
+	Name string `json:"name"`
+
+	// Description is the human readable description of the port pair group.
+	Description string `json:"description"`
+
+	// PortPairs are the IDs of the port pairs of the group.
+	PortPairs []string `json:"port_pairs"`
+
+	// PortPairGroupParameters are the parameters of the group.
+	PortPairGroupParameters PortPairGroupParameters `json:"port_pair_group_parameters"`
+
+	// TapEnabled indicates whether the port pairs of the group are passive
+	// taps, which receive a copy of the traffic.
+	TapEnabled bool `json:"tap_enabled"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a port pair group.
+func (r commonResult) Extract() (*PortPairGroup, error) {
+	var s struct {
+		PortPairGroup *PortPairGroup `json:"port_pair_group"`
+	}
+	err := r.ExtractInto(&s)
+	return s.PortPairGroup, err
+}
+
+// PortPairGroupPage is the page returned by a pager when traversing over a
+// collection of port pair groups.
+type PortPairGroupPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of port pair groups has
+// reached the end of a page and the pager seeks to traverse over a new one. In
+// order to do this, it needs to construct the next page's URL.
+func (r PortPairGroupPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"port_pair_groups_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a PortPairGroupPage struct is empty.
+func (r PortPairGroupPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractPortPairGroups(r)
+	return len(is) == 0, err
+}
+
+// ExtractPortPairGroups accepts a Page struct, specifically a PortPairGroupPage
+// struct, and extracts the elements into a slice of PortPairGroup structs. In
+// other words, a generic collection is mapped into a relevant slice.
+func ExtractPortPairGroups(r pagination.Page) ([]PortPairGroup, error) {
+	var s struct {
+		PortPairGroups []PortPairGroup `json:"port_pair_groups"`
+	}
+	err := (r.(PortPairGroupPage)).ExtractInto(&s)
+	return s.PortPairGroups, err
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a PortPairGroup.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract method
+// to interpret it as a PortPairGroup.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult represents the results of a Delete operation. Call its
+// ExtractErr method to determine whether the operation succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a PortPairGroup.
+type UpdateResult struct {
+	commonResult
+}
@@ -0,0 +1,250 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/sfc/portpairgroups"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+const portPairGroupResult = `
+{
+    "port_pair_group": {
+        "id": "4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+        "group_id": 1,
+        "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+        "project_id": "d382007aa9904763a801f68ecf065cf5",
+        "name": "firewalls",
+        "description": "Firewall SF instances",
+        "port_pairs": [
+            "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae",
+            "d11e9190-73d4-11e8-b7ef-7b31f2ff9e55"
+        ],
+        "port_pair_group_parameters": {
+            "lb_fields": ["ip_src", "ip_dst"],
+            "ppg_n_tuple_mapping": {
+                "ingress_n_tuple": {"source_ip_prefix": "10.0.0.0/24"},
+                "egress_n_tuple": {"source_ip_prefix": "192.168.0.0/24"}
+            }
+        },
+        "tap_enabled": false
+    }
+}
+`
+
+var portPairGroup = portpairgroups.PortPairGroup{
+	ID:          "4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+	GroupID:     1,
+	TenantID:    "d382007aa9904763a801f68ecf065cf5",
+	ProjectID:   "d382007aa9904763a801f68ecf065cf5",
+	Name:        "firewalls",
+	Description: "Firewall SF instances",
+	PortPairs: []string{
+		"78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae",
+		"d11e9190-73d4-11e8-b7ef-7b31f2ff9e55",
+	},
+	PortPairGroupParameters: portpairgroups.PortPairGroupParameters{
+		LBFields: []string{"ip_src", "ip_dst"},
+		NTupleMapping: &portpairgroups.NTupleMapping{
+			IngressNTuple: map[string]interface{}{"source_ip_prefix": "10.0.0.0/24"},
+			EgressNTuple:  map[string]interface{}{"source_ip_prefix": "192.168.0.0/24"},
+		},
+	},
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_pair_groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "port_pair_group": {
+        "name": "firewalls",
+        "description": "Firewall SF instances",
+        "port_pairs": [
+            "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae",
+            "d11e9190-73d4-11e8-b7ef-7b31f2ff9e55"
+        ],
+        "port_pair_group_parameters": {
+            "lb_fields": ["ip_src", "ip_dst"],
+            "ppg_n_tuple_mapping": {
+                "ingress_n_tuple": {"source_ip_prefix": "10.0.0.0/24"},
+                "egress_n_tuple": {"source_ip_prefix": "192.168.0.0/24"}
+            }
+        }
+    }
+}
+        `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, portPairGroupResult)
+	})
+
+	options := portpairgroups.CreateOpts{
+		Name:        "firewalls",
+		Description: "Firewall SF instances",
+		PortPairs: []string{
+			"78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae",
+			"d11e9190-73d4-11e8-b7ef-7b31f2ff9e55",
+		},
+		PortPairGroupParameters: &portpairgroups.PortPairGroupParameters{
+			LBFields: []string{"ip_src", "ip_dst"},
+			NTupleMapping: &portpairgroups.NTupleMapping{
+				IngressNTuple: map[string]interface{}{"source_ip_prefix": "10.0.0.0/24"},
+				EgressNTuple:  map[string]interface{}{"source_ip_prefix": "192.168.0.0/24"},
+			},
+		},
+	}
+	actual, err := portpairgroups.Create(fake.ServiceClient(), options).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, portPairGroup, *actual)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_pair_groups/4512d643-24fc-4fae-af4b-321c5e2eb3d1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, portPairGroupResult)
+	})
+
+	actual, err := portpairgroups.Get(fake.ServiceClient(), "4512d643-24fc-4fae-af4b-321c5e2eb3d1").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, portPairGroup, *actual)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_pair_groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"name": "firewalls",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `
+{
+    "port_pair_groups": [
+        {
+            "id": "4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+            "group_id": 1,
+            "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+            "project_id": "d382007aa9904763a801f68ecf065cf5",
+            "name": "firewalls",
+            "description": "Firewall SF instances",
+            "port_pairs": [
+                "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae",
+                "d11e9190-73d4-11e8-b7ef-7b31f2ff9e55"
+            ],
+            "port_pair_group_parameters": {
+                "lb_fields": ["ip_src", "ip_dst"],
+                "ppg_n_tuple_mapping": {
+                    "ingress_n_tuple": {"source_ip_prefix": "10.0.0.0/24"},
+                    "egress_n_tuple": {"source_ip_prefix": "192.168.0.0/24"}
+                }
+            },
+            "tap_enabled": false
+        }
+    ]
+}
+        `)
+	})
+
+	count := 0
+	err := portpairgroups.List(fake.ServiceClient(), portpairgroups.ListOpts{Name: "firewalls"}).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := portpairgroups.ExtractPortPairGroups(page)
+		if err != nil {
+			t.Errorf("Failed to extract port pair groups: %v", err)
+			return false, err
+		}
+		th.CheckDeepEquals(t, []portpairgroups.PortPairGroup{portPairGroup}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_pair_groups/4512d643-24fc-4fae-af4b-321c5e2eb3d1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "port_pair_group": {
+        "port_pairs": [
+            "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae"
+        ]
+    }
+}
+        `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `
+{
+    "port_pair_group": {
+        "id": "4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+        "name": "firewalls",
+        "port_pairs": [
+            "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae"
+        ],
+        "port_pair_group_parameters": {
+            "lb_fields": []
+        },
+        "tap_enabled": false
+    }
+}
+        `)
+	})
+
+	portPairs := []string{"78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae"}
+	updateOpts := portpairgroups.UpdateOpts{
+		PortPairs: &portPairs,
+	}
+	actual, err := portpairgroups.Update(fake.ServiceClient(), "4512d643-24fc-4fae-af4b-321c5e2eb3d1", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, portPairs, actual.PortPairs)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_pair_groups/4512d643-24fc-4fae-af4b-321c5e2eb3d1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := portpairgroups.Delete(fake.ServiceClient(), "4512d643-24fc-4fae-af4b-321c5e2eb3d1")
+	th.AssertNoErr(t, res.Err)
+}
@@ -0,0 +1,61 @@
+/*
+Package portpairs allows management of the port pairs of service function
+chains in the Openstack Network Service. A port pair represents a service
+function instance by its ingress and egress ports.
+
+Example to List Port Pairs
+
+	listOpts := portpairs.ListOpts{
+		Ingress: "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+	}
+
+	allPages, err := portpairs.List(client, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allPortPairs, err := portpairs.ExtractPortPairs(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, portPair := range allPortPairs {
+		fmt.Printf("%+v\n", portPair)
+	}
+
+Example to Create a Port Pair
+
+	createOpts := portpairs.CreateOpts{
+		Name:    "firewall-1",
+		Ingress: "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+		Egress:  "aef3478a-4a56-2a6e-cd3a-9dee4e2ec345",
+		ServiceFunctionParameters: &portpairs.ServiceFunctionParameters{
+			Correlation: portpairs.CorrelationMPLS,
+		},
+	}
+
+	portPair, err := portpairs.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Port Pair
+
+	name := "firewall-a"
+	updateOpts := portpairs.UpdateOpts{
+		Name: &name,
+	}
+
+	portPair, err := portpairs.Update(client, "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Port Pair
+
+	err := portpairs.Delete(client, "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package portpairs
@@ -0,0 +1,172 @@
+package portpairs
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Correlation is the type of the chain header the service function supports.
+type Correlation string
+
+const (
+	CorrelationNone Correlation = ""
+	CorrelationMPLS Correlation = "mpls"
+	CorrelationNSH  Correlation = "nsh"
+)
+
+// ServiceFunctionParameters are the parameters of the service function of a
+// port pair.
+type ServiceFunctionParameters struct {
+	// Correlation is the type of the chain header the service function
+	// supports. The header is stripped before packets are sent to the service
+	// function if empty.
+	Correlation Correlation `json:"correlation,omitempty"`
+
+	// Weight is the weight of the port pair for load balancing in a port pair
+	// group.
+	Weight int `json:"weight,omitempty"`
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToPortPairCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains all the values needed to create a new port pair.
+type CreateOpts struct {
+	// TenantID specifies a tenant to own the port pair. The caller must have
+	// an admin role in order to set this. Otherwise, this field is left unset
+	// and the caller will be the owner.
+	TenantID string `json:"tenant_id,omitempty"`
+
+	// ProjectID specifies a project to own the port pair.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// Name is the human readable name of the port pair.
+	Name string `json:"name,omitempty"`
+
+	// Description is the human readable description of the port pair.
+	Description string `json:"description,omitempty"`
+
+	// Ingress is the ID of the port packets enter the service function by.
+	Ingress string `json:"ingress" required:"true"`
+
+	// Egress is the ID of the port packets leave the service function by.
+	Egress string `json:"egress" required:"true"`
+
+	// ServiceFunctionParameters are the parameters of the service function.
+	ServiceFunctionParameters *ServiceFunctionParameters `json:"service_function_parameters,omitempty"`
+}
+
+// ToPortPairCreateMap casts a CreateOpts struct to a map.
+func (opts CreateOpts) ToPortPairCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "port_pair")
+}
+
+// Create accepts a CreateOpts struct and uses the values to create a new
+// port pair.
+func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToPortPairCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(rootURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves a particular port pair based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(resourceURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToPortPairListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the port pair attributes you want to see returned. SortKey allows you to
+// sort by a particular port pair attribute. SortDir sets the direction, and
+// is either `asc' or `desc'. Marker and Limit are used for pagination.
+type ListOpts struct {
+	ID          string `q:"id"`
+	TenantID    string `q:"tenant_id"`
+	ProjectID   string `q:"project_id"`
+	Name        string `q:"name"`
+	Description string `q:"description"`
+	Ingress     string `q:"ingress"`
+	Egress      string `q:"egress"`
+	Marker      string `q:"marker"`
+	Limit       int    `q:"limit"`
+	SortKey     string `q:"sort_key"`
+	SortDir     string `q:"sort_dir"`
+}
+
+// ToPortPairListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToPortPairListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// port pairs. It accepts a ListOpts struct, which allows you to filter and
+// sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToPortPairListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return PortPairPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Delete will permanently delete a particular port pair based on its unique
+// ID.
+func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(resourceURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToPortPairUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains the values used when updating a port pair.
+type UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ToPortPairUpdateMap casts an UpdateOpts struct to a map.
+func (opts UpdateOpts) ToPortPairUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "port_pair")
+}
+
+// Update allows port pairs to be updated.
+func Update(c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToPortPairUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(resourceURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
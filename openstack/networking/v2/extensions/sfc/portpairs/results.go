@@ -0,0 +1,112 @@
+package portpairs
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// PortPair represents a service function instance by its ingress and egress
+// ports.
+type PortPair struct {
+	// ID is the unique ID of the port pair.
+	ID string `json:"id"`
+
+	// TenantID is the ID of the tenant owning the port pair.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the ID of the project owning the port pair.
+	ProjectID string `json:"project_id"`
+
+	// Name is the human readable name of the port pair.
+	Name string `json:"name"`
+
+	// Description is the human readable description of the port pair.
+	Description string `json:"description"`
+
+	// Ingress is the ID of the port packets enter the service function by.
+	Ingress string `json:"ingress"`
+
+	// Egress is the ID of the port packets leave the service function by.
+	Egress string `json:"egress"`
+
+	// ServiceFunctionParameters are the parameters of the service function.
+	ServiceFunctionParameters ServiceFunctionParameters `json:"service_function_parameters"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a port pair.
+func (r commonResult) Extract() (*PortPair, error) {
+	var s struct {
+		PortPair *PortPair `json:"port_pair"`
+	}
+	err := r.ExtractInto(&s)
+	return s.PortPair, err
+}
+
+// PortPairPage is the page returned by a pager when traversing over a
+// collection of port pairs.
+type PortPairPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of port pairs has
+// reached the end of a page and the pager seeks to traverse over a new one.
+// In order to do this, it needs to construct the next page's URL.
+func (r PortPairPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"port_pairs_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a PortPairPage struct is empty.
+func (r PortPairPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractPortPairs(r)
+	return len(is) == 0, err
+}
+
+// ExtractPortPairs accepts a Page struct, specifically a PortPairPage struct,
+// and extracts the elements into a slice of PortPair structs. In other words,
+// a generic collection is mapped into a relevant slice.
+func ExtractPortPairs(r pagination.Page) ([]PortPair, error) {
+	var s struct {
+		PortPairs []PortPair `json:"port_pairs"`
+	}
+	err := (r.(PortPairPage)).ExtractInto(&s)
+	return s.PortPairs, err
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a PortPair.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a PortPair.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult represents the results of a Delete operation. Call its
+// ExtractErr method to determine whether the operation succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a PortPair.
+type UpdateResult struct {
+	commonResult
+}
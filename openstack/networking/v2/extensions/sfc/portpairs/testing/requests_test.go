@@ -0,0 +1,244 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/sfc/portpairs"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+const portPairResult = `
+{
+    "port_pair": {
+        "id": "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae",
+        "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+        "project_id": "d382007aa9904763a801f68ecf065cf5",
+        "name": "firewall-1",
+        "description": "Firewall SF instance",
+        "ingress": "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+        "egress": "aef3478a-4a56-2a6e-cd3a-9dee4e2ec345",
+        "service_function_parameters": {
+            "correlation": "mpls",
+            "weight": 1
+        }
+    }
+}
+`
+
+var portPair = portpairs.PortPair{
+	ID:          "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae",
+	TenantID:    "d382007aa9904763a801f68ecf065cf5",
+	ProjectID:   "d382007aa9904763a801f68ecf065cf5",
+	Name:        "firewall-1",
+	Description: "Firewall SF instance",
+	Ingress:     "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+	Egress:      "aef3478a-4a56-2a6e-cd3a-9dee4e2ec345",
+	ServiceFunctionParameters: portpairs.ServiceFunctionParameters{
+		Correlation: portpairs.CorrelationMPLS,
+		Weight:      1,
+	},
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_pairs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "port_pair": {
+        "name": "firewall-1",
+        "description": "Firewall SF instance",
+        "ingress": "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+        "egress": "aef3478a-4a56-2a6e-cd3a-9dee4e2ec345",
+        "service_function_parameters": {
+            "correlation": "mpls"
+        }
+    }
+}
+        `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, portPairResult)
+	})
+
+	options := portpairs.CreateOpts{
+		Name:        "firewall-1",
+		Description: "Firewall SF instance",
+		Ingress:     "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+		Egress:      "aef3478a-4a56-2a6e-cd3a-9dee4e2ec345",
+		ServiceFunctionParameters: &portpairs.ServiceFunctionParameters{
+			Correlation: portpairs.CorrelationMPLS,
+		},
+	}
+	actual, err := portpairs.Create(fake.ServiceClient(), options).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, portPair, *actual)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	res := portpairs.Create(fake.ServiceClient(), portpairs.CreateOpts{
+		Ingress: "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+	})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_pairs/78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, portPairResult)
+	})
+
+	actual, err := portpairs.Get(fake.ServiceClient(), "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, portPair, *actual)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_pairs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		r.ParseForm()
+		switch r.Form.Get("marker") {
+		case "":
+			th.TestFormValues(t, r, map[string]string{
+				"ingress": "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+				"limit":   "1",
+			})
+			fmt.Fprintf(w, `
+{
+    "port_pairs": [
+        {
+            "id": "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae",
+            "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+            "project_id": "d382007aa9904763a801f68ecf065cf5",
+            "name": "firewall-1",
+            "description": "Firewall SF instance",
+            "ingress": "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+            "egress": "aef3478a-4a56-2a6e-cd3a-9dee4e2ec345",
+            "service_function_parameters": {
+                "correlation": "mpls",
+                "weight": 1
+            }
+        }
+    ],
+    "port_pairs_links": [
+        {
+            "href": "%s/sfc/port_pairs?ingress=dace4513-24fc-4fae-af4b-321c5e2eb3d1&limit=1&marker=78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae",
+            "rel": "next"
+        }
+    ]
+}
+            `, th.Server.URL+"/v2.0")
+		case "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae":
+			fmt.Fprintf(w, `{"port_pairs": []}`)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", r.Form.Get("marker"))
+		}
+	})
+
+	count := 0
+	listOpts := portpairs.ListOpts{
+		Ingress: "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+		Limit:   1,
+	}
+	err := portpairs.List(fake.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := portpairs.ExtractPortPairs(page)
+		if err != nil {
+			t.Errorf("Failed to extract port pairs: %v", err)
+			return false, err
+		}
+		th.CheckDeepEquals(t, []portpairs.PortPair{portPair}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_pairs/78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "port_pair": {
+        "name": "firewall-a"
+    }
+}
+        `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `
+{
+    "port_pair": {
+        "id": "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae",
+        "name": "firewall-a",
+        "ingress": "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+        "egress": "aef3478a-4a56-2a6e-cd3a-9dee4e2ec345",
+        "service_function_parameters": {
+            "correlation": null,
+            "weight": 1
+        }
+    }
+}
+        `)
+	})
+
+	name := "firewall-a"
+	updateOpts := portpairs.UpdateOpts{
+		Name: &name,
+	}
+	actual, err := portpairs.Update(fake.ServiceClient(), "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "firewall-a", actual.Name)
+	th.AssertEquals(t, portpairs.CorrelationNone, actual.ServiceFunctionParameters.Correlation)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_pairs/78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := portpairs.Delete(fake.ServiceClient(), "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae")
+	th.AssertNoErr(t, res.Err)
+}
@@ -0,0 +1,47 @@
+/*
+Package servicegraphs allows management of the service graphs of service
+function chains in the Openstack Network Service. A service graph connects
+port chains, steering the traffic leaving a chain into the chains following
+it.
+
+Example to List Service Graphs
+
+	allPages, err := servicegraphs.List(client, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allGraphs, err := servicegraphs.ExtractServiceGraphs(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, graph := range allGraphs {
+		fmt.Printf("%+v\n", graph)
+	}
+
+Example to Create a Service Graph
+
+	createOpts := servicegraphs.CreateOpts{
+		Name: "web-graph",
+		PortChains: map[string][]string{
+			"1278dcd4-459f-62ed-754b-87fc5e4a6751": {
+				"73e97aad-8c0f-44e3-bee0-c0a641b00b66",
+				"b9570dc9-01fb-4cb8-8c66-4f8a4bed7fbc",
+			},
+		},
+	}
+
+	graph, err := servicegraphs.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Service Graph
+
+	err := servicegraphs.Delete(client, "0e6b9f7a-4d9b-4a3b-8f0e-2b4c6a8d0e1f").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package servicegraphs
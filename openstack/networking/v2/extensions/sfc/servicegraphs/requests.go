@@ -0,0 +1,144 @@
+package servicegraphs
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToServiceGraphCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains all the values needed to create a new service graph.
+type CreateOpts struct {
+	// TenantID specifies a tenant to own the service graph. The caller must
+	// have an admin role in order to set this. Otherwise, this field is left
+	// unset and the caller will be the owner.
+	TenantID string `json:"tenant_id,omitempty"`
+
+	// ProjectID specifies a project to own the service graph.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// Name is the human readable name of the service graph.
+	Name string `json:"name,omitempty"`
+
+	// Description is the human readable description of the service graph.
+	Description string `json:"description,omitempty"`
+
+	// PortChains maps the ID of each port chain of the graph to the IDs of
+	// the port chains following it.
+	PortChains map[string][]string `json:"port_chains" required:"true"`
+}
+
+// ToServiceGraphCreateMap casts a CreateOpts struct to a map.
+func (opts CreateOpts) ToServiceGraphCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "service_graph")
+}
+
+// Create accepts a CreateOpts struct and uses the values to create a new
+// service graph.
+func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToServiceGraphCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(rootURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves a particular service graph based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(resourceURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToServiceGraphListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the service graph attributes you want to see returned. SortKey allows you to
+// sort by a particular service graph attribute. SortDir sets the direction,
+// and is either `asc' or `desc'. Marker and Limit are used for pagination.
+type ListOpts struct {
+	ID          string `q:"id"`
+	TenantID    string `q:"tenant_id"`
+	ProjectID   string `q:"project_id"`
+	Name        string `q:"name"`
+	Description string `q:"description"`
+	Marker      string `q:"marker"`
+	Limit       int    `q:"limit"`
+	SortKey     string `q:"sort_key"`
+	SortDir     string `q:"sort_dir"`
+}
+
+// ToServiceGraphListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToServiceGraphListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// service graphs. It accepts a ListOpts struct, which allows you to filter
+// and sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToServiceGraphListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return ServiceGraphPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Delete will permanently delete a particular service graph based on its
+// unique ID.
+func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(resourceURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToServiceGraphUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains the values used when updating a service graph. The
+// port chains of a graph cannot be updated.
+type UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ToServiceGraphUpdateMap casts an UpdateOpts struct to a map.
+func (opts UpdateOpts) ToServiceGraphUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "service_graph")
+}
+
+// Update allows service graphs to be updated.
+func Update(c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToServiceGraphUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(resourceURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
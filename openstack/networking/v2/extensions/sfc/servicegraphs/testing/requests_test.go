@@ -0,0 +1,212 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/sfc/servicegraphs"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+const serviceGraphResult = `
+{
+    "service_graph": {
+        "id": "0e6b9f7a-4d9b-4a3b-8f0e-2b4c6a8d0e1f",
+        "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+        "project_id": "d382007aa9904763a801f68ecf065cf5",
+        "name": "web-graph",
+        "description": "",
+        "port_chains": {
+            "1278dcd4-459f-62ed-754b-87fc5e4a6751": [
+                "73e97aad-8c0f-44e3-bee0-c0a641b00b66",
+                "b9570dc9-01fb-4cb8-8c66-4f8a4bed7fbc"
+            ]
+        }
+    }
+}
+`
+
+var serviceGraph = servicegraphs.ServiceGraph{
+	ID:        "0e6b9f7a-4d9b-4a3b-8f0e-2b4c6a8d0e1f",
+	TenantID:  "d382007aa9904763a801f68ecf065cf5",
+	ProjectID: "d382007aa9904763a801f68ecf065cf5",
+	Name:      "web-graph",
+	PortChains: map[string][]string{
+		"1278dcd4-459f-62ed-754b-87fc5e4a6751": {
+			"73e97aad-8c0f-44e3-bee0-c0a641b00b66",
+			"b9570dc9-01fb-4cb8-8c66-4f8a4bed7fbc",
+		},
+	},
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/service_graphs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "service_graph": {
+        "name": "web-graph",
+        "port_chains": {
+            "1278dcd4-459f-62ed-754b-87fc5e4a6751": [
+                "73e97aad-8c0f-44e3-bee0-c0a641b00b66",
+                "b9570dc9-01fb-4cb8-8c66-4f8a4bed7fbc"
+            ]
+        }
+    }
+}
+        `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, serviceGraphResult)
+	})
+
+	options := servicegraphs.CreateOpts{
+		Name: "web-graph",
+		PortChains: map[string][]string{
+			"1278dcd4-459f-62ed-754b-87fc5e4a6751": {
+				"73e97aad-8c0f-44e3-bee0-c0a641b00b66",
+				"b9570dc9-01fb-4cb8-8c66-4f8a4bed7fbc",
+			},
+		},
+	}
+	actual, err := servicegraphs.Create(fake.ServiceClient(), options).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, serviceGraph, *actual)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/service_graphs/0e6b9f7a-4d9b-4a3b-8f0e-2b4c6a8d0e1f", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, serviceGraphResult)
+	})
+
+	actual, err := servicegraphs.Get(fake.ServiceClient(), "0e6b9f7a-4d9b-4a3b-8f0e-2b4c6a8d0e1f").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, serviceGraph, *actual)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/service_graphs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `
+{
+    "service_graphs": [
+        {
+            "id": "0e6b9f7a-4d9b-4a3b-8f0e-2b4c6a8d0e1f",
+            "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+            "project_id": "d382007aa9904763a801f68ecf065cf5",
+            "name": "web-graph",
+            "description": "",
+            "port_chains": {
+                "1278dcd4-459f-62ed-754b-87fc5e4a6751": [
+                    "73e97aad-8c0f-44e3-bee0-c0a641b00b66",
+                    "b9570dc9-01fb-4cb8-8c66-4f8a4bed7fbc"
+                ]
+            }
+        }
+    ]
+}
+        `)
+	})
+
+	count := 0
+	err := servicegraphs.List(fake.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := servicegraphs.ExtractServiceGraphs(page)
+		if err != nil {
+			t.Errorf("Failed to extract service graphs: %v", err)
+			return false, err
+		}
+		th.CheckDeepEquals(t, []servicegraphs.ServiceGraph{serviceGraph}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/service_graphs/0e6b9f7a-4d9b-4a3b-8f0e-2b4c6a8d0e1f", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "service_graph": {
+        "description": "Web service graph"
+    }
+}
+        `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `
+{
+    "service_graph": {
+        "id": "0e6b9f7a-4d9b-4a3b-8f0e-2b4c6a8d0e1f",
+        "name": "web-graph",
+        "description": "Web service graph",
+        "port_chains": {
+            "1278dcd4-459f-62ed-754b-87fc5e4a6751": [
+                "73e97aad-8c0f-44e3-bee0-c0a641b00b66",
+                "b9570dc9-01fb-4cb8-8c66-4f8a4bed7fbc"
+            ]
+        }
+    }
+}
+        `)
+	})
+
+	description := "Web service graph"
+	updateOpts := servicegraphs.UpdateOpts{
+		Description: &description,
+	}
+	actual, err := servicegraphs.Update(fake.ServiceClient(), "0e6b9f7a-4d9b-4a3b-8f0e-2b4c6a8d0e1f", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "Web service graph", actual.Description)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/service_graphs/0e6b9f7a-4d9b-4a3b-8f0e-2b4c6a8d0e1f", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := servicegraphs.Delete(fake.ServiceClient(), "0e6b9f7a-4d9b-4a3b-8f0e-2b4c6a8d0e1f")
+	th.AssertNoErr(t, res.Err)
+}
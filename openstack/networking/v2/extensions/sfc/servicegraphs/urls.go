@@ -0,0 +1,16 @@
+package servicegraphs
+
+import "github.com/gophercloud/gophercloud"
+
+const (
+	rootPath     = "sfc"
+	resourcePath = "service_graphs"
+)
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath, resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, resourcePath, id)
+}
@@ -0,0 +1,4 @@
+// Package taas provides information and interaction with the Tap-as-a-Service
+// (tap-as-a-service) extension for the OpenStack Networking service, used to
+// mirror the traffic of ports for monitoring.
+package taas
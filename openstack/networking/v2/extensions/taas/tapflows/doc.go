@@ -0,0 +1,46 @@
+/*
+Package tapflows allows management of tap flows in the Openstack Network
+Service. A tap flow mirrors the traffic of a source port to a tap service.
+
+Example to List the Tap Flows of a Tap Service
+
+	listOpts := tapflows.ListOpts{
+		TapServiceID: "c352f537-ad49-48eb-ab05-1c6b8cb900ff",
+	}
+
+	allPages, err := tapflows.List(client, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allFlows, err := tapflows.ExtractTapFlows(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, flow := range allFlows {
+		fmt.Printf("%+v\n", flow)
+	}
+
+Example to Create a Tap Flow
+
+	createOpts := tapflows.CreateOpts{
+		Name:         "web-mirror",
+		TapServiceID: "c352f537-ad49-48eb-ab05-1c6b8cb900ff",
+		SourcePort:   "2a5b8f9c-4f6e-4b3a-9a0d-7c3e1f2b4d5e",
+		Direction:    tapflows.DirectionBoth,
+	}
+
+	flow, err := tapflows.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Tap Flow
+
+	err := tapflows.Delete(client, "f3c2d5e9-0b9b-4a3e-8d1a-6c7e8f9a0b1c").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package tapflows
@@ -0,0 +1,166 @@
+package tapflows
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Direction is the direction of the traffic mirrored by a tap flow, relative
+// to the source port.
+type Direction string
+
+const (
+	DirectionIn   Direction = "IN"
+	DirectionOut  Direction = "OUT"
+	DirectionBoth Direction = "BOTH"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToTapFlowCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains all the values needed to create a new tap flow.
+type CreateOpts struct {
+	// TenantID specifies a tenant to own the tap flow. The caller must
+	// have an admin role in order to set this. Otherwise, this field is left
+	// unset and the caller will be the owner.
+	TenantID string `json:"tenant_id,omitempty"`
+
+	// ProjectID specifies a project to own the tap flow.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// Name is the human readable name of the tap flow.
+	Name string `json:"name,omitempty"`
+
+	// Description is the human readable description of the tap flow.
+	Description string `json:"description,omitempty"`
+
+	// TapServiceID is the ID of the tap service to send mirrored traffic to.
+	TapServiceID string `json:"tap_service_id" required:"true"`
+
+	// SourcePort is the ID of the port whose traffic is mirrored.
+	SourcePort string `json:"source_port" required:"true"`
+
+	// Direction is the direction of the mirrored traffic.
+	Direction Direction `json:"direction" required:"true"`
+
+	// VLANFilter is the comma separated list of VLAN IDs and ranges to mirror,
+	// such as "9,18,27-36". It only applies to the traffic of VLAN networks.
+	VLANFilter string `json:"vlan_filter,omitempty"`
+}
+
+// ToTapFlowCreateMap casts a CreateOpts struct to a map.
+func (opts CreateOpts) ToTapFlowCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "tap_flow")
+}
+
+// Create accepts a CreateOpts struct and uses the values to create a new
+// tap flow.
+func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToTapFlowCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(rootURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves a particular tap flow based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(resourceURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToTapFlowListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the tap flow attributes you want to see returned. SortKey allows you to
+// sort by a particular tap flow attribute. SortDir sets the direction, and
+// is either `asc' or `desc'. Marker and Limit are used for pagination.
+type ListOpts struct {
+	ID           string    `q:"id"`
+	TenantID     string    `q:"tenant_id"`
+	ProjectID    string    `q:"project_id"`
+	Name         string    `q:"name"`
+	Description  string    `q:"description"`
+	TapServiceID string    `q:"tap_service_id"`
+	SourcePort   string    `q:"source_port"`
+	Direction    Direction `q:"direction"`
+	Status       string    `q:"status"`
+	Marker       string    `q:"marker"`
+	Limit        int       `q:"limit"`
+	SortKey      string    `q:"sort_key"`
+	SortDir      string    `q:"sort_dir"`
+}
+
+// ToTapFlowListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToTapFlowListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// tap flows. It accepts a ListOpts struct, which allows you to filter and
+// sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToTapFlowListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return TapFlowPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Delete will permanently delete a particular tap flow based on its unique
+// ID.
+func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(resourceURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToTapFlowUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains the values used when updating a tap flow.
+type UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ToTapFlowUpdateMap casts an UpdateOpts struct to a map.
+func (opts UpdateOpts) ToTapFlowUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "tap_flow")
+}
+
+// Update allows tap flows to be updated.
+func Update(c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToTapFlowUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(resourceURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,119 @@
+package tapflows
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// TapFlow represents the mirroring of the traffic of a source port to a tap
+// service.
+type TapFlow struct {
+	// ID is the unique ID of the tap flow.
+	ID string `json:"id"`
+
+	// TenantID is the ID of the tenant owning the tap flow.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the ID of the project owning the tap flow.
+	ProjectID string `json:"project_id"`
+
+	// Name is the human readable name of the tap flow.
+	Name string `json:"name"`
+
+	// Description is the human readable description of the tap flow.
+	Description string `json:"description"`
+
+	// TapServiceID is the ID of the tap service mirrored traffic is sent to.
+	TapServiceID string `json:"tap_service_id"`
+
+	// SourcePort is the ID of the port whose traffic is mirrored.
+	SourcePort string `json:"source_port"`
+
+	// Direction is the direction of the mirrored traffic.
+	Direction Direction `json:"direction"`
+
+	// VLANFilter is the comma separated list of VLAN IDs and ranges of the
+	// mirrored traffic.
+	VLANFilter string `json:"vlan_filter"`
+
+	// Status is the status of the tap flow.
+	Status string `json:"status"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a tap flow.
+func (r commonResult) Extract() (*TapFlow, error) {
+	var s struct {
+		TapFlow *TapFlow `json:"tap_flow"`
+	}
+	err := r.ExtractInto(&s)
+	return s.TapFlow, err
+}
+
+// TapFlowPage is the page returned by a pager when traversing over a collection
+// of tap flows.
+type TapFlowPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of tap flows has reached
+// the end of a page and the pager seeks to traverse over a new one. In order to
+// do this, it needs to construct the next page's URL.
+func (r TapFlowPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"tap_flows_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a TapFlowPage struct is empty.
+func (r TapFlowPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractTapFlows(r)
+	return len(is) == 0, err
+}
+
+// ExtractTapFlows accepts a Page struct, specifically a TapFlowPage struct, and
+// extracts the elements into a slice of TapFlow structs. In other words, a
+// generic collection is mapped into a relevant slice.
+func ExtractTapFlows(r pagination.Page) ([]TapFlow, error) {
+	var s struct {
+		TapFlows []TapFlow `json:"tap_flows"`
+	}
+	err := (r.(TapFlowPage)).ExtractInto(&s)
+	return s.TapFlows, err
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a TapFlow.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract method
+// to interpret it as a TapFlow.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult represents the results of a Delete operation. Call its
+// ExtractErr method to determine whether the operation succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a TapFlow.
+type UpdateResult struct {
+	commonResult
+}
@@ -0,0 +1,212 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/taas/tapflows"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+const tapFlowResult = `
+{
+    "tap_flow": {
+        "id": "f3c2d5e9-0b9b-4a3e-8d1a-6c7e8f9a0b1c",
+        "tenant_id": "97e1586d580745d7b311406697aaf097",
+        "project_id": "97e1586d580745d7b311406697aaf097",
+        "name": "web-mirror",
+        "description": "",
+        "tap_service_id": "c352f537-ad49-48eb-ab05-1c6b8cb900ff",
+        "source_port": "2a5b8f9c-4f6e-4b3a-9a0d-7c3e1f2b4d5e",
+        "direction": "BOTH",
+        "vlan_filter": "9,18,27-36",
+        "status": "ACTIVE"
+    }
+}
+`
+
+var tapFlow = tapflows.TapFlow{
+	ID:           "f3c2d5e9-0b9b-4a3e-8d1a-6c7e8f9a0b1c",
+	TenantID:     "97e1586d580745d7b311406697aaf097",
+	ProjectID:    "97e1586d580745d7b311406697aaf097",
+	Name:         "web-mirror",
+	TapServiceID: "c352f537-ad49-48eb-ab05-1c6b8cb900ff",
+	SourcePort:   "2a5b8f9c-4f6e-4b3a-9a0d-7c3e1f2b4d5e",
+	Direction:    tapflows.DirectionBoth,
+	VLANFilter:   "9,18,27-36",
+	Status:       "ACTIVE",
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/taas/tap_flows", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "tap_flow": {
+        "name": "web-mirror",
+        "tap_service_id": "c352f537-ad49-48eb-ab05-1c6b8cb900ff",
+        "source_port": "2a5b8f9c-4f6e-4b3a-9a0d-7c3e1f2b4d5e",
+        "direction": "BOTH",
+        "vlan_filter": "9,18,27-36"
+    }
+}
+        `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, tapFlowResult)
+	})
+
+	options := tapflows.CreateOpts{
+		Name:         "web-mirror",
+		TapServiceID: "c352f537-ad49-48eb-ab05-1c6b8cb900ff",
+		SourcePort:   "2a5b8f9c-4f6e-4b3a-9a0d-7c3e1f2b4d5e",
+		Direction:    tapflows.DirectionBoth,
+		VLANFilter:   "9,18,27-36",
+	}
+	actual, err := tapflows.Create(fake.ServiceClient(), options).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, tapFlow, *actual)
+}
+
+func TestCreateRequiresDirection(t *testing.T) {
+	options := tapflows.CreateOpts{
+		TapServiceID: "c352f537-ad49-48eb-ab05-1c6b8cb900ff",
+		SourcePort:   "2a5b8f9c-4f6e-4b3a-9a0d-7c3e1f2b4d5e",
+	}
+	res := tapflows.Create(fake.ServiceClient(), options)
+	if res.Err == nil {
+		t.Fatal("Expected an error for a tap flow without a direction")
+	}
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/taas/tap_flows/f3c2d5e9-0b9b-4a3e-8d1a-6c7e8f9a0b1c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, tapFlowResult)
+	})
+
+	actual, err := tapflows.Get(fake.ServiceClient(), "f3c2d5e9-0b9b-4a3e-8d1a-6c7e8f9a0b1c").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, tapFlow, *actual)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/taas/tap_flows", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"tap_service_id": "c352f537-ad49-48eb-ab05-1c6b8cb900ff"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `
+{
+    "tap_flows": [
+        {
+            "id": "f3c2d5e9-0b9b-4a3e-8d1a-6c7e8f9a0b1c",
+            "tenant_id": "97e1586d580745d7b311406697aaf097",
+            "project_id": "97e1586d580745d7b311406697aaf097",
+            "name": "web-mirror",
+            "description": "",
+            "tap_service_id": "c352f537-ad49-48eb-ab05-1c6b8cb900ff",
+            "source_port": "2a5b8f9c-4f6e-4b3a-9a0d-7c3e1f2b4d5e",
+            "direction": "BOTH",
+            "vlan_filter": "9,18,27-36",
+            "status": "ACTIVE"
+        }
+    ]
+}
+        `)
+	})
+
+	count := 0
+	listOpts := tapflows.ListOpts{
+		TapServiceID: "c352f537-ad49-48eb-ab05-1c6b8cb900ff",
+	}
+	err := tapflows.List(fake.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := tapflows.ExtractTapFlows(page)
+		if err != nil {
+			t.Errorf("Failed to extract tap flows: %v", err)
+			return false, err
+		}
+		th.CheckDeepEquals(t, []tapflows.TapFlow{tapFlow}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/taas/tap_flows/f3c2d5e9-0b9b-4a3e-8d1a-6c7e8f9a0b1c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+    "tap_flow": {
+        "name": "web-mirror-in"
+    }
+}
+        `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `
+{
+    "tap_flow": {
+        "id": "f3c2d5e9-0b9b-4a3e-8d1a-6c7e8f9a0b1c",
+        "name": "web-mirror-in",
+        "direction": "BOTH"
+    }
+}
+        `)
+	})
+
+	name := "web-mirror-in"
+	updateOpts := tapflows.UpdateOpts{
+		Name: &name,
+	}
+	actual, err := tapflows.Update(fake.ServiceClient(), "f3c2d5e9-0b9b-4a3e-8d1a-6c7e8f9a0b1c", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "web-mirror-in", actual.Name)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/taas/tap_flows/f3c2d5e9-0b9b-4a3e-8d1a-6c7e8f9a0b1c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := tapflows.Delete(fake.ServiceClient(), "f3c2d5e9-0b9b-4a3e-8d1a-6c7e8f9a0b1c")
+	th.AssertNoErr(t, res.Err)
+}
@@ -0,0 +1,16 @@
+package tapflows
+
+import "github.com/gophercloud/gophercloud"
+
+const (
+	rootPath     = "taas"
+	resourcePath = "tap_flows"
+)
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath, resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, resourcePath, id)
+}
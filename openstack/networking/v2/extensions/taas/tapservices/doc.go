@@ -0,0 +1,40 @@
+/*
+Package tapservices allows management of tap services in the Openstack
+Network Service. A tap service is the port mirrored traffic is delivered to.
+
+Example to List Tap Services
+
+	allPages, err := tapservices.List(client, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allServices, err := tapservices.ExtractTapServices(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, service := range allServices {
+		fmt.Printf("%+v\n", service)
+	}
+
+Example to Create a Tap Service
+
+	createOpts := tapservices.CreateOpts{
+		Name:   "monitor",
+		PortID: "a6e6a7e2-8b47-4bbf-9d9e-2ba8e5d3d0b4",
+	}
+
+	service, err := tapservices.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Tap Service
+
+	err := tapservices.Delete(client, "c352f537-ad49-48eb-ab05-1c6b8cb900ff").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package tapservices
@@ -0,0 +1,109 @@
+package tapservices
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// TapService represents the destination port mirrored traffic is delivered
+// to, typically the port of a monitoring instance.
+type TapService struct {
+	// ID is the unique ID of the tap service.
+	ID string `json:"id"`
+
+	// TenantID is the ID of the tenant owning the tap service.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the ID of the project owning the tap service.
+	ProjectID string `json:"project_id"`
+
+	// Name is the human readable name of the tap service.
+	Name string `json:"name"`
+
+	// Description is the human readable description of the tap service.
+	Description string `json:"description"`
+
+	// PortID is the ID of the port mirrored traffic is delivered to.
+	PortID string `json:"port_id"`
+
+	// Status is the status of the tap service.
+	Status string `json:"status"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a tap service.
+func (r commonResult) Extract() (*TapService, error) {
+	var s struct {
+		TapService *TapService `json:"tap_service"`
+	}
+	err := r.ExtractInto(&s)
+	return s.TapService, err
+}
+
+// TapServicePage is the page returned by a pager when traversing over a
+// collection of tap services.
+type TapServicePage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of tap services has
+// reached the end of a page and the pager seeks to traverse over a new one. In
+// order to do this, it needs to construct the next page's URL.
+func (r TapServicePage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"tap_services_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a TapServicePage struct is empty.
+func (r TapServicePage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractTapServices(r)
+	return len(is) == 0, err
+}
+
+// ExtractTapServices accepts a Page struct, specifically a TapServicePage
+// struct, and extracts the elements into a slice of TapService structs. In
+// other words, a generic collection is mapped into a relevant slice.
+func ExtractTapServices(r pagination.Page) ([]TapService, error) {
+	var s struct {
+		TapServices []TapService `json:"tap_services"`
+	}
+	err := (r.(TapServicePage)).ExtractInto(&s)
+	return s.TapServices, err
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a TapService.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract method
+// to interpret it as a TapService.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult represents the results of a Delete operation. Call its
+// ExtractErr method to determine whether the operation succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a TapService.
+type UpdateResult struct {
+	commonResult
+}
@@ -0,0 +1,184 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/taas/tapservices"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+const tapServiceResult = `
+{
+    "tap_service": {
+        "id": "c352f537-ad49-48eb-ab05-1c6b8cb900ff",
+        "tenant_id": "97e1586d580745d7b311406697aaf097",
+        "project_id": "97e1586d580745d7b311406697aaf097",
+        "name": "monitor",
+        "description": "",
+        "port_id": "a6e6a7e2-8b47-4bbf-9d9e-2ba8e5d3d0b4",
+        "status": "ACTIVE"
+    }
+}
+`
+
+var tapService = tapservices.TapService{
+	ID:        "c352f537-ad49-48eb-ab05-1c6b8cb900ff",
+	TenantID:  "97e1586d580745d7b311406697aaf097",
+	ProjectID: "97e1586d580745d7b311406697aaf097",
+	Name:      "monitor",
+	PortID:    "a6e6a7e2-8b47-4bbf-9d9e-2ba8e5d3d0b4",
+	Status:    "ACTIVE",
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/taas/tap_services", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "tap_service": {
+        "name": "monitor",
+        "port_id": "a6e6a7e2-8b47-4bbf-9d9e-2ba8e5d3d0b4"
+    }
+}
+        `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, tapServiceResult)
+	})
+
+	options := tapservices.CreateOpts{
+		Name:   "monitor",
+		PortID: "a6e6a7e2-8b47-4bbf-9d9e-2ba8e5d3d0b4",
+	}
+	actual, err := tapservices.Create(fake.ServiceClient(), options).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, tapService, *actual)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/taas/tap_services/c352f537-ad49-48eb-ab05-1c6b8cb900ff", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, tapServiceResult)
+	})
+
+	actual, err := tapservices.Get(fake.ServiceClient(), "c352f537-ad49-48eb-ab05-1c6b8cb900ff").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, tapService, *actual)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/taas/tap_services", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `
+{
+    "tap_services": [
+        {
+            "id": "c352f537-ad49-48eb-ab05-1c6b8cb900ff",
+            "tenant_id": "97e1586d580745d7b311406697aaf097",
+            "project_id": "97e1586d580745d7b311406697aaf097",
+            "name": "monitor",
+            "description": "",
+            "port_id": "a6e6a7e2-8b47-4bbf-9d9e-2ba8e5d3d0b4",
+            "status": "ACTIVE"
+        }
+    ]
+}
+        `)
+	})
+
+	count := 0
+	err := tapservices.List(fake.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := tapservices.ExtractTapServices(page)
+		if err != nil {
+			t.Errorf("Failed to extract tap services: %v", err)
+			return false, err
+		}
+		th.CheckDeepEquals(t, []tapservices.TapService{tapService}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/taas/tap_services/c352f537-ad49-48eb-ab05-1c6b8cb900ff", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+    "tap_service": {
+        "description": "IDS sensor"
+    }
+}
+        `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `
+{
+    "tap_service": {
+        "id": "c352f537-ad49-48eb-ab05-1c6b8cb900ff",
+        "name": "monitor",
+        "description": "IDS sensor",
+        "port_id": "a6e6a7e2-8b47-4bbf-9d9e-2ba8e5d3d0b4",
+        "status": "ACTIVE"
+    }
+}
+        `)
+	})
+
+	description := "IDS sensor"
+	updateOpts := tapservices.UpdateOpts{
+		Description: &description,
+	}
+	actual, err := tapservices.Update(fake.ServiceClient(), "c352f537-ad49-48eb-ab05-1c6b8cb900ff", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "IDS sensor", actual.Description)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/taas/tap_services/c352f537-ad49-48eb-ab05-1c6b8cb900ff", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := tapservices.Delete(fake.ServiceClient(), "c352f537-ad49-48eb-ab05-1c6b8cb900ff")
+	th.AssertNoErr(t, res.Err)
+}
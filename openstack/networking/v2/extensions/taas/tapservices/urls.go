@@ -0,0 +1,16 @@
+package tapservices
+
+import "github.com/gophercloud/gophercloud"
+
+const (
+	rootPath     = "taas"
+	resourcePath = "tap_services"
+)
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath, resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, resourcePath, id)
+}
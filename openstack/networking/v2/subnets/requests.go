@@ -32,6 +32,7 @@ type ListOpts struct {
 	IPv6RAMode      string `q:"ipv6_ra_mode"`
 	ID              string `q:"id"`
 	SubnetPoolID    string `q:"subnetpool_id"`
+	SegmentID       string `q:"segment_id"`
 	Limit           int    `q:"limit"`
 	Marker          string `q:"marker"`
 	SortKey         string `q:"sort_key"`
@@ -141,6 +142,10 @@ type CreateOpts struct {
 	// Prefixlen is used when user creates a subnet from the subnetpool. It will
 	// overwrite the "default_prefixlen" value of the referenced subnetpool.
 	Prefixlen int `json:"prefixlen,omitempty"`
+
+	// SegmentID is the ID of the network segment the subnet is associated
+	// with, for routed provider networks.
+	SegmentID string `json:"segment_id,omitempty"`
 }
 
 // ToSubnetCreateMap builds a request body from CreateOpts.
@@ -206,6 +211,10 @@ type UpdateOpts struct {
 	// EnableDHCP will either enable to disable the DHCP service.
 	EnableDHCP *bool `json:"enable_dhcp,omitempty"`
 
+	// SegmentID associates the subnet with a network segment. It can only be
+	// set on a subnet which is not yet associated with a segment.
+	SegmentID *string `json:"segment_id,omitempty"`
+
 	// RevisionNumber implements extension:standard-attr-revisions. If != "" it
 	// will set revision_number=%s. If the revision number does not match, the
 	// update will fail.
@@ -363,6 +363,41 @@ const SubnetCreateRequestWithPrefixlen = `
 }
 `
 
+const SubnetCreateWithSegmentRequest = `
+{
+    "subnet": {
+        "network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+        "ip_version": 4,
+        "cidr": "203.0.113.0/24",
+        "segment_id": "83a59912-a473-4d1b-a2c7-b4b6a6f0a2a4"
+    }
+}
+`
+
+const SubnetCreateWithSegmentResponse = `
+{
+    "subnet": {
+        "name": "",
+        "enable_dhcp": true,
+        "network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+        "segment_id": "83a59912-a473-4d1b-a2c7-b4b6a6f0a2a4",
+        "tenant_id": "4fd44f30292945e481c7b8a0c8908869",
+        "dns_nameservers": [],
+        "allocation_pools": [
+            {
+                "start": "203.0.113.2",
+                "end": "203.0.113.254"
+            }
+        ],
+        "host_routes": [],
+        "ip_version": 4,
+        "gateway_ip": "203.0.113.1",
+        "cidr": "203.0.113.0/24",
+        "id": "9b3d8e8c-2a4b-4c8f-8f5c-0b6a4e2d1c7e"
+    }
+}
+`
+
 const SubnetUpdateRequest = `
 {
     "subnet": {
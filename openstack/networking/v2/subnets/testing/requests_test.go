@@ -385,6 +385,37 @@ func TestCreateWithPrefixlen(t *testing.T) {
 	th.AssertEquals(t, s.SubnetPoolID, "b80340c7-9960-4f67-a99c-02501656284b")
 }
 
+func TestCreateWithSegment(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/subnets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, SubnetCreateWithSegmentRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, SubnetCreateWithSegmentResponse)
+	})
+
+	opts := subnets.CreateOpts{
+		NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+		IPVersion: 4,
+		CIDR:      "203.0.113.0/24",
+		SegmentID: "83a59912-a473-4d1b-a2c7-b4b6a6f0a2a4",
+	}
+	s, err := subnets.Create(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, s.ID, "9b3d8e8c-2a4b-4c8f-8f5c-0b6a4e2d1c7e")
+	th.AssertEquals(t, s.SegmentID, "83a59912-a473-4d1b-a2c7-b4b6a6f0a2a4")
+	th.AssertEquals(t, s.CIDR, "203.0.113.0/24")
+}
+
 func TestRequiredCreateOpts(t *testing.T) {
 	res := subnets.Create(fake.ServiceClient(), subnets.CreateOpts{})
 	if res.Err == nil {
@@ -0,0 +1,43 @@
+/*
+Package topology collects the networking resources of a project and links them
+into a graph, which can be exported to DOT or JSON and checked for common
+problems.
+
+A Snapshot is taken with Collect, which lists the networks, subnets, ports,
+routers, floating IPs, security groups and trunks of the project
+concurrently. The router gateway ports and the external networks referenced
+by router gateways and floating IPs, which are not owned by the project, are
+retrieved individually.
+
+Example to Collect a Snapshot and Export it to DOT
+
+	snapshot, err := topology.Collect(networkClient, topology.CollectOpts{
+		ProjectID: "a99e9b4e620e4db09a2dfb6e42a01e66",
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	graph := topology.NewGraph(snapshot)
+	if err := graph.WriteDOT(os.Stdout); err != nil {
+		panic(err)
+	}
+
+Example to Find the Router Interfaces of a Subnet
+
+	for _, edge := range graph.EdgesTo(subnetID) {
+		port := graph.Node(edge.From)
+		for _, e := range graph.EdgesTo(port.ID) {
+			if e.Kind == topology.EdgeRouterInterface {
+				fmt.Printf("router %s: %s\n", e.From, edge.Label)
+			}
+		}
+	}
+
+Example to Check a Snapshot for Problems
+
+	for _, problem := range topology.Check(snapshot) {
+		fmt.Println(problem)
+	}
+*/
+package topology
@@ -0,0 +1,23 @@
+package topology
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ErrCollectFailed is returned by Collect when a resource cannot be listed
+// or retrieved.
+type ErrCollectFailed struct {
+	gophercloud.BaseError
+	Resource NodeKind
+	Err      error
+}
+
+func (e ErrCollectFailed) Error() string {
+	return fmt.Sprintf("Failed to collect %ss: %s", e.Resource, e.Err)
+}
+
+func (e ErrCollectFailed) Unwrap() error {
+	return e.Err
+}
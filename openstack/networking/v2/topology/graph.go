@@ -0,0 +1,315 @@
+package topology
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// NodeKind is the kind of resource a Node represents.
+type NodeKind string
+
+const (
+	NodeNetwork       NodeKind = "network"
+	NodeSubnet        NodeKind = "subnet"
+	NodePort          NodeKind = "port"
+	NodeRouter        NodeKind = "router"
+	NodeFloatingIP    NodeKind = "floating-ip"
+	NodeSecurityGroup NodeKind = "security-group"
+	NodeTrunk         NodeKind = "trunk"
+)
+
+// EdgeKind is the kind of relation an Edge represents.
+type EdgeKind string
+
+const (
+	// EdgeSubnetNetwork links a subnet to its network.
+	EdgeSubnetNetwork EdgeKind = "subnet-network"
+
+	// EdgePortNetwork links a port to its network.
+	EdgePortNetwork EdgeKind = "port-network"
+
+	// EdgeFixedIP links a port to a subnet it has a fixed IP on. The label
+	// is the IP address.
+	EdgeFixedIP EdgeKind = "fixed-ip"
+
+	// EdgeRouterInterface links a router to one of its interface ports.
+	EdgeRouterInterface EdgeKind = "router-interface"
+
+	// EdgeRouterGatewayPort links a router to its gateway port.
+	EdgeRouterGatewayPort EdgeKind = "router-gateway-port"
+
+	// EdgeRouterGateway links a router to its external network. The label
+	// is the list of external fixed IPs.
+	EdgeRouterGateway EdgeKind = "router-gateway"
+
+	// EdgeFloatingIPPort links a floating IP to the port it is associated
+	// with. The label is the fixed IP address it is mapped to.
+	EdgeFloatingIPPort EdgeKind = "floating-ip-port"
+
+	// EdgeFloatingIPNetwork links a floating IP to the network it is
+	// allocated from.
+	EdgeFloatingIPNetwork EdgeKind = "floating-ip-network"
+
+	// EdgeSecurityGroup links a port to one of its security groups.
+	EdgeSecurityGroup EdgeKind = "security-group"
+
+	// EdgeTrunkParent links a trunk to its parent port.
+	EdgeTrunkParent EdgeKind = "trunk-parent"
+
+	// EdgeTrunkSubport links a trunk to one of its subports. The label is
+	// the segmentation type and ID.
+	EdgeTrunkSubport EdgeKind = "trunk-subport"
+)
+
+// deviceOwnerRouterGateway is the device owner of router gateway ports.
+const deviceOwnerRouterGateway = "network:router_gateway"
+
+// routerInterfaceOwners are the device owners of router interface ports, for
+// legacy, distributed and highly available routers.
+var routerInterfaceOwners = map[string]bool{
+	"network:router_interface":               true,
+	"network:router_interface_distributed":   true,
+	"network:ha_router_replicated_interface": true,
+}
+
+// Node is a resource of the graph.
+type Node struct {
+	ID         string            `json:"id"`
+	Kind       NodeKind          `json:"kind"`
+	Name       string            `json:"name,omitempty"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// Edge is a directed relation between two nodes of the graph.
+type Edge struct {
+	From  string   `json:"from"`
+	To    string   `json:"to"`
+	Kind  EdgeKind `json:"kind"`
+	Label string   `json:"label,omitempty"`
+}
+
+// Graph links the resources of a Snapshot. Edges only join nodes which are
+// part of the graph: a port using a security group of another project, for
+// example, has no edge to it.
+type Graph struct {
+	Nodes []Node `json:"nodes"`
+	Edges []Edge `json:"edges"`
+
+	nodes map[string]int
+	from  map[string][]int
+	to    map[string][]int
+}
+
+// NewGraph builds the graph of a Snapshot. Nodes and edges are in the order
+// of the resources of the snapshot.
+func NewGraph(s *Snapshot) *Graph {
+	g := &Graph{
+		Nodes: []Node{},
+		Edges: []Edge{},
+		nodes: make(map[string]int),
+		from:  make(map[string][]int),
+		to:    make(map[string][]int),
+	}
+
+	for _, n := range s.Networks {
+		g.addNode(n.ID, NodeNetwork, n.Name, map[string]string{
+			"status": n.Status,
+			"shared": strconv.FormatBool(n.Shared),
+		})
+	}
+	for _, n := range s.ExternalNetworks {
+		g.addNode(n.ID, NodeNetwork, n.Name, map[string]string{
+			"status":   n.Status,
+			"shared":   strconv.FormatBool(n.Shared),
+			"external": "true",
+		})
+	}
+	for _, sn := range s.Subnets {
+		g.addNode(sn.ID, NodeSubnet, sn.Name, map[string]string{
+			"cidr":       sn.CIDR,
+			"gateway_ip": sn.GatewayIP,
+		})
+	}
+	for _, p := range s.Ports {
+		g.addNode(p.ID, NodePort, p.Name, map[string]string{
+			"status":       p.Status,
+			"device_owner": p.DeviceOwner,
+			"device_id":    p.DeviceID,
+			"mac_address":  p.MACAddress,
+		})
+	}
+	for _, r := range s.Routers {
+		g.addNode(r.ID, NodeRouter, r.Name, map[string]string{
+			"status": r.Status,
+		})
+	}
+	for _, fip := range s.FloatingIPs {
+		g.addNode(fip.ID, NodeFloatingIP, fip.FloatingIP, map[string]string{
+			"status":   fip.Status,
+			"fixed_ip": fip.FixedIP,
+		})
+	}
+	for _, sg := range s.SecurityGroups {
+		g.addNode(sg.ID, NodeSecurityGroup, sg.Name, nil)
+	}
+	for _, t := range s.Trunks {
+		g.addNode(t.ID, NodeTrunk, t.Name, map[string]string{
+			"status": t.Status,
+		})
+	}
+
+	for _, sn := range s.Subnets {
+		g.addEdge(sn.ID, sn.NetworkID, EdgeSubnetNetwork, "")
+	}
+	for _, p := range s.Ports {
+		g.addEdge(p.ID, p.NetworkID, EdgePortNetwork, "")
+		for _, ip := range p.FixedIPs {
+			g.addEdge(p.ID, ip.SubnetID, EdgeFixedIP, ip.IPAddress)
+		}
+		for _, sg := range p.SecurityGroups {
+			g.addEdge(p.ID, sg, EdgeSecurityGroup, "")
+		}
+		if isRouterGatewayPort(p.DeviceOwner) {
+			g.addEdge(p.DeviceID, p.ID, EdgeRouterGatewayPort, "")
+		} else if isRouterInterfacePort(p.DeviceOwner) {
+			g.addEdge(p.DeviceID, p.ID, EdgeRouterInterface, "")
+		}
+	}
+	for _, r := range s.Routers {
+		if r.GatewayInfo.NetworkID == "" {
+			continue
+		}
+		ips := make([]string, 0, len(r.GatewayInfo.ExternalFixedIPs))
+		for _, ip := range r.GatewayInfo.ExternalFixedIPs {
+			ips = append(ips, ip.IPAddress)
+		}
+		g.addEdge(r.ID, r.GatewayInfo.NetworkID, EdgeRouterGateway, strings.Join(ips, ", "))
+	}
+	for _, fip := range s.FloatingIPs {
+		g.addEdge(fip.ID, fip.FloatingNetworkID, EdgeFloatingIPNetwork, "")
+		if fip.PortID != "" {
+			g.addEdge(fip.ID, fip.PortID, EdgeFloatingIPPort, fip.FixedIP)
+		}
+	}
+	for _, t := range s.Trunks {
+		g.addEdge(t.ID, t.PortID, EdgeTrunkParent, "")
+		for _, sp := range t.Subports {
+			g.addEdge(t.ID, sp.PortID, EdgeTrunkSubport, fmt.Sprintf("%s %d", sp.SegmentationType, sp.SegmentationID))
+		}
+	}
+
+	return g
+}
+
+func isRouterGatewayPort(deviceOwner string) bool {
+	return deviceOwner == deviceOwnerRouterGateway
+}
+
+func isRouterInterfacePort(deviceOwner string) bool {
+	return routerInterfaceOwners[deviceOwner]
+}
+
+func (g *Graph) addNode(id string, kind NodeKind, name string, attributes map[string]string) {
+	if _, ok := g.nodes[id]; ok {
+		return
+	}
+	for k, v := range attributes {
+		if v == "" {
+			delete(attributes, k)
+		}
+	}
+	if len(attributes) == 0 {
+		attributes = nil
+	}
+	g.nodes[id] = len(g.Nodes)
+	g.Nodes = append(g.Nodes, Node{ID: id, Kind: kind, Name: name, Attributes: attributes})
+}
+
+func (g *Graph) addEdge(from, to string, kind EdgeKind, label string) {
+	if _, ok := g.nodes[from]; !ok {
+		return
+	}
+	if _, ok := g.nodes[to]; !ok {
+		return
+	}
+	i := len(g.Edges)
+	g.Edges = append(g.Edges, Edge{From: from, To: to, Kind: kind, Label: label})
+	g.from[from] = append(g.from[from], i)
+	g.to[to] = append(g.to[to], i)
+}
+
+// Node returns the node with the given ID, or nil if there is none.
+func (g *Graph) Node(id string) *Node {
+	i, ok := g.nodes[id]
+	if !ok {
+		return nil
+	}
+	return &g.Nodes[i]
+}
+
+// EdgesFrom returns the edges starting at the node with the given ID.
+func (g *Graph) EdgesFrom(id string) []Edge {
+	return g.edges(g.from[id])
+}
+
+// EdgesTo returns the edges ending at the node with the given ID.
+func (g *Graph) EdgesTo(id string) []Edge {
+	return g.edges(g.to[id])
+}
+
+func (g *Graph) edges(indexes []int) []Edge {
+	edges := make([]Edge, 0, len(indexes))
+	for _, i := range indexes {
+		edges = append(edges, g.Edges[i])
+	}
+	return edges
+}
+
+// dotShapes are the Graphviz shapes of the nodes of each kind.
+var dotShapes = map[NodeKind]string{
+	NodeNetwork:       "box",
+	NodeSubnet:        "note",
+	NodePort:          "ellipse",
+	NodeRouter:        "diamond",
+	NodeFloatingIP:    "octagon",
+	NodeSecurityGroup: "hexagon",
+	NodeTrunk:         "component",
+}
+
+// WriteDOT writes the graph in the Graphviz DOT language.
+func (g *Graph) WriteDOT(w io.Writer) error {
+	var b strings.Builder
+
+	b.WriteString("digraph topology {\n")
+	for _, n := range g.Nodes {
+		name := n.Name
+		if name == "" {
+			name = n.ID
+		}
+		fmt.Fprintf(&b, "\t%s [label=%s, shape=%s];\n",
+			strconv.Quote(n.ID), strconv.Quote(string(n.Kind)+"\n"+name), dotShapes[n.Kind])
+	}
+	for _, e := range g.Edges {
+		label := string(e.Kind)
+		if e.Label != "" {
+			label += "\n" + e.Label
+		}
+		fmt.Fprintf(&b, "\t%s -> %s [label=%s];\n",
+			strconv.Quote(e.From), strconv.Quote(e.To), strconv.Quote(label))
+	}
+	b.WriteString("}\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteJSON writes the graph as a JSON object with "nodes" and "edges"
+// arrays.
+func (g *Graph) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(g)
+}
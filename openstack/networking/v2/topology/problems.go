@@ -0,0 +1,136 @@
+package topology
+
+import (
+	"fmt"
+)
+
+// ProblemKind is the kind of a Problem found by Check.
+type ProblemKind string
+
+const (
+	// ProblemOrphanedPort is a port which is neither bound to a device nor
+	// part of a trunk, and whose addresses are not used as allowed address
+	// pairs by another port.
+	ProblemOrphanedPort ProblemKind = "orphaned-port"
+
+	// ProblemSubnetWithoutRouter is a subnet with a gateway IP which no
+	// router has a port on.
+	ProblemSubnetWithoutRouter ProblemKind = "subnet-without-router"
+
+	// ProblemNetworkWithoutSubnet is a network which has no subnet.
+	ProblemNetworkWithoutSubnet ProblemKind = "network-without-subnet"
+
+	// ProblemUnassociatedFloatingIP is a floating IP which is not associated
+	// with a port.
+	ProblemUnassociatedFloatingIP ProblemKind = "unassociated-floating-ip"
+)
+
+// Problem is a possible misconfiguration found in a Snapshot.
+type Problem struct {
+	Kind       ProblemKind `json:"kind"`
+	ResourceID string      `json:"resource_id"`
+	Message    string      `json:"message"`
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("%s %s: %s", p.Kind, p.ResourceID, p.Message)
+}
+
+// Check looks for common problems in a Snapshot. Problems are returned
+// grouped by kind, in the order of the resources of the snapshot.
+func Check(s *Snapshot) []Problem {
+	problems := []Problem{}
+
+	// Ports which are part of a trunk.
+	trunkPorts := make(map[string]bool)
+	for _, t := range s.Trunks {
+		trunkPorts[t.PortID] = true
+		for _, sp := range t.Subports {
+			trunkPorts[sp.PortID] = true
+		}
+	}
+
+	// IP addresses used as allowed address pairs, such as virtual IPs.
+	pairs := make(map[string]bool)
+	for _, p := range s.Ports {
+		for _, pair := range p.AllowedAddressPairs {
+			pairs[pair.IPAddress] = true
+		}
+	}
+
+	for _, p := range s.Ports {
+		if p.DeviceID != "" || p.DeviceOwner != "" || trunkPorts[p.ID] {
+			continue
+		}
+		vip := false
+		for _, ip := range p.FixedIPs {
+			if pairs[ip.IPAddress] {
+				vip = true
+				break
+			}
+		}
+		if vip {
+			continue
+		}
+		problems = append(problems, Problem{
+			Kind:       ProblemOrphanedPort,
+			ResourceID: p.ID,
+			Message:    fmt.Sprintf("port %q is not bound to any device", p.Name),
+		})
+	}
+
+	// Subnets a router has a port on.
+	routed := make(map[string]bool)
+	for _, p := range s.Ports {
+		if !isRouterInterfacePort(p.DeviceOwner) && !isRouterGatewayPort(p.DeviceOwner) {
+			continue
+		}
+		for _, ip := range p.FixedIPs {
+			routed[ip.SubnetID] = true
+		}
+	}
+	for _, r := range s.Routers {
+		for _, ip := range r.GatewayInfo.ExternalFixedIPs {
+			routed[ip.SubnetID] = true
+		}
+	}
+
+	for _, sn := range s.Subnets {
+		if sn.GatewayIP == "" || routed[sn.ID] {
+			continue
+		}
+		problems = append(problems, Problem{
+			Kind:       ProblemSubnetWithoutRouter,
+			ResourceID: sn.ID,
+			Message:    fmt.Sprintf("subnet %q (%s) has gateway %s but no router interface", sn.Name, sn.CIDR, sn.GatewayIP),
+		})
+	}
+
+	withSubnets := make(map[string]bool)
+	for _, sn := range s.Subnets {
+		withSubnets[sn.NetworkID] = true
+	}
+	for _, n := range s.Networks {
+		if len(n.Subnets) > 0 || withSubnets[n.ID] {
+			continue
+		}
+		problems = append(problems, Problem{
+			Kind:       ProblemNetworkWithoutSubnet,
+			ResourceID: n.ID,
+			Message:    fmt.Sprintf("network %q has no subnet", n.Name),
+		})
+	}
+
+	for _, fip := range s.FloatingIPs {
+		if fip.PortID != "" {
+			continue
+		}
+		problems = append(problems, Problem{
+			Kind:       ProblemUnassociatedFloatingIP,
+			ResourceID: fip.ID,
+			Message:    fmt.Sprintf("floating IP %s is not associated with any port", fip.FloatingIP),
+		})
+	}
+
+	return problems
+}
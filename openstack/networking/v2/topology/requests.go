@@ -0,0 +1,198 @@
+package topology
+
+import (
+	"sync"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/trunks"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
+)
+
+// CollectOpts specifies the resources collected by Collect.
+type CollectOpts struct {
+	// ProjectID restricts the collected resources to those owned by a
+	// project. If empty, every resource visible to the client is collected.
+	ProjectID string
+
+	// SkipTrunks disables the listing of trunks, for clouds which do not
+	// provide the trunk extension.
+	SkipTrunks bool
+}
+
+// Collect lists the networking resources of a project concurrently and
+// returns them as a Snapshot. If a listing fails, Collect returns an
+// ErrCollectFailed for the first resource which failed.
+//
+// When ProjectID is set, the gateway ports of the routers are listed by
+// router, as Neutron creates them without a project. Their listing usually
+// requires the admin role: with a client which cannot see them, the graph has
+// no router gateway port edges.
+func Collect(c *gophercloud.ServiceClient, opts CollectOpts) (*Snapshot, error) {
+	s := &Snapshot{ProjectID: opts.ProjectID}
+
+	listers := []struct {
+		resource NodeKind
+		list     func() error
+	}{
+		{NodeNetwork, func() (err error) {
+			pages, err := networks.List(c, networks.ListOpts{ProjectID: opts.ProjectID}).AllPages()
+			if err != nil {
+				return err
+			}
+			s.Networks, err = networks.ExtractNetworks(pages)
+			return err
+		}},
+		{NodeSubnet, func() (err error) {
+			pages, err := subnets.List(c, subnets.ListOpts{ProjectID: opts.ProjectID}).AllPages()
+			if err != nil {
+				return err
+			}
+			s.Subnets, err = subnets.ExtractSubnets(pages)
+			return err
+		}},
+		{NodePort, func() (err error) {
+			pages, err := ports.List(c, ports.ListOpts{ProjectID: opts.ProjectID}).AllPages()
+			if err != nil {
+				return err
+			}
+			s.Ports, err = ports.ExtractPorts(pages)
+			return err
+		}},
+		{NodeRouter, func() (err error) {
+			pages, err := routers.List(c, routers.ListOpts{ProjectID: opts.ProjectID}).AllPages()
+			if err != nil {
+				return err
+			}
+			s.Routers, err = routers.ExtractRouters(pages)
+			return err
+		}},
+		{NodeFloatingIP, func() (err error) {
+			pages, err := floatingips.List(c, floatingips.ListOpts{ProjectID: opts.ProjectID}).AllPages()
+			if err != nil {
+				return err
+			}
+			s.FloatingIPs, err = floatingips.ExtractFloatingIPs(pages)
+			return err
+		}},
+		{NodeSecurityGroup, func() (err error) {
+			pages, err := groups.List(c, groups.ListOpts{ProjectID: opts.ProjectID}).AllPages()
+			if err != nil {
+				return err
+			}
+			s.SecurityGroups, err = groups.ExtractGroups(pages)
+			return err
+		}},
+	}
+	if !opts.SkipTrunks {
+		listers = append(listers, struct {
+			resource NodeKind
+			list     func() error
+		}{NodeTrunk, func() (err error) {
+			pages, err := trunks.List(c, trunks.ListOpts{ProjectID: opts.ProjectID}).AllPages()
+			if err != nil {
+				return err
+			}
+			s.Trunks, err = trunks.ExtractTrunks(pages)
+			return err
+		}})
+	}
+
+	// Each listing writes to its own field of the snapshot and its own slot
+	// of errs, so no locking is needed.
+	errs := make([]error, len(listers))
+	var wg sync.WaitGroup
+	for i := range listers {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = listers[i].list()
+		}(i)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return nil, ErrCollectFailed{Resource: listers[i].resource, Err: err}
+		}
+	}
+
+	// Neutron creates router gateway ports without a project, so the
+	// project filter of the port listing leaves them out.
+	if opts.ProjectID != "" {
+		if err := s.collectGatewayPorts(c); err != nil {
+			return nil, ErrCollectFailed{Resource: NodePort, Err: err}
+		}
+	}
+
+	for _, id := range s.missingNetworkIDs() {
+		network, err := networks.Get(c, id).Extract()
+		if err != nil {
+			return nil, ErrCollectFailed{Resource: NodeNetwork, Err: err}
+		}
+		s.ExternalNetworks = append(s.ExternalNetworks, *network)
+	}
+
+	return s, nil
+}
+
+// collectGatewayPorts lists the gateway ports of the collected routers and
+// adds those which are not part of the collected ports.
+func (s *Snapshot) collectGatewayPorts(c *gophercloud.ServiceClient) error {
+	known := make(map[string]bool, len(s.Ports))
+	for _, p := range s.Ports {
+		known[p.ID] = true
+	}
+
+	for _, r := range s.Routers {
+		if r.GatewayInfo.NetworkID == "" {
+			continue
+		}
+		pages, err := ports.List(c, ports.ListOpts{
+			DeviceID:    r.ID,
+			DeviceOwner: deviceOwnerRouterGateway,
+		}).AllPages()
+		if err != nil {
+			return err
+		}
+		gatewayPorts, err := ports.ExtractPorts(pages)
+		if err != nil {
+			return err
+		}
+		for _, p := range gatewayPorts {
+			if !known[p.ID] {
+				known[p.ID] = true
+				s.Ports = append(s.Ports, p)
+			}
+		}
+	}
+	return nil
+}
+
+// missingNetworkIDs returns the IDs of the networks referenced by router
+// gateways and floating IPs which are not part of the listed networks.
+func (s *Snapshot) missingNetworkIDs() []string {
+	known := make(map[string]bool, len(s.Networks))
+	for _, n := range s.Networks {
+		known[n.ID] = true
+	}
+
+	var ids []string
+	add := func(id string) {
+		if id != "" && !known[id] {
+			known[id] = true
+			ids = append(ids, id)
+		}
+	}
+	for _, r := range s.Routers {
+		add(r.GatewayInfo.NetworkID)
+	}
+	for _, fip := range s.FloatingIPs {
+		add(fip.FloatingNetworkID)
+	}
+	return ids
+}
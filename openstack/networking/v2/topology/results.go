@@ -0,0 +1,32 @@
+package topology
+
+import (
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/trunks"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
+)
+
+// Snapshot holds the networking resources of a project at the time of a
+// Collect call.
+type Snapshot struct {
+	// ProjectID is the project the resources were collected for. It is empty
+	// when every resource visible to the client was collected.
+	ProjectID string `json:"project_id,omitempty"`
+
+	Networks       []networks.Network       `json:"networks"`
+	Subnets        []subnets.Subnet         `json:"subnets"`
+	Ports          []ports.Port             `json:"ports"`
+	Routers        []routers.Router         `json:"routers"`
+	FloatingIPs    []floatingips.FloatingIP `json:"floating_ips"`
+	SecurityGroups []groups.SecGroup        `json:"security_groups"`
+	Trunks         []trunks.Trunk           `json:"trunks"`
+
+	// ExternalNetworks are the networks referenced by a router gateway or a
+	// floating IP which are not part of Networks, usually because they are
+	// owned by another project.
+	ExternalNetworks []networks.Network `json:"external_networks,omitempty"`
+}
@@ -0,0 +1,384 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/openstack/networking/v2/common"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+const (
+	ProjectID = "a99e9b4e620e4db09a2dfb6e42a01e66"
+
+	PrivateNetworkID  = "1f5c2f3a-4b6d-4e8f-9a0b-1c2d3e4f5a6b"
+	IsolatedNetworkID = "2a6d3a4b-5c7e-4f9a-ab1c-2d3e4f5a6b7c"
+	EmptyNetworkID    = "3b7e4b5c-6d8f-4a0b-bc2d-3e4f5a6b7c8d"
+	PublicNetworkID   = "4c8f5c6d-7e9a-4b1c-cd3e-4f5a6b7c8d9e"
+
+	PrivateSubnetID  = "5d9a6d7e-8f0b-4c2d-de4f-5a6b7c8d9e0f"
+	IsolatedSubnetID = "6e0b7e8f-9a1c-4d3e-ef5a-6b7c8d9e0f1a"
+	PublicSubnetID   = "7f1c8f9a-0b2d-4e4f-fa6b-7c8d9e0f1a2b"
+
+	RouterID = "8a2d9a0b-1c3e-4f5a-ab7c-8d9e0f1a2b3c"
+
+	RouterInterfacePortID = "9b3e0b1c-2d4f-4a6b-bc8d-9e0f1a2b3c4d"
+	RouterGatewayPortID   = "0c4f1c2d-3e5a-4b7c-cd9e-0f1a2b3c4d5e"
+	ServerPortID          = "1d5a2d3e-4f6b-4c8d-de0f-1a2b3c4d5e6f"
+	OrphanedPortID        = "2e6b3e4f-5a7c-4d9e-ef1a-2b3c4d5e6f7a"
+	VIPPortID             = "3f7c4f5a-6b8d-4e0f-fa2b-3c4d5e6f7a8b"
+	TrunkParentPortID     = "4a8d5a6b-7c9e-4f1a-ab3c-4d5e6f7a8b9c"
+	TrunkSubportID        = "5b9e6b7c-8d0f-4a2b-bc4d-5e6f7a8b9c0d"
+
+	AssociatedFloatingIPID   = "6c0f7c8d-9e1a-4b3c-cd5e-6f7a8b9c0d1e"
+	UnassociatedFloatingIPID = "7d1a8d9e-0f2b-4c4d-de6f-7a8b9c0d1e2f"
+
+	SecurityGroupID = "8e2b9e0f-1a3c-4d5e-ef7a-8b9c0d1e2f3a"
+
+	TrunkID = "9f3c0f1a-2b4d-4e6f-fa8b-9c0d1e2f3a4b"
+)
+
+// NetworksListBody is the canned body of the network List request.
+const NetworksListBody = `
+{
+	"networks": [
+		{
+			"id": "1f5c2f3a-4b6d-4e8f-9a0b-1c2d3e4f5a6b",
+			"name": "private",
+			"status": "ACTIVE",
+			"subnets": ["5d9a6d7e-8f0b-4c2d-de4f-5a6b7c8d9e0f"],
+			"shared": false,
+			"project_id": "a99e9b4e620e4db09a2dfb6e42a01e66"
+		},
+		{
+			"id": "2a6d3a4b-5c7e-4f9a-ab1c-2d3e4f5a6b7c",
+			"name": "isolated",
+			"status": "ACTIVE",
+			"subnets": ["6e0b7e8f-9a1c-4d3e-ef5a-6b7c8d9e0f1a"],
+			"shared": false,
+			"project_id": "a99e9b4e620e4db09a2dfb6e42a01e66"
+		},
+		{
+			"id": "3b7e4b5c-6d8f-4a0b-bc2d-3e4f5a6b7c8d",
+			"name": "empty",
+			"status": "ACTIVE",
+			"subnets": [],
+			"shared": false,
+			"project_id": "a99e9b4e620e4db09a2dfb6e42a01e66"
+		}
+	]
+}
+`
+
+// PublicNetworkGetBody is the canned body of the Get request of the
+// external network.
+const PublicNetworkGetBody = `
+{
+	"network": {
+		"id": "4c8f5c6d-7e9a-4b1c-cd3e-4f5a6b7c8d9e",
+		"name": "public",
+		"status": "ACTIVE",
+		"subnets": ["7f1c8f9a-0b2d-4e4f-fa6b-7c8d9e0f1a2b"],
+		"shared": false,
+		"project_id": "d6b5c4a3f2e1d0c9b8a7f6e5d4c3b2a1"
+	}
+}
+`
+
+// SubnetsListBody is the canned body of the subnet List request.
+const SubnetsListBody = `
+{
+	"subnets": [
+		{
+			"id": "5d9a6d7e-8f0b-4c2d-de4f-5a6b7c8d9e0f",
+			"name": "private-subnet",
+			"network_id": "1f5c2f3a-4b6d-4e8f-9a0b-1c2d3e4f5a6b",
+			"cidr": "10.0.0.0/24",
+			"gateway_ip": "10.0.0.1",
+			"ip_version": 4,
+			"project_id": "a99e9b4e620e4db09a2dfb6e42a01e66"
+		},
+		{
+			"id": "6e0b7e8f-9a1c-4d3e-ef5a-6b7c8d9e0f1a",
+			"name": "isolated-subnet",
+			"network_id": "2a6d3a4b-5c7e-4f9a-ab1c-2d3e4f5a6b7c",
+			"cidr": "10.1.0.0/24",
+			"gateway_ip": "10.1.0.1",
+			"ip_version": 4,
+			"project_id": "a99e9b4e620e4db09a2dfb6e42a01e66"
+		}
+	]
+}
+`
+
+// PortsListBody is the canned body of the port List request.
+const PortsListBody = `
+{
+	"ports": [
+		{
+			"id": "9b3e0b1c-2d4f-4a6b-bc8d-9e0f1a2b3c4d",
+			"name": "",
+			"network_id": "1f5c2f3a-4b6d-4e8f-9a0b-1c2d3e4f5a6b",
+			"status": "ACTIVE",
+			"mac_address": "fa:16:3e:00:00:01",
+			"fixed_ips": [
+				{"subnet_id": "5d9a6d7e-8f0b-4c2d-de4f-5a6b7c8d9e0f", "ip_address": "10.0.0.1"}
+			],
+			"device_owner": "network:router_interface",
+			"device_id": "8a2d9a0b-1c3e-4f5a-ab7c-8d9e0f1a2b3c",
+			"security_groups": [],
+			"project_id": "a99e9b4e620e4db09a2dfb6e42a01e66"
+		},
+		{
+			"id": "1d5a2d3e-4f6b-4c8d-de0f-1a2b3c4d5e6f",
+			"name": "web",
+			"network_id": "1f5c2f3a-4b6d-4e8f-9a0b-1c2d3e4f5a6b",
+			"status": "ACTIVE",
+			"mac_address": "fa:16:3e:00:00:03",
+			"fixed_ips": [
+				{"subnet_id": "5d9a6d7e-8f0b-4c2d-de4f-5a6b7c8d9e0f", "ip_address": "10.0.0.5"}
+			],
+			"allowed_address_pairs": [
+				{"ip_address": "10.0.0.100"}
+			],
+			"device_owner": "compute:nova",
+			"device_id": "f1e2d3c4-b5a6-4978-8695-a4b3c2d1e0f9",
+			"security_groups": ["8e2b9e0f-1a3c-4d5e-ef7a-8b9c0d1e2f3a"],
+			"project_id": "a99e9b4e620e4db09a2dfb6e42a01e66"
+		},
+		{
+			"id": "2e6b3e4f-5a7c-4d9e-ef1a-2b3c4d5e6f7a",
+			"name": "leftover",
+			"network_id": "1f5c2f3a-4b6d-4e8f-9a0b-1c2d3e4f5a6b",
+			"status": "DOWN",
+			"mac_address": "fa:16:3e:00:00:04",
+			"fixed_ips": [
+				{"subnet_id": "5d9a6d7e-8f0b-4c2d-de4f-5a6b7c8d9e0f", "ip_address": "10.0.0.20"}
+			],
+			"device_owner": "",
+			"device_id": "",
+			"security_groups": ["8e2b9e0f-1a3c-4d5e-ef7a-8b9c0d1e2f3a"],
+			"project_id": "a99e9b4e620e4db09a2dfb6e42a01e66"
+		},
+		{
+			"id": "3f7c4f5a-6b8d-4e0f-fa2b-3c4d5e6f7a8b",
+			"name": "vip",
+			"network_id": "1f5c2f3a-4b6d-4e8f-9a0b-1c2d3e4f5a6b",
+			"status": "DOWN",
+			"mac_address": "fa:16:3e:00:00:05",
+			"fixed_ips": [
+				{"subnet_id": "5d9a6d7e-8f0b-4c2d-de4f-5a6b7c8d9e0f", "ip_address": "10.0.0.100"}
+			],
+			"device_owner": "",
+			"device_id": "",
+			"security_groups": [],
+			"project_id": "a99e9b4e620e4db09a2dfb6e42a01e66"
+		},
+		{
+			"id": "4a8d5a6b-7c9e-4f1a-ab3c-4d5e6f7a8b9c",
+			"name": "trunk-parent",
+			"network_id": "2a6d3a4b-5c7e-4f9a-ab1c-2d3e4f5a6b7c",
+			"status": "ACTIVE",
+			"mac_address": "fa:16:3e:00:00:06",
+			"fixed_ips": [
+				{"subnet_id": "6e0b7e8f-9a1c-4d3e-ef5a-6b7c8d9e0f1a", "ip_address": "10.1.0.5"}
+			],
+			"device_owner": "compute:nova",
+			"device_id": "a0b1c2d3-e4f5-4a6b-8c7d-9e0f1a2b3c4d",
+			"security_groups": [],
+			"project_id": "a99e9b4e620e4db09a2dfb6e42a01e66"
+		},
+		{
+			"id": "5b9e6b7c-8d0f-4a2b-bc4d-5e6f7a8b9c0d",
+			"name": "trunk-subport",
+			"network_id": "2a6d3a4b-5c7e-4f9a-ab1c-2d3e4f5a6b7c",
+			"status": "ACTIVE",
+			"mac_address": "fa:16:3e:00:00:06",
+			"fixed_ips": [
+				{"subnet_id": "6e0b7e8f-9a1c-4d3e-ef5a-6b7c8d9e0f1a", "ip_address": "10.1.0.6"}
+			],
+			"device_owner": "",
+			"device_id": "",
+			"security_groups": [],
+			"project_id": "a99e9b4e620e4db09a2dfb6e42a01e66"
+		}
+	]
+}
+`
+
+// RouterGatewayPortsListBody is the canned body of the port List request of
+// the gateway port of the router. Neutron creates the port without a project.
+const RouterGatewayPortsListBody = `
+{
+	"ports": [
+		{
+			"id": "0c4f1c2d-3e5a-4b7c-cd9e-0f1a2b3c4d5e",
+			"name": "",
+			"network_id": "4c8f5c6d-7e9a-4b1c-cd3e-4f5a6b7c8d9e",
+			"status": "ACTIVE",
+			"mac_address": "fa:16:3e:00:00:02",
+			"fixed_ips": [
+				{"subnet_id": "7f1c8f9a-0b2d-4e4f-fa6b-7c8d9e0f1a2b", "ip_address": "172.24.4.10"}
+			],
+			"device_owner": "network:router_gateway",
+			"device_id": "8a2d9a0b-1c3e-4f5a-ab7c-8d9e0f1a2b3c",
+			"security_groups": [],
+			"project_id": ""
+		}
+	]
+}
+`
+
+// RoutersListBody is the canned body of the router List request.
+const RoutersListBody = `
+{
+	"routers": [
+		{
+			"id": "8a2d9a0b-1c3e-4f5a-ab7c-8d9e0f1a2b3c",
+			"name": "router1",
+			"status": "ACTIVE",
+			"admin_state_up": true,
+			"external_gateway_info": {
+				"network_id": "4c8f5c6d-7e9a-4b1c-cd3e-4f5a6b7c8d9e",
+				"enable_snat": true,
+				"external_fixed_ips": [
+					{"subnet_id": "7f1c8f9a-0b2d-4e4f-fa6b-7c8d9e0f1a2b", "ip_address": "172.24.4.10"}
+				]
+			},
+			"project_id": "a99e9b4e620e4db09a2dfb6e42a01e66"
+		}
+	]
+}
+`
+
+// FloatingIPsListBody is the canned body of the floating IP List request.
+const FloatingIPsListBody = `
+{
+	"floatingips": [
+		{
+			"id": "6c0f7c8d-9e1a-4b3c-cd5e-6f7a8b9c0d1e",
+			"floating_network_id": "4c8f5c6d-7e9a-4b1c-cd3e-4f5a6b7c8d9e",
+			"floating_ip_address": "172.24.4.20",
+			"port_id": "1d5a2d3e-4f6b-4c8d-de0f-1a2b3c4d5e6f",
+			"fixed_ip_address": "10.0.0.5",
+			"router_id": "8a2d9a0b-1c3e-4f5a-ab7c-8d9e0f1a2b3c",
+			"status": "ACTIVE",
+			"project_id": "a99e9b4e620e4db09a2dfb6e42a01e66"
+		},
+		{
+			"id": "7d1a8d9e-0f2b-4c4d-de6f-7a8b9c0d1e2f",
+			"floating_network_id": "4c8f5c6d-7e9a-4b1c-cd3e-4f5a6b7c8d9e",
+			"floating_ip_address": "172.24.4.21",
+			"port_id": null,
+			"fixed_ip_address": null,
+			"router_id": null,
+			"status": "DOWN",
+			"project_id": "a99e9b4e620e4db09a2dfb6e42a01e66"
+		}
+	]
+}
+`
+
+// SecurityGroupsListBody is the canned body of the security group List
+// request.
+const SecurityGroupsListBody = `
+{
+	"security_groups": [
+		{
+			"id": "8e2b9e0f-1a3c-4d5e-ef7a-8b9c0d1e2f3a",
+			"name": "default",
+			"description": "Default security group",
+			"security_group_rules": [],
+			"project_id": "a99e9b4e620e4db09a2dfb6e42a01e66"
+		}
+	]
+}
+`
+
+// TrunksListBody is the canned body of the trunk List request.
+const TrunksListBody = `
+{
+	"trunks": [
+		{
+			"id": "9f3c0f1a-2b4d-4e6f-fa8b-9c0d1e2f3a4b",
+			"name": "trunk1",
+			"status": "ACTIVE",
+			"admin_state_up": true,
+			"port_id": "4a8d5a6b-7c9e-4f1a-ab3c-4d5e6f7a8b9c",
+			"sub_ports": [
+				{
+					"segmentation_id": 100,
+					"segmentation_type": "vlan",
+					"port_id": "5b9e6b7c-8d0f-4a2b-bc4d-5e6f7a8b9c0d"
+				}
+			],
+			"project_id": "a99e9b4e620e4db09a2dfb6e42a01e66"
+		}
+	]
+}
+`
+
+// HandleListSuccessfully registers a handler for the List request of
+// path which checks the project filter and responds with body.
+func HandleListSuccessfully(t *testing.T, path, body string) {
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"project_id": ProjectID})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, body)
+	})
+}
+
+// HandlePortsListSuccessfully registers a handler for the port List requests
+// of Collect, which list the ports of the project or the gateway port of the
+// router.
+func HandlePortsListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		if r.URL.Query().Get("device_id") != "" {
+			th.TestFormValues(t, r, map[string]string{
+				"device_id":    RouterID,
+				"device_owner": "network:router_gateway",
+			})
+			fmt.Fprint(w, RouterGatewayPortsListBody)
+			return
+		}
+
+		th.TestFormValues(t, r, map[string]string{"project_id": ProjectID})
+		fmt.Fprint(w, PortsListBody)
+	})
+}
+
+// HandleCollectSuccessfully registers the handlers of every request of a
+// Collect call of ProjectID. Trunks are only handled if withTrunks is true.
+func HandleCollectSuccessfully(t *testing.T, withTrunks bool) {
+	HandleListSuccessfully(t, "/v2.0/networks", NetworksListBody)
+	HandleListSuccessfully(t, "/v2.0/subnets", SubnetsListBody)
+	HandlePortsListSuccessfully(t)
+	HandleListSuccessfully(t, "/v2.0/routers", RoutersListBody)
+	HandleListSuccessfully(t, "/v2.0/floatingips", FloatingIPsListBody)
+	HandleListSuccessfully(t, "/v2.0/security-groups", SecurityGroupsListBody)
+	if withTrunks {
+		HandleListSuccessfully(t, "/v2.0/trunks", TrunksListBody)
+	}
+
+	th.Mux.HandleFunc("/v2.0/networks/"+PublicNetworkID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, PublicNetworkGetBody)
+	})
+}
@@ -0,0 +1,211 @@
+package testing
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	fake "github.com/gophercloud/gophercloud/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/topology"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func collect(t *testing.T) *topology.Snapshot {
+	HandleCollectSuccessfully(t, true)
+
+	s, err := topology.Collect(fake.ServiceClient(), topology.CollectOpts{ProjectID: ProjectID})
+	th.AssertNoErr(t, err)
+	return s
+}
+
+func TestCollect(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	s := collect(t)
+
+	th.AssertEquals(t, ProjectID, s.ProjectID)
+	th.AssertEquals(t, 3, len(s.Networks))
+	th.AssertEquals(t, 2, len(s.Subnets))
+	th.AssertEquals(t, 7, len(s.Ports))
+	th.AssertEquals(t, 1, len(s.Routers))
+	th.AssertEquals(t, 2, len(s.FloatingIPs))
+	th.AssertEquals(t, 1, len(s.SecurityGroups))
+	th.AssertEquals(t, 1, len(s.Trunks))
+	th.AssertEquals(t, 1, len(s.ExternalNetworks))
+	th.AssertEquals(t, PublicNetworkID, s.ExternalNetworks[0].ID)
+	th.AssertEquals(t, "public", s.ExternalNetworks[0].Name)
+
+	gatewayPort := s.Ports[len(s.Ports)-1]
+	th.AssertEquals(t, RouterGatewayPortID, gatewayPort.ID)
+	th.AssertEquals(t, "", gatewayPort.ProjectID)
+}
+
+func TestCollectSkipTrunks(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleCollectSuccessfully(t, false)
+
+	s, err := topology.Collect(fake.ServiceClient(), topology.CollectOpts{
+		ProjectID:  ProjectID,
+		SkipTrunks: true,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, len(s.Trunks))
+	th.AssertEquals(t, 7, len(s.Ports))
+}
+
+func TestCollectFailed(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleListSuccessfully(t, "/v2.0/networks", NetworksListBody)
+	HandleListSuccessfully(t, "/v2.0/subnets", SubnetsListBody)
+	HandleListSuccessfully(t, "/v2.0/routers", RoutersListBody)
+	HandleListSuccessfully(t, "/v2.0/floatingips", FloatingIPsListBody)
+	HandleListSuccessfully(t, "/v2.0/security-groups", SecurityGroupsListBody)
+	HandleListSuccessfully(t, "/v2.0/trunks", TrunksListBody)
+	th.Mux.HandleFunc("/v2.0/ports", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	_, err := topology.Collect(fake.ServiceClient(), topology.CollectOpts{ProjectID: ProjectID})
+
+	var collectErr topology.ErrCollectFailed
+	th.AssertEquals(t, true, errors.As(err, &collectErr))
+	th.AssertEquals(t, topology.NodePort, collectErr.Resource)
+
+	var forbidden gophercloud.ErrDefault403
+	th.AssertEquals(t, true, errors.As(err, &forbidden))
+}
+
+func TestNewGraph(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	g := topology.NewGraph(collect(t))
+
+	th.AssertEquals(t, 4+2+7+1+2+1+1, len(g.Nodes))
+
+	public := g.Node(PublicNetworkID)
+	th.AssertEquals(t, topology.NodeNetwork, public.Kind)
+	th.AssertEquals(t, "true", public.Attributes["external"])
+	th.AssertEquals(t, (*topology.Node)(nil), g.Node("unknown"))
+
+	th.AssertDeepEquals(t, []topology.Edge{
+		{From: RouterID, To: RouterInterfacePortID, Kind: topology.EdgeRouterInterface},
+		{From: RouterID, To: RouterGatewayPortID, Kind: topology.EdgeRouterGatewayPort},
+		{From: RouterID, To: PublicNetworkID, Kind: topology.EdgeRouterGateway, Label: "172.24.4.10"},
+	}, g.EdgesFrom(RouterID))
+
+	th.AssertDeepEquals(t, []topology.Edge{
+		{From: ServerPortID, To: PrivateNetworkID, Kind: topology.EdgePortNetwork},
+		{From: ServerPortID, To: PrivateSubnetID, Kind: topology.EdgeFixedIP, Label: "10.0.0.5"},
+		{From: ServerPortID, To: SecurityGroupID, Kind: topology.EdgeSecurityGroup},
+	}, g.EdgesFrom(ServerPortID))
+
+	th.AssertDeepEquals(t, []topology.Edge{
+		{From: AssociatedFloatingIPID, To: PublicNetworkID, Kind: topology.EdgeFloatingIPNetwork},
+		{From: AssociatedFloatingIPID, To: ServerPortID, Kind: topology.EdgeFloatingIPPort, Label: "10.0.0.5"},
+	}, g.EdgesFrom(AssociatedFloatingIPID))
+
+	th.AssertDeepEquals(t, []topology.Edge{
+		{From: TrunkID, To: TrunkParentPortID, Kind: topology.EdgeTrunkParent},
+		{From: TrunkID, To: TrunkSubportID, Kind: topology.EdgeTrunkSubport, Label: "vlan 100"},
+	}, g.EdgesFrom(TrunkID))
+
+	th.AssertDeepEquals(t, []topology.Edge{
+		{From: PrivateSubnetID, To: PrivateNetworkID, Kind: topology.EdgeSubnetNetwork},
+		{From: RouterInterfacePortID, To: PrivateNetworkID, Kind: topology.EdgePortNetwork},
+		{From: ServerPortID, To: PrivateNetworkID, Kind: topology.EdgePortNetwork},
+		{From: OrphanedPortID, To: PrivateNetworkID, Kind: topology.EdgePortNetwork},
+		{From: VIPPortID, To: PrivateNetworkID, Kind: topology.EdgePortNetwork},
+	}, g.EdgesTo(PrivateNetworkID))
+}
+
+func TestCheck(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	problems := topology.Check(collect(t))
+
+	th.AssertDeepEquals(t, []topology.Problem{
+		{
+			Kind:       topology.ProblemOrphanedPort,
+			ResourceID: OrphanedPortID,
+			Message:    `port "leftover" is not bound to any device`,
+		},
+		{
+			Kind:       topology.ProblemSubnetWithoutRouter,
+			ResourceID: IsolatedSubnetID,
+			Message:    `subnet "isolated-subnet" (10.1.0.0/24) has gateway 10.1.0.1 but no router interface`,
+		},
+		{
+			Kind:       topology.ProblemNetworkWithoutSubnet,
+			ResourceID: EmptyNetworkID,
+			Message:    `network "empty" has no subnet`,
+		},
+		{
+			Kind:       topology.ProblemUnassociatedFloatingIP,
+			ResourceID: UnassociatedFloatingIPID,
+			Message:    "floating IP 172.24.4.21 is not associated with any port",
+		},
+	}, problems)
+}
+
+var smallSnapshot = &topology.Snapshot{
+	Networks: []networks.Network{
+		{ID: "net-1", Name: "private", Status: "ACTIVE"},
+	},
+	Subnets: []subnets.Subnet{
+		{ID: "subnet-1", NetworkID: "net-1", CIDR: "10.0.0.0/24"},
+	},
+}
+
+func TestWriteDOT(t *testing.T) {
+	var b bytes.Buffer
+	err := topology.NewGraph(smallSnapshot).WriteDOT(&b)
+	th.AssertNoErr(t, err)
+
+	expected := `digraph topology {
+	"net-1" [label="network\nprivate", shape=box];
+	"subnet-1" [label="subnet\nsubnet-1", shape=note];
+	"subnet-1" -> "net-1" [label="subnet-network"];
+}
+`
+	th.AssertEquals(t, expected, b.String())
+}
+
+func TestWriteJSON(t *testing.T) {
+	var b bytes.Buffer
+	err := topology.NewGraph(smallSnapshot).WriteJSON(&b)
+	th.AssertNoErr(t, err)
+
+	expected := `
+{
+	"nodes": [
+		{
+			"id": "net-1",
+			"kind": "network",
+			"name": "private",
+			"attributes": {"shared": "false", "status": "ACTIVE"}
+		},
+		{
+			"id": "subnet-1",
+			"kind": "subnet",
+			"attributes": {"cidr": "10.0.0.0/24"}
+		}
+	],
+	"edges": [
+		{"from": "subnet-1", "to": "net-1", "kind": "subnet-network"}
+	]
+}
+`
+	th.AssertJSONEquals(t, expected, json.RawMessage(b.Bytes()))
+}
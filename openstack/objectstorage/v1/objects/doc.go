@@ -106,5 +106,37 @@ Example to Download an Object's Data
 	if err != nil {
 		panic(err)
 	}
+
+Example to Create a Temporary URL for all Objects with a Prefix
+
+	tempURL, err := objects.CreateTempURL(objectStorageClient, "my_container", "logs/2023/app.log", objects.CreateTempURLOpts{
+		Method: objects.GET,
+		TTL:    3600,
+		Prefix: "logs/",
+	})
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Form POST Upload Policy
+
+	createOpts := objects.CreateFormPostOpts{
+		TTL:          3600,
+		Prefix:       "uploads/",
+		Redirect:     "https://example.com/done",
+		MaxFileSize:  104857600,
+		MaxFileCount: 10,
+		Digest:       "sha256",
+	}
+
+	formPost, err := objects.CreateFormPost(objectStorageClient, "my_container", createOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("<form action=%q method=\"POST\" enctype=\"multipart/form-data\">\n", formPost.URL)
+	for name, value := range formPost.Fields {
+		fmt.Printf("<input type=\"hidden\" name=%q value=%q />\n", name, value)
+	}
 */
 package objects
@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"hash"
 	"io"
+	neturl "net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -564,6 +566,11 @@ type CreateTempURLOpts struct {
 	// calculate the signature. Valid values include sha1, sha256, and
 	// sha512. If not specified, the default hash function is sha1.
 	Digest string
+
+	// (Optional) Prefix creates a prefix-based temp URL. The signature then
+	// covers every object in the container whose name starts with Prefix, and
+	// the object name passed to CreateTempURL must start with Prefix.
+	Prefix string
 }
 
 // CreateTempURL is a function for creating a temporary URL for an object. It
@@ -579,6 +586,14 @@ func CreateTempURL(c *gophercloud.ServiceClient, containerName, objectName strin
 		opts.Split = "/v1/"
 	}
 
+	if opts.Prefix != "" && !strings.HasPrefix(objectName, opts.Prefix) {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "objects.CreateTempURLOpts.Prefix"
+		err.Value = opts.Prefix
+		err.Info = fmt.Sprintf("object name %q does not start with the temp URL prefix %q", objectName, opts.Prefix)
+		return "", err
+	}
+
 	// Initialize time if it was not passed as opts
 	date := opts.Timestamp
 	if date.IsZero() {
@@ -589,34 +604,210 @@ func CreateTempURL(c *gophercloud.ServiceClient, containerName, objectName strin
 	expiry := date.Add(duration).Unix()
 
 	// Initialize the tempURLKey to calculate a signature
-	tempURLKey := opts.TempURLKey
+	tempURLKey, err := getTempURLKey(c, containerName, opts.TempURLKey)
+	if err != nil {
+		return "", err
+	}
+
+	splitPath := strings.Split(url, opts.Split)
+	baseURL, objectPath := splitPath[0], splitPath[1]
+	objectPath = opts.Split + objectPath
+
+	signedPath := objectPath
+	if opts.Prefix != "" {
+		signedPath = "prefix:" + strings.TrimSuffix(objectPath, objectName) + opts.Prefix
+	}
+
+	body := fmt.Sprintf("%s\n%d\n%s", opts.Method, expiry, signedPath)
+	hexsum, err := signTempURL(opts.Digest, tempURLKey, body)
+	if err != nil {
+		return "", err
+	}
+
+	tempURL := fmt.Sprintf("%s%s?temp_url_sig=%s&temp_url_expires=%d", baseURL, objectPath, hexsum, expiry)
+	if opts.Prefix != "" {
+		tempURL += "&temp_url_prefix=" + neturl.QueryEscape(opts.Prefix)
+	}
+	return tempURL, nil
+}
+
+// CreateFormPostOpts are options for creating the signature and the form
+// fields of an HTML form, which allows browsers to upload objects directly
+// into a container using the Swift formpost middleware.
+type CreateFormPostOpts struct {
+	// (REQUIRED) TTL is the number of seconds the form should be accepted.
+	TTL int
+
+	// (REQUIRED) MaxFileSize is the maximum size in bytes of a single uploaded
+	// file.
+	MaxFileSize int64
+
+	// (REQUIRED) MaxFileCount is the maximum number of files, which can be
+	// uploaded with a single form submission.
+	MaxFileCount int
+
+	// (Optional) Prefix is the object name prefix, which is prepended to the
+	// name of every uploaded file.
+	Prefix string
+
+	// (Optional) Redirect is the URL, to which the browser is redirected after
+	// the upload.
+	Redirect string
+
+	// (Optional) Split is the string on which to split the container URL. If
+	// empty, the default OpenStack URL split point will be used ("/v1/").
+	Split string
+
+	// (Optional) Timestamp is the current timestamp used to calculate the
+	// signature. If not specified, the current UNIX timestamp is used as the
+	// base timestamp.
+	Timestamp time.Time
+
+	// (Optional) TempURLKey overrides the Swift container or account Temp URL key.
+	// If not specified, the key is obtained from a Swift container or account.
+	TempURLKey string
+
+	// (Optional) Digest specifies the cryptographic hash function used to
+	// calculate the signature. Valid values include sha1, sha256, and
+	// sha512. If not specified, the default hash function is sha1.
+	Digest string
+
+	// (Optional) DeleteAt is the time, when the uploaded objects will be
+	// deleted. The value is not covered by the signature.
+	DeleteAt time.Time
+
+	// (Optional) DeleteAfter is the number of seconds, after which the
+	// uploaded objects will be deleted. The value is not covered by the
+	// signature.
+	DeleteAfter int
+}
+
+// FormPost represents the upload policy of a Swift formpost form. URL must be
+// used as the form "action" and Fields as its hidden input fields.
+type FormPost struct {
+	// URL is the URL the form has to be submitted to using the POST method.
+	URL string
+
+	// Expires is the UNIX timestamp, after which the form is rejected.
+	Expires int64
+
+	// Signature is the hex encoded HMAC signature of the form.
+	Signature string
+
+	// Fields contains the hidden form fields, including the signature.
+	Fields map[string]string
+}
+
+// CreateFormPost is a function for creating the signature and the hidden
+// form fields, which allow browsers to upload objects into a container using
+// an HTML form for a limited amount of time.
+func CreateFormPost(c *gophercloud.ServiceClient, containerName string, opts CreateFormPostOpts) (*FormPost, error) {
+	url, err := getURL(c, containerName, opts.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.MaxFileSize <= 0 {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "objects.CreateFormPostOpts.MaxFileSize"
+		return nil, err
+	}
+	if opts.MaxFileCount <= 0 {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "objects.CreateFormPostOpts.MaxFileCount"
+		return nil, err
+	}
+
+	if opts.Split == "" {
+		opts.Split = "/v1/"
+	}
+
+	date := opts.Timestamp
+	if date.IsZero() {
+		date = time.Now()
+	}
+	expiry := date.Add(time.Duration(opts.TTL) * time.Second).Unix()
+
+	tempURLKey, err := getTempURLKey(c, containerName, opts.TempURLKey)
+	if err != nil {
+		return nil, err
+	}
+
+	splitPath := strings.SplitN(url, opts.Split, 2)
+	if len(splitPath) != 2 {
+		return nil, fmt.Errorf("unable to find %q in the %q URL", opts.Split, url)
+	}
+	path := opts.Split + splitPath[1]
+
+	body := fmt.Sprintf("%s\n%s\n%d\n%d\n%d", path, opts.Redirect, opts.MaxFileSize, opts.MaxFileCount, expiry)
+	hexsum, err := signTempURL(opts.Digest, tempURLKey, body)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]string{
+		"redirect":       opts.Redirect,
+		"max_file_size":  strconv.FormatInt(opts.MaxFileSize, 10),
+		"max_file_count": strconv.Itoa(opts.MaxFileCount),
+		"expires":        strconv.FormatInt(expiry, 10),
+		"signature":      hexsum,
+	}
+	if !opts.DeleteAt.IsZero() {
+		fields["x_delete_at"] = strconv.FormatInt(opts.DeleteAt.Unix(), 10)
+	}
+	if opts.DeleteAfter > 0 {
+		fields["x_delete_after"] = strconv.Itoa(opts.DeleteAfter)
+	}
+
+	return &FormPost{
+		URL:       url,
+		Expires:   expiry,
+		Signature: hexsum,
+		Fields:    fields,
+	}, nil
+}
+
+// getTempURLKey returns the key, which is used to sign temp URLs and forms.
+// When the key is not set explicitly, it falls back to the container keys
+// and then to the account keys.
+func getTempURLKey(c *gophercloud.ServiceClient, containerName, tempURLKey string) (string, error) {
+	if tempURLKey != "" {
+		return tempURLKey, nil
+	}
+
+	// fallback to a container TempURL key
+	containerHeader, err := containers.Get(c, containerName, nil).Extract()
+	if err != nil {
+		return "", err
+	}
+	tempURLKey = containerHeader.TempURLKey
+	if tempURLKey == "" {
+		tempURLKey = containerHeader.TempURLKey2
+	}
 	if tempURLKey == "" {
-		// fallback to a container TempURL key
-		getHeader, err := containers.Get(c, containerName, nil).Extract()
+		// fallback to an account TempURL key
+		accountHeader, err := accounts.Get(c, nil).Extract()
 		if err != nil {
 			return "", err
 		}
-		tempURLKey = getHeader.TempURLKey
+		tempURLKey = accountHeader.TempURLKey
 		if tempURLKey == "" {
-			// fallback to an account TempURL key
-			getHeader, err := accounts.Get(c, nil).Extract()
-			if err != nil {
-				return "", err
-			}
-			tempURLKey = getHeader.TempURLKey
-		}
-		if tempURLKey == "" {
-			return "", ErrTempURLKeyNotFound{}
+			tempURLKey = accountHeader.TempURLKey2
 		}
 	}
+	if tempURLKey == "" {
+		return "", ErrTempURLKeyNotFound{}
+	}
 
-	secretKey := []byte(tempURLKey)
-	splitPath := strings.Split(url, opts.Split)
-	baseURL, objectPath := splitPath[0], splitPath[1]
-	objectPath = opts.Split + objectPath
-	body := fmt.Sprintf("%s\n%d\n%s", opts.Method, expiry, objectPath)
+	return tempURLKey, nil
+}
+
+// signTempURL returns the hex encoded HMAC signature of the body using the
+// requested digest.
+func signTempURL(digest, key, body string) (string, error) {
+	secretKey := []byte(key)
 	var hash hash.Hash
-	switch opts.Digest {
+	switch digest {
 	case "", "sha1":
 		hash = hmac.New(sha1.New, secretKey)
 	case "sha256":
@@ -624,11 +815,10 @@ func CreateTempURL(c *gophercloud.ServiceClient, containerName, objectName strin
 	case "sha512":
 		hash = hmac.New(sha512.New, secretKey)
 	default:
-		return "", ErrTempURLDigestNotValid{Digest: opts.Digest}
+		return "", ErrTempURLDigestNotValid{Digest: digest}
 	}
 	hash.Write([]byte(body))
-	hexsum := fmt.Sprintf("%x", hash.Sum(nil))
-	return fmt.Sprintf("%s%s?temp_url_sig=%s&temp_url_expires=%d", baseURL, objectPath, hexsum, expiry), nil
+	return fmt.Sprintf("%x", hash.Sum(nil)), nil
 }
 
 // BulkDelete is a function that bulk deletes objects.
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gophercloud/gophercloud"
 	accountTesting "github.com/gophercloud/gophercloud/openstack/objectstorage/v1/accounts/testing"
 	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
 	containerTesting "github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers/testing"
@@ -525,3 +526,86 @@ func TestCreateTempURL(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.AssertEquals(t, expectedURL, tempURL)
 }
+
+func TestCreatePrefixTempURL(t *testing.T) {
+	port := 33200
+	th.SetupHTTP()
+	th.SetupPersistentPortHTTP(t, port)
+	defer th.TeardownHTTP()
+
+	containerTesting.HandleGetContainerSuccessfully(t)
+	accountTesting.HandleGetAccountSuccessfully(t)
+	client := fake.ServiceClient()
+	client.Endpoint = client.Endpoint + "v1/"
+
+	tempURL, err := objects.CreateTempURL(client, "testContainer", "testObject/testFile.txt", objects.CreateTempURLOpts{
+		Method:    http.MethodGet,
+		TTL:       60,
+		Timestamp: time.Date(2020, 07, 01, 01, 12, 00, 00, time.UTC),
+		Prefix:    "testObject/",
+	})
+
+	sig := "2c1ca02c1fc7ddd61117dbc4069772825f23297d"
+	expiry := "1593565980"
+	expectedURL := fmt.Sprintf("http://127.0.0.1:%v/v1/testContainer/testObject/testFile.txt?temp_url_sig=%v&temp_url_expires=%v&temp_url_prefix=testObject%%2F", port, sig, expiry)
+
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, expectedURL, tempURL)
+
+	_, err = objects.CreateTempURL(client, "testContainer", "testFile.txt", objects.CreateTempURLOpts{
+		Method:     http.MethodGet,
+		TTL:        60,
+		TempURLKey: "testsecret",
+		Prefix:     "testObject/",
+	})
+	th.CheckErr(t, err, &gophercloud.ErrInvalidInput{})
+}
+
+func TestCreateFormPost(t *testing.T) {
+	port := 33200
+	th.SetupHTTP()
+	th.SetupPersistentPortHTTP(t, port)
+	defer th.TeardownHTTP()
+
+	containerTesting.HandleGetContainerSuccessfully(t)
+	accountTesting.HandleGetAccountSuccessfully(t)
+	client := fake.ServiceClient()
+	client.Endpoint = client.Endpoint + "v1/"
+
+	formPost, err := objects.CreateFormPost(client, "testContainer", objects.CreateFormPostOpts{
+		TTL:          60,
+		Timestamp:    time.Date(2020, 07, 01, 01, 12, 00, 00, time.UTC),
+		Prefix:       "uploads/",
+		Redirect:     "https://example.com/done",
+		MaxFileSize:  1048576,
+		MaxFileCount: 5,
+		Digest:       "sha256",
+		DeleteAfter:  3600,
+	})
+	th.AssertNoErr(t, err)
+
+	sig := "a1511d405e444e001468ce07c82ac5708ff2015501988b63c8fe99b7f324b1ec"
+	expected := &objects.FormPost{
+		URL:       fmt.Sprintf("http://127.0.0.1:%v/v1/testContainer/uploads/", port),
+		Expires:   1593565980,
+		Signature: sig,
+		Fields: map[string]string{
+			"redirect":       "https://example.com/done",
+			"max_file_size":  "1048576",
+			"max_file_count": "5",
+			"expires":        "1593565980",
+			"signature":      sig,
+			"x_delete_after": "3600",
+		},
+	}
+	th.AssertDeepEquals(t, expected, formPost)
+
+	_, err = objects.CreateFormPost(client, "testContainer", objects.CreateFormPostOpts{
+		TTL:          60,
+		MaxFileSize:  1048576,
+		MaxFileCount: 5,
+		TempURLKey:   "testsecret",
+		Digest:       "md5",
+	})
+	th.CheckErr(t, err, &objects.ErrTempURLDigestNotValid{})
+}
@@ -0,0 +1,66 @@
+/*
+Package softwareconfigs provides operations for managing software
+configurations of the OpenStack Orchestration service. A software
+configuration contains the configuration data and the schema of its inputs
+and outputs, and is applied to servers through software deployments.
+
+Software configurations are immutable; to change one, create a new software
+configuration and update the deployments which use it.
+
+Example to List Software Configurations
+
+	allPages, err := softwareconfigs.List(client, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allConfigs, err := softwareconfigs.ExtractSoftwareConfigs(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, config := range allConfigs {
+		fmt.Printf("%+v\n", config)
+	}
+
+Example to Create a Software Configuration
+
+	createOpts := softwareconfigs.CreateOpts{
+		Name:   "deploy-app",
+		Group:  "script",
+		Config: "#!/bin/sh\necho ${version} > /tmp/version\n",
+		Inputs: []softwareconfigs.Input{
+			{
+				Name:    "version",
+				Type:    softwareconfigs.TypeString,
+				Default: "1.0",
+			},
+		},
+		Outputs: []softwareconfigs.Output{
+			{
+				Name:        "result",
+				ErrorOutput: true,
+			},
+		},
+	}
+
+	config, err := softwareconfigs.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Get a Software Configuration
+
+	config, err := softwareconfigs.Get(client, "e4c3e4b4-1c1b-4e5b-8b6a-8c0ab7d7c8b1").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Software Configuration
+
+	err := softwareconfigs.Delete(client, "e4c3e4b4-1c1b-4e5b-8b6a-8c0ab7d7c8b1").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package softwareconfigs
@@ -0,0 +1,107 @@
+package softwareconfigs
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToSoftwareConfigListQuery() (string, error)
+}
+
+// ListOpts allows the paging of software configurations through the API.
+type ListOpts struct {
+	// Limit is the maximum number of software configurations to return.
+	Limit int `q:"limit"`
+
+	// Marker is the ID of the last software configuration of the previous
+	// page.
+	Marker string `q:"marker"`
+}
+
+// ToSoftwareConfigListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToSoftwareConfigListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over the software
+// configurations.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(client)
+	if opts != nil {
+		query, err := opts.ToSoftwareConfigListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return SoftwareConfigPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Get retrieves a software configuration.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := client.Get(resourceURL(client, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToSoftwareConfigCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts represents the attributes used when creating a new software
+// configuration.
+type CreateOpts struct {
+	// Name is the name of the software configuration.
+	Name string `json:"name" required:"true"`
+
+	// Group is the namespace that groups this software configuration by
+	// which tool on the server is intended to apply it, for example
+	// "script", "puppet", "ansible" or "Heat::Ungrouped".
+	Group string `json:"group,omitempty"`
+
+	// Config is the configuration script or data.
+	Config string `json:"config,omitempty"`
+
+	// Inputs is the schema of the inputs of the configuration.
+	Inputs []Input `json:"inputs,omitempty"`
+
+	// Outputs is the schema of the outputs of the configuration.
+	Outputs []Output `json:"outputs,omitempty"`
+
+	// Options is a map of options which are specific to the Group.
+	Options map[string]interface{} `json:"options,omitempty"`
+}
+
+// ToSoftwareConfigCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToSoftwareConfigCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create creates a new software configuration.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToSoftwareConfigCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(rootURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete deletes a software configuration.
+func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := client.Delete(resourceURL(client, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,187 @@
+package softwareconfigs
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Type is the type of an input or output of a software configuration.
+type Type string
+
+const (
+	TypeString             Type = "String"
+	TypeNumber             Type = "Number"
+	TypeCommaDelimitedList Type = "CommaDelimitedList"
+	TypeJSON               Type = "Json"
+	TypeBoolean            Type = "Boolean"
+)
+
+// Input is the schema of an input of a software configuration.
+type Input struct {
+	// Name is the name of the input.
+	Name string `json:"name"`
+
+	// Type is the type of the input. The Orchestration service defaults it to
+	// TypeString.
+	Type Type `json:"type,omitempty"`
+
+	// Description is the description of the input.
+	Description string `json:"description,omitempty"`
+
+	// Default is the value used if the deployment does not set the input.
+	Default interface{} `json:"default,omitempty"`
+
+	// ReplaceOnChange causes the deployment to be replaced, rather than
+	// updated, when the value of the input changes.
+	ReplaceOnChange bool `json:"replace_on_change,omitempty"`
+
+	// Value is the value of the input. It is only set in the metadata of a
+	// server, as returned by softwaredeployments.GetMetadata.
+	Value interface{} `json:"value,omitempty"`
+}
+
+// Output is the schema of an output of a software configuration.
+type Output struct {
+	// Name is the name of the output.
+	Name string `json:"name"`
+
+	// Type is the type of the output. The Orchestration service defaults it
+	// to TypeString.
+	Type Type `json:"type,omitempty"`
+
+	// Description is the description of the output.
+	Description string `json:"description,omitempty"`
+
+	// ErrorOutput denotes that the deployment is in an error state if this
+	// output has a value.
+	ErrorOutput bool `json:"error_output,omitempty"`
+}
+
+// SoftwareConfig represents a software configuration.
+type SoftwareConfig struct {
+	// ID is the unique ID of the software configuration.
+	ID string `json:"id"`
+
+	// Name is the name of the software configuration.
+	Name string `json:"name"`
+
+	// Group is the namespace that groups this software configuration by
+	// which tool on the server is intended to apply it.
+	Group string `json:"group"`
+
+	// Config is the configuration script or data. It is not returned by
+	// List.
+	Config string `json:"config"`
+
+	// Inputs is the schema of the inputs of the configuration.
+	Inputs []Input `json:"inputs"`
+
+	// Outputs is the schema of the outputs of the configuration.
+	Outputs []Output `json:"outputs"`
+
+	// Options is a map of options which are specific to the Group.
+	Options map[string]interface{} `json:"options"`
+
+	// CreationTime is the time the software configuration was created.
+	CreationTime time.Time `json:"-"`
+
+	// UpdatedTime is the time the deployment of the software configuration
+	// was last updated. It is only set in the metadata of a server, as
+	// returned by softwaredeployments.GetMetadata.
+	UpdatedTime time.Time `json:"-"`
+}
+
+func (r *SoftwareConfig) UnmarshalJSON(b []byte) error {
+	type tmp SoftwareConfig
+	var s struct {
+		tmp
+		CreationTime string `json:"creation_time"`
+		UpdatedTime  string `json:"updated_time"`
+	}
+
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+
+	*r = SoftwareConfig(s.tmp)
+
+	if r.CreationTime, err = parseTime(s.CreationTime); err != nil {
+		return err
+	}
+	r.UpdatedTime, err = parseTime(s.UpdatedTime)
+	return err
+}
+
+// parseTime parses the times returned by the Orchestration service, which
+// may or may not have a time zone.
+func parseTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Parse(gophercloud.RFC3339NoZ, s)
+	}
+	return t, nil
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any commonResult as a SoftwareConfig.
+func (r commonResult) Extract() (*SoftwareConfig, error) {
+	var s struct {
+		SoftwareConfig *SoftwareConfig `json:"software_config"`
+	}
+	err := r.ExtractInto(&s)
+	return s.SoftwareConfig, err
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a SoftwareConfig.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult is the response from a Get operation. Call its Extract method to
+// interpret it as a SoftwareConfig.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// SoftwareConfigPage contains a single page of all software configurations
+// from a List call.
+type SoftwareConfigPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines if a SoftwareConfigPage contains any results.
+func (r SoftwareConfigPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	configs, err := ExtractSoftwareConfigs(r)
+	return len(configs) == 0, err
+}
+
+// ExtractSoftwareConfigs extracts and returns a slice of SoftwareConfig. It
+// is used while iterating over a softwareconfigs.List call.
+func ExtractSoftwareConfigs(r pagination.Page) ([]SoftwareConfig, error) {
+	var s struct {
+		SoftwareConfigs []SoftwareConfig `json:"software_configs"`
+	}
+	err := (r.(SoftwareConfigPage)).ExtractInto(&s)
+	return s.SoftwareConfigs, err
+}
@@ -0,0 +1,3 @@
+// orchestration_softwareconfigs_v1
+
+package testing
@@ -0,0 +1,188 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/softwareconfigs"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput represents the response body from a List request.
+const ListOutput = `
+{
+  "software_configs": [
+    {
+      "id": "e4c3e4b4-1c1b-4e5b-8b6a-8c0ab7d7c8b1",
+      "name": "deploy-app",
+      "group": "script",
+      "creation_time": "2023-06-26T07:58:17Z"
+    },
+    {
+      "id": "0f1a3e6e-7c3b-4d8f-9a44-1a2c1b6e8f3d",
+      "name": "configure-db",
+      "group": "ansible",
+      "creation_time": "2023-06-27T08:12:01Z"
+    }
+  ]
+}
+`
+
+// ListExpected represents the expected objects from a List request.
+var ListExpected = []softwareconfigs.SoftwareConfig{
+	{
+		ID:           "e4c3e4b4-1c1b-4e5b-8b6a-8c0ab7d7c8b1",
+		Name:         "deploy-app",
+		Group:        "script",
+		CreationTime: time.Date(2023, 6, 26, 7, 58, 17, 0, time.UTC),
+	},
+	{
+		ID:           "0f1a3e6e-7c3b-4d8f-9a44-1a2c1b6e8f3d",
+		Name:         "configure-db",
+		Group:        "ansible",
+		CreationTime: time.Date(2023, 6, 27, 8, 12, 1, 0, time.UTC),
+	},
+}
+
+// CreateRequest represents the request body of a Create request.
+const CreateRequest = `
+{
+  "name": "deploy-app",
+  "group": "script",
+  "config": "#!/bin/sh\necho ${version} > /tmp/version\n",
+  "inputs": [
+    {
+      "name": "version",
+      "type": "String",
+      "default": "1.0",
+      "replace_on_change": true
+    }
+  ],
+  "outputs": [
+    {
+      "name": "result",
+      "error_output": true
+    }
+  ],
+  "options": {
+    "timeout": 600
+  }
+}
+`
+
+// GetOutput represents the response body from a Get or Create request.
+const GetOutput = `
+{
+  "software_config": {
+    "id": "e4c3e4b4-1c1b-4e5b-8b6a-8c0ab7d7c8b1",
+    "name": "deploy-app",
+    "group": "script",
+    "config": "#!/bin/sh\necho ${version} > /tmp/version\n",
+    "inputs": [
+      {
+        "name": "version",
+        "type": "String",
+        "description": null,
+        "default": "1.0",
+        "replace_on_change": true
+      }
+    ],
+    "outputs": [
+      {
+        "name": "result",
+        "type": "String",
+        "description": null,
+        "error_output": true
+      }
+    ],
+    "options": {
+      "timeout": 600
+    },
+    "creation_time": "2023-06-26T07:58:17Z"
+  }
+}
+`
+
+// GetExpected represents the expected object from a Get or Create request.
+var GetExpected = &softwareconfigs.SoftwareConfig{
+	ID:     "e4c3e4b4-1c1b-4e5b-8b6a-8c0ab7d7c8b1",
+	Name:   "deploy-app",
+	Group:  "script",
+	Config: "#!/bin/sh\necho ${version} > /tmp/version\n",
+	Inputs: []softwareconfigs.Input{
+		{
+			Name:            "version",
+			Type:            softwareconfigs.TypeString,
+			Default:         "1.0",
+			ReplaceOnChange: true,
+		},
+	},
+	Outputs: []softwareconfigs.Output{
+		{
+			Name:        "result",
+			Type:        softwareconfigs.TypeString,
+			ErrorOutput: true,
+		},
+	},
+	Options: map[string]interface{}{
+		"timeout": float64(600),
+	},
+	CreationTime: time.Date(2023, 6, 26, 7, 58, 17, 0, time.UTC),
+}
+
+// HandleListSuccessfully creates an HTTP handler at `/software_configs` on the
+// test handler mux that responds with a `List` response.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/software_configs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"limit": "2"})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListOutput)
+	})
+}
+
+// HandleCreateSuccessfully creates an HTTP handler at `/software_configs` on
+// the test handler mux that responds with a `Create` response.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/software_configs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+// HandleGetSuccessfully creates an HTTP handler at
+// `/software_configs/e4c3e4b4-1c1b-4e5b-8b6a-8c0ab7d7c8b1` on the test
+// handler mux that responds with a `Get` response.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/software_configs/e4c3e4b4-1c1b-4e5b-8b6a-8c0ab7d7c8b1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+// HandleDeleteSuccessfully creates an HTTP handler at
+// `/software_configs/e4c3e4b4-1c1b-4e5b-8b6a-8c0ab7d7c8b1` on the test
+// handler mux that responds with a `Delete` response.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/software_configs/e4c3e4b4-1c1b-4e5b-8b6a-8c0ab7d7c8b1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,81 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/softwareconfigs"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	allPages, err := softwareconfigs.List(fake.ServiceClient(), softwareconfigs.ListOpts{Limit: 2}).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := softwareconfigs.ExtractSoftwareConfigs(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, ListExpected, actual)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := softwareconfigs.CreateOpts{
+		Name:   "deploy-app",
+		Group:  "script",
+		Config: "#!/bin/sh\necho ${version} > /tmp/version\n",
+		Inputs: []softwareconfigs.Input{
+			{
+				Name:            "version",
+				Type:            softwareconfigs.TypeString,
+				Default:         "1.0",
+				ReplaceOnChange: true,
+			},
+		},
+		Outputs: []softwareconfigs.Output{
+			{
+				Name:        "result",
+				ErrorOutput: true,
+			},
+		},
+		Options: map[string]interface{}{
+			"timeout": 600,
+		},
+	}
+
+	actual, err := softwareconfigs.Create(fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, GetExpected, actual)
+}
+
+func TestCreateMissingName(t *testing.T) {
+	res := softwareconfigs.Create(fake.ServiceClient(), softwareconfigs.CreateOpts{Group: "script"})
+	if res.Err == nil {
+		t.Fatal("Expected error when creating a software configuration without a name")
+	}
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := softwareconfigs.Get(fake.ServiceClient(), "e4c3e4b4-1c1b-4e5b-8b6a-8c0ab7d7c8b1").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, GetExpected, actual)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	err := softwareconfigs.Delete(fake.ServiceClient(), "e4c3e4b4-1c1b-4e5b-8b6a-8c0ab7d7c8b1").ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,13 @@
+package softwareconfigs
+
+import "github.com/gophercloud/gophercloud"
+
+const rootPath = "software_configs"
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, id)
+}
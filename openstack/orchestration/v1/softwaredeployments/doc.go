@@ -0,0 +1,76 @@
+/*
+Package softwaredeployments provides operations for managing software
+deployments of the OpenStack Orchestration service. A software deployment
+applies a software configuration to a server, with a set of input values, and
+records the resulting status and output values.
+
+Example to List Software Deployments of a Server
+
+	listOpts := softwaredeployments.ListOpts{
+		ServerID: "9f6b5c2d-4ac5-4c4e-9f3c-5a1d2b6e7f80",
+	}
+
+	allPages, err := softwaredeployments.List(client, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allDeployments, err := softwaredeployments.ExtractSoftwareDeployments(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, deployment := range allDeployments {
+		fmt.Printf("%+v\n", deployment)
+	}
+
+Example to Create a Software Deployment
+
+	createOpts := softwaredeployments.CreateOpts{
+		ConfigID: "e4c3e4b4-1c1b-4e5b-8b6a-8c0ab7d7c8b1",
+		ServerID: "9f6b5c2d-4ac5-4c4e-9f3c-5a1d2b6e7f80",
+		Action:   softwaredeployments.ActionCreate,
+		InputValues: map[string]interface{}{
+			"version": "1.1",
+		},
+	}
+
+	deployment, err := softwaredeployments.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Signal the Result of a Software Deployment
+
+	updateOpts := softwaredeployments.UpdateOpts{
+		Status:       softwaredeployments.StatusComplete,
+		StatusReason: "Outputs received",
+		OutputValues: map[string]interface{}{
+			"deploy_stdout": "done",
+		},
+	}
+
+	deployment, err := softwaredeployments.Update(client, deploymentID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Software Deployment
+
+	err := softwaredeployments.Delete(client, deploymentID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Get the Deployment Metadata of a Server
+
+	configs, err := softwaredeployments.GetMetadata(client, serverID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, config := range configs {
+		fmt.Printf("%s: %+v\n", config.Name, config.Inputs)
+	}
+*/
+package softwaredeployments
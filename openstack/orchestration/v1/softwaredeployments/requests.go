@@ -0,0 +1,183 @@
+package softwaredeployments
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Action is the stack action which triggered a software deployment.
+type Action string
+
+const (
+	ActionCreate  Action = "CREATE"
+	ActionUpdate  Action = "UPDATE"
+	ActionDelete  Action = "DELETE"
+	ActionSuspend Action = "SUSPEND"
+	ActionResume  Action = "RESUME"
+)
+
+// Status is the status of a software deployment.
+type Status string
+
+const (
+	StatusInProgress Status = "IN_PROGRESS"
+	StatusComplete   Status = "COMPLETE"
+	StatusFailed     Status = "FAILED"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToSoftwareDeploymentListQuery() (string, error)
+}
+
+// ListOpts allows the filtering of software deployments through the API.
+type ListOpts struct {
+	// ServerID filters the list by the server the deployments apply to.
+	ServerID string `q:"server_id"`
+}
+
+// ToSoftwareDeploymentListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToSoftwareDeploymentListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over the software
+// deployments.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(client)
+	if opts != nil {
+		query, err := opts.ToSoftwareDeploymentListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return SoftwareDeploymentPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Get retrieves a software deployment.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := client.Get(resourceURL(client, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToSoftwareDeploymentCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts represents the attributes used when creating a new software
+// deployment.
+type CreateOpts struct {
+	// ConfigID is the ID of the software configuration to deploy.
+	ConfigID string `json:"config_id" required:"true"`
+
+	// ServerID is the ID of the server to deploy the configuration to.
+	ServerID string `json:"server_id" required:"true"`
+
+	// Action is the stack action which triggers the deployment.
+	Action Action `json:"action,omitempty"`
+
+	// Status is the initial status of the deployment.
+	Status Status `json:"status,omitempty"`
+
+	// StatusReason is the reason for the initial status.
+	StatusReason string `json:"status_reason,omitempty"`
+
+	// InputValues are the values of the inputs of the software
+	// configuration.
+	InputValues map[string]interface{} `json:"input_values,omitempty"`
+
+	// StackUserProjectID is the ID of the project the stack user which
+	// signals the deployment belongs to.
+	StackUserProjectID string `json:"stack_user_project_id,omitempty"`
+}
+
+// ToSoftwareDeploymentCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToSoftwareDeploymentCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create creates a new software deployment.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToSoftwareDeploymentCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(rootURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToSoftwareDeploymentUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts represents the attributes used when updating a software
+// deployment. Updating the status and the output values is how the result
+// of a deployment is signalled.
+type UpdateOpts struct {
+	// ConfigID is the ID of a software configuration to deploy instead.
+	ConfigID string `json:"config_id,omitempty"`
+
+	// Action is the stack action which triggers the deployment.
+	Action Action `json:"action,omitempty"`
+
+	// Status is the new status of the deployment.
+	Status Status `json:"status,omitempty"`
+
+	// StatusReason is the reason for the new status.
+	StatusReason string `json:"status_reason,omitempty"`
+
+	// InputValues are the new values of the inputs of the software
+	// configuration.
+	InputValues map[string]interface{} `json:"input_values,omitempty"`
+
+	// OutputValues are the values of the outputs of the deployment.
+	OutputValues map[string]interface{} `json:"output_values,omitempty"`
+}
+
+// ToSoftwareDeploymentUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToSoftwareDeploymentUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update updates a software deployment.
+func Update(client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToSoftwareDeploymentUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(resourceURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete deletes a software deployment.
+func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := client.Delete(resourceURL(client, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetMetadata retrieves the deployment metadata of a server, that is the
+// software configurations deployed to it along with their input values.
+func GetMetadata(client *gophercloud.ServiceClient, serverID string) (r MetadataResult) {
+	resp, err := client.Get(metadataURL(client, serverID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
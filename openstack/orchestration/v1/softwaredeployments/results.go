@@ -0,0 +1,159 @@
+package softwaredeployments
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/softwareconfigs"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// SoftwareDeployment represents a software deployment.
+type SoftwareDeployment struct {
+	// ID is the unique ID of the software deployment.
+	ID string `json:"id"`
+
+	// ConfigID is the ID of the deployed software configuration.
+	ConfigID string `json:"config_id"`
+
+	// ServerID is the ID of the server the configuration is deployed to.
+	ServerID string `json:"server_id"`
+
+	// Action is the stack action which triggered the deployment.
+	Action Action `json:"action"`
+
+	// Status is the status of the deployment.
+	Status Status `json:"status"`
+
+	// StatusReason is the reason for the status of the deployment.
+	StatusReason string `json:"status_reason"`
+
+	// InputValues are the values of the inputs of the software
+	// configuration.
+	InputValues map[string]interface{} `json:"input_values"`
+
+	// OutputValues are the values of the outputs signalled by the server.
+	OutputValues map[string]interface{} `json:"output_values"`
+
+	// CreationTime is the time the deployment was created.
+	CreationTime time.Time `json:"-"`
+
+	// UpdatedTime is the time the deployment was last updated.
+	UpdatedTime time.Time `json:"-"`
+}
+
+func (r *SoftwareDeployment) UnmarshalJSON(b []byte) error {
+	type tmp SoftwareDeployment
+	var s struct {
+		tmp
+		CreationTime string `json:"creation_time"`
+		UpdatedTime  string `json:"updated_time"`
+	}
+
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+
+	*r = SoftwareDeployment(s.tmp)
+
+	if r.CreationTime, err = parseTime(s.CreationTime); err != nil {
+		return err
+	}
+	r.UpdatedTime, err = parseTime(s.UpdatedTime)
+	return err
+}
+
+// parseTime parses the times returned by the Orchestration service, which
+// may or may not have a time zone.
+func parseTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Parse(gophercloud.RFC3339NoZ, s)
+	}
+	return t, nil
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any commonResult as a SoftwareDeployment.
+func (r commonResult) Extract() (*SoftwareDeployment, error) {
+	var s struct {
+		SoftwareDeployment *SoftwareDeployment `json:"software_deployment"`
+	}
+	err := r.ExtractInto(&s)
+	return s.SoftwareDeployment, err
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a SoftwareDeployment.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult is the response from a Get operation. Call its Extract method to
+// interpret it as a SoftwareDeployment.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a SoftwareDeployment.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// MetadataResult is the response from a GetMetadata operation. Call its
+// Extract method to interpret it as a slice of software configurations.
+type MetadataResult struct {
+	gophercloud.Result
+}
+
+// Extract returns the software configurations deployed to a server. Their
+// inputs carry the values set by the deployments.
+func (r MetadataResult) Extract() ([]softwareconfigs.SoftwareConfig, error) {
+	var s struct {
+		Metadata []softwareconfigs.SoftwareConfig `json:"metadata"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Metadata, err
+}
+
+// SoftwareDeploymentPage contains a single page of all software deployments
+// from a List call.
+type SoftwareDeploymentPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines if a SoftwareDeploymentPage contains any results.
+func (r SoftwareDeploymentPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	deployments, err := ExtractSoftwareDeployments(r)
+	return len(deployments) == 0, err
+}
+
+// ExtractSoftwareDeployments extracts and returns a slice of
+// SoftwareDeployment. It is used while iterating over a
+// softwaredeployments.List call.
+func ExtractSoftwareDeployments(r pagination.Page) ([]SoftwareDeployment, error) {
+	var s struct {
+		SoftwareDeployments []SoftwareDeployment `json:"software_deployments"`
+	}
+	err := (r.(SoftwareDeploymentPage)).ExtractInto(&s)
+	return s.SoftwareDeployments, err
+}
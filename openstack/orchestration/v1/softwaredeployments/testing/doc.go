@@ -0,0 +1,3 @@
+// orchestration_softwaredeployments_v1
+
+package testing
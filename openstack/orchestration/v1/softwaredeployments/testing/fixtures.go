@@ -0,0 +1,230 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/softwareconfigs"
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/softwaredeployments"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput represents the response body from a List request.
+const ListOutput = `
+{
+  "software_deployments": [
+    {
+      "id": "a7d9d7c4-2f3b-4f64-9a4b-6b0d5c0a3e21",
+      "config_id": "e4c3e4b4-1c1b-4e5b-8b6a-8c0ab7d7c8b1",
+      "server_id": "9f6b5c2d-4ac5-4c4e-9f3c-5a1d2b6e7f80",
+      "action": "CREATE",
+      "status": "COMPLETE",
+      "status_reason": "Outputs received",
+      "input_values": {
+        "version": "1.1"
+      },
+      "output_values": {
+        "deploy_stdout": "done",
+        "deploy_status_code": 0
+      },
+      "creation_time": "2023-06-26T07:58:17",
+      "updated_time": "2023-06-26T07:59:17"
+    }
+  ]
+}
+`
+
+// Deployment is the expected deployment from the List request and the
+// response body of the Get, Create and Update requests.
+var Deployment = softwaredeployments.SoftwareDeployment{
+	ID:           "a7d9d7c4-2f3b-4f64-9a4b-6b0d5c0a3e21",
+	ConfigID:     "e4c3e4b4-1c1b-4e5b-8b6a-8c0ab7d7c8b1",
+	ServerID:     "9f6b5c2d-4ac5-4c4e-9f3c-5a1d2b6e7f80",
+	Action:       softwaredeployments.ActionCreate,
+	Status:       softwaredeployments.StatusComplete,
+	StatusReason: "Outputs received",
+	InputValues: map[string]interface{}{
+		"version": "1.1",
+	},
+	OutputValues: map[string]interface{}{
+		"deploy_stdout":      "done",
+		"deploy_status_code": float64(0),
+	},
+	CreationTime: time.Date(2023, 6, 26, 7, 58, 17, 0, time.UTC),
+	UpdatedTime:  time.Date(2023, 6, 26, 7, 59, 17, 0, time.UTC),
+}
+
+// GetOutput represents the response body from a Get, Create or Update request.
+const GetOutput = `
+{
+  "software_deployment": {
+    "id": "a7d9d7c4-2f3b-4f64-9a4b-6b0d5c0a3e21",
+    "config_id": "e4c3e4b4-1c1b-4e5b-8b6a-8c0ab7d7c8b1",
+    "server_id": "9f6b5c2d-4ac5-4c4e-9f3c-5a1d2b6e7f80",
+    "action": "CREATE",
+    "status": "COMPLETE",
+    "status_reason": "Outputs received",
+    "input_values": {
+      "version": "1.1"
+    },
+    "output_values": {
+      "deploy_stdout": "done",
+      "deploy_status_code": 0
+    },
+    "creation_time": "2023-06-26T07:58:17",
+    "updated_time": "2023-06-26T07:59:17"
+  }
+}
+`
+
+// CreateRequest represents the request body of a Create request.
+const CreateRequest = `
+{
+  "config_id": "e4c3e4b4-1c1b-4e5b-8b6a-8c0ab7d7c8b1",
+  "server_id": "9f6b5c2d-4ac5-4c4e-9f3c-5a1d2b6e7f80",
+  "action": "CREATE",
+  "status": "IN_PROGRESS",
+  "input_values": {
+    "version": "1.1"
+  }
+}
+`
+
+// UpdateRequest represents the request body of an Update request.
+const UpdateRequest = `
+{
+  "status": "COMPLETE",
+  "status_reason": "Outputs received",
+  "output_values": {
+    "deploy_stdout": "done",
+    "deploy_status_code": 0
+  }
+}
+`
+
+// MetadataOutput represents the response body from a GetMetadata request.
+const MetadataOutput = `
+{
+  "metadata": [
+    {
+      "id": "e4c3e4b4-1c1b-4e5b-8b6a-8c0ab7d7c8b1",
+      "name": "deploy-app",
+      "group": "script",
+      "config": "#!/bin/sh\necho ${version} > /tmp/version\n",
+      "inputs": [
+        {
+          "name": "version",
+          "type": "String",
+          "value": "1.1"
+        },
+        {
+          "name": "deploy_server_id",
+          "type": "String",
+          "description": "ID of the server being deployed to",
+          "value": "9f6b5c2d-4ac5-4c4e-9f3c-5a1d2b6e7f80"
+        }
+      ],
+      "outputs": [],
+      "options": {},
+      "creation_time": "2023-06-26T07:58:17",
+      "updated_time": "2023-06-26T07:59:17"
+    }
+  ]
+}
+`
+
+// MetadataExpected represents the expected objects from a GetMetadata request.
+var MetadataExpected = []softwareconfigs.SoftwareConfig{
+	{
+		ID:     "e4c3e4b4-1c1b-4e5b-8b6a-8c0ab7d7c8b1",
+		Name:   "deploy-app",
+		Group:  "script",
+		Config: "#!/bin/sh\necho ${version} > /tmp/version\n",
+		Inputs: []softwareconfigs.Input{
+			{
+				Name:  "version",
+				Type:  softwareconfigs.TypeString,
+				Value: "1.1",
+			},
+			{
+				Name:        "deploy_server_id",
+				Type:        softwareconfigs.TypeString,
+				Description: "ID of the server being deployed to",
+				Value:       "9f6b5c2d-4ac5-4c4e-9f3c-5a1d2b6e7f80",
+			},
+		},
+		Outputs:      []softwareconfigs.Output{},
+		Options:      map[string]interface{}{},
+		CreationTime: time.Date(2023, 6, 26, 7, 58, 17, 0, time.UTC),
+		UpdatedTime:  time.Date(2023, 6, 26, 7, 59, 17, 0, time.UTC),
+	},
+}
+
+// HandleListSuccessfully creates an HTTP handler at `/software_deployments`
+// on the test handler mux that responds with a `List` response.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/software_deployments", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"server_id": "9f6b5c2d-4ac5-4c4e-9f3c-5a1d2b6e7f80"})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListOutput)
+	})
+}
+
+// HandleCreateSuccessfully creates an HTTP handler at `/software_deployments`
+// on the test handler mux that responds with a `Create` response.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/software_deployments", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+// HandleDeploymentSuccessfully creates an HTTP handler at
+// `/software_deployments/a7d9d7c4-2f3b-4f64-9a4b-6b0d5c0a3e21` on the test
+// handler mux that responds to `Get`, `Update` and `Delete` requests.
+func HandleDeploymentSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/software_deployments/a7d9d7c4-2f3b-4f64-9a4b-6b0d5c0a3e21", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		switch r.Method {
+		case "GET":
+		case "PUT":
+			th.TestJSONRequest(t, r, UpdateRequest)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+			return
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+// HandleGetMetadataSuccessfully creates an HTTP handler at
+// `/software_deployments/metadata/9f6b5c2d-4ac5-4c4e-9f3c-5a1d2b6e7f80` on the
+// test handler mux that responds with a `GetMetadata` response.
+func HandleGetMetadataSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/software_deployments/metadata/9f6b5c2d-4ac5-4c4e-9f3c-5a1d2b6e7f80", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, MetadataOutput)
+	})
+}
@@ -0,0 +1,102 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/softwaredeployments"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	listOpts := softwaredeployments.ListOpts{
+		ServerID: "9f6b5c2d-4ac5-4c4e-9f3c-5a1d2b6e7f80",
+	}
+	allPages, err := softwaredeployments.List(fake.ServiceClient(), listOpts).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := softwaredeployments.ExtractSoftwareDeployments(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []softwaredeployments.SoftwareDeployment{Deployment}, actual)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := softwaredeployments.CreateOpts{
+		ConfigID: "e4c3e4b4-1c1b-4e5b-8b6a-8c0ab7d7c8b1",
+		ServerID: "9f6b5c2d-4ac5-4c4e-9f3c-5a1d2b6e7f80",
+		Action:   softwaredeployments.ActionCreate,
+		Status:   softwaredeployments.StatusInProgress,
+		InputValues: map[string]interface{}{
+			"version": "1.1",
+		},
+	}
+
+	actual, err := softwaredeployments.Create(fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &Deployment, actual)
+}
+
+func TestCreateMissingServerID(t *testing.T) {
+	res := softwaredeployments.Create(fake.ServiceClient(), softwaredeployments.CreateOpts{
+		ConfigID: "e4c3e4b4-1c1b-4e5b-8b6a-8c0ab7d7c8b1",
+	})
+	if res.Err == nil {
+		t.Fatal("Expected error when creating a software deployment without a server ID")
+	}
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeploymentSuccessfully(t)
+
+	actual, err := softwaredeployments.Get(fake.ServiceClient(), "a7d9d7c4-2f3b-4f64-9a4b-6b0d5c0a3e21").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &Deployment, actual)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeploymentSuccessfully(t)
+
+	updateOpts := softwaredeployments.UpdateOpts{
+		Status:       softwaredeployments.StatusComplete,
+		StatusReason: "Outputs received",
+		OutputValues: map[string]interface{}{
+			"deploy_stdout":      "done",
+			"deploy_status_code": 0,
+		},
+	}
+
+	actual, err := softwaredeployments.Update(fake.ServiceClient(), "a7d9d7c4-2f3b-4f64-9a4b-6b0d5c0a3e21", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &Deployment, actual)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeploymentSuccessfully(t)
+
+	err := softwaredeployments.Delete(fake.ServiceClient(), "a7d9d7c4-2f3b-4f64-9a4b-6b0d5c0a3e21").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestGetMetadata(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetMetadataSuccessfully(t)
+
+	actual, err := softwaredeployments.GetMetadata(fake.ServiceClient(), "9f6b5c2d-4ac5-4c4e-9f3c-5a1d2b6e7f80").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, MetadataExpected, actual)
+}
@@ -0,0 +1,17 @@
+package softwaredeployments
+
+import "github.com/gophercloud/gophercloud"
+
+const rootPath = "software_deployments"
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, id)
+}
+
+func metadataURL(c *gophercloud.ServiceClient, serverID string) string {
+	return c.ServiceURL(rootPath, "metadata", serverID)
+}
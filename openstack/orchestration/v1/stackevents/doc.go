@@ -15,5 +15,26 @@ Example for list events for a stack
 	}
 	fmt.Println("Get Event List")
 	fmt.Println(events)
+
+Example to Follow the Events of a Stack Until Its Creation Finishes
+
+	watcher := stackevents.Watch(client, stack.Name, stack.ID, stackevents.WatchOpts{
+		Action:      "CREATE",
+		NestedDepth: 3,
+		Timeout:     3600,
+	})
+
+	for event := range watcher.Events() {
+		fmt.Println(event.Time, event.ResourceName, event.ResourceStatus, event.ResourceStatusReason)
+	}
+
+	stack, err := watcher.Wait()
+	if err != nil {
+		if failed, ok := err.(stackevents.ErrStackFailed); ok {
+			fmt.Println("Resource", failed.ResourceName, "failed:", failed.ResourceStatusReason)
+		}
+		panic(err)
+	}
+	fmt.Println("Stack status:", stack.Status)
 */
 package stackevents
@@ -0,0 +1,38 @@
+package stackevents
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ErrStackFailed is returned by Watcher.Wait when the watched stack operation
+// did not succeed.
+type ErrStackFailed struct {
+	gophercloud.BaseError
+	StackName    string
+	Status       string
+	StatusReason string
+	// ResourceName is the name of the first resource whose operation failed,
+	// if any.
+	ResourceName string
+	// ResourceStatusReason is the reason the resource operation failed.
+	ResourceStatusReason string
+}
+
+func (e ErrStackFailed) Error() string {
+	if e.ResourceName != "" {
+		return fmt.Sprintf("Stack %s reached status %s: resource %s failed: %s", e.StackName, e.Status, e.ResourceName, e.ResourceStatusReason)
+	}
+	return fmt.Sprintf("Stack %s reached status %s: %s", e.StackName, e.Status, e.StatusReason)
+}
+
+// ErrWatchStopped is returned by Watcher.Wait when the watcher was stopped
+// before the stack operation finished.
+type ErrWatchStopped struct {
+	gophercloud.BaseError
+}
+
+func (e ErrWatchStopped) Error() string {
+	return "Watcher was stopped before the stack operation finished"
+}
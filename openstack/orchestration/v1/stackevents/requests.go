@@ -94,6 +94,8 @@ type ListOpts struct {
 	SortKey SortKey `q:"sort_keys"`
 	// The sort direction of the event list. Which is asc (ascending) or desc (descending).
 	SortDir SortDir `q:"sort_dir"`
+	// Includes the events of nested stacks up to the specified depth.
+	NestedDepth int `q:"nested_depth"`
 }
 
 // ToStackEventListQuery formats a ListOpts into a query string.
@@ -0,0 +1,134 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/stackevents"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// watchEvents are the events of the watched stack, in the order the
+// Orchestration service records them.
+var watchEvents = []string{
+	`{"id": "e1", "resource_name": "hello_world", "physical_resource_id": "49181cd6-169a-4130-9455-31185bbfc5bf", "resource_status": "CREATE_IN_PROGRESS", "resource_status_reason": "Stack CREATE started", "event_time": "2018-06-26T07:58:17Z"}`,
+	`{"id": "e2", "resource_name": "server", "physical_resource_id": "", "resource_status": "CREATE_IN_PROGRESS", "resource_status_reason": "state changed", "event_time": "2018-06-26T07:58:18Z"}`,
+	`{"id": "e3", "resource_name": "volume", "physical_resource_id": "", "resource_status": "CREATE_FAILED", "resource_status_reason": "Quota exceeded for volumes", "event_time": "2018-06-26T07:58:19Z"}`,
+	`{"id": "e4", "resource_name": "hello_world", "physical_resource_id": "49181cd6-169a-4130-9455-31185bbfc5bf", "resource_status": "CREATE_FAILED", "resource_status_reason": "Resource CREATE failed", "event_time": "2018-06-26T07:58:20Z"}`,
+}
+
+// HandleWatchSuccessfully creates HTTP handlers for the stack and its events.
+// Every poll of the stack reveals one more event; the stack reaches the
+// given final status once all the events are revealed.
+func HandleWatchSuccessfully(t *testing.T, finalStatus string) {
+	revealed := 0
+
+	th.Mux.HandleFunc("/stacks/hello_world/49181cd6-169a-4130-9455-31185bbfc5bf", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		if revealed < len(watchEvents) {
+			revealed++
+		}
+		status := "CREATE_IN_PROGRESS"
+		if revealed == len(watchEvents) {
+			status = finalStatus
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"stack": {"id": "49181cd6-169a-4130-9455-31185bbfc5bf", "stack_name": "hello_world", "stack_status": "%s", "stack_status_reason": "Resource CREATE failed"}}`, status)
+	})
+
+	th.Mux.HandleFunc("/stacks/hello_world/49181cd6-169a-4130-9455-31185bbfc5bf/events", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		r.ParseForm()
+		th.AssertEquals(t, "2", r.Form.Get("nested_depth"))
+		th.AssertEquals(t, "asc", r.Form.Get("sort_dir"))
+		th.AssertEquals(t, "created_at", r.Form.Get("sort_keys"))
+
+		start := 0
+		if marker := r.Form.Get("marker"); marker != "" {
+			fmt.Sscanf(marker, "e%d", &start)
+		}
+		var events []string
+		if start < revealed {
+			events = watchEvents[start:revealed]
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"events": [%s]}`, strings.Join(events, ","))
+	})
+}
+
+func TestWatchFailed(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleWatchSuccessfully(t, "CREATE_FAILED")
+
+	watcher := stackevents.Watch(fake.ServiceClient(), "hello_world", "49181cd6-169a-4130-9455-31185bbfc5bf", stackevents.WatchOpts{
+		Action:      "CREATE",
+		NestedDepth: 2,
+		Interval:    time.Millisecond,
+		Timeout:     10,
+	})
+
+	var ids []string
+	for event := range watcher.Events() {
+		ids = append(ids, event.ID)
+	}
+	th.AssertDeepEquals(t, []string{"e1", "e2", "e3", "e4"}, ids)
+
+	stack, err := watcher.Wait()
+	th.AssertEquals(t, "CREATE_FAILED", stack.Status)
+
+	var failed stackevents.ErrStackFailed
+	th.CheckErr(t, err, &failed)
+	th.AssertEquals(t, "volume", failed.ResourceName)
+	th.AssertEquals(t, "Quota exceeded for volumes", failed.ResourceStatusReason)
+}
+
+func TestWatchComplete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleWatchSuccessfully(t, "CREATE_COMPLETE")
+
+	watcher := stackevents.Watch(fake.ServiceClient(), "hello_world", "49181cd6-169a-4130-9455-31185bbfc5bf", stackevents.WatchOpts{
+		Marker:      "e2",
+		NestedDepth: 2,
+		Interval:    time.Millisecond,
+		Buffer:      len(watchEvents),
+	})
+
+	stack, err := watcher.Wait()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "CREATE_COMPLETE", stack.Status)
+
+	var ids []string
+	for event := range watcher.Events() {
+		ids = append(ids, event.ID)
+	}
+	th.AssertDeepEquals(t, []string{"e3", "e4"}, ids)
+}
+
+func TestWatchStop(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleWatchSuccessfully(t, "CREATE_COMPLETE")
+
+	watcher := stackevents.Watch(fake.ServiceClient(), "hello_world", "49181cd6-169a-4130-9455-31185bbfc5bf", stackevents.WatchOpts{
+		NestedDepth: 2,
+		Interval:    time.Millisecond,
+	})
+	<-watcher.Events()
+	watcher.Stop()
+
+	_, err := watcher.Wait()
+	var stopped stackevents.ErrWatchStopped
+	th.CheckErr(t, err, &stopped)
+}
@@ -0,0 +1,206 @@
+package stackevents
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/stacks"
+)
+
+// WatchOpts contains the options used to watch a stack.
+type WatchOpts struct {
+	// Action restricts the statuses which finish the watch to the ones of
+	// this action, for example "CREATE" or "UPDATE", and to the ROLLBACK
+	// action. Set it when the stack may still report the status of a
+	// previous action when the watch starts.
+	Action string
+
+	// Marker is the ID of the last event already seen. Only the events after
+	// it are emitted. If empty, all the events of the stack are emitted.
+	Marker string
+
+	// NestedDepth includes the events of nested stacks up to this depth.
+	NestedDepth int
+
+	// Interval is the time between two polls. Defaults to one second.
+	Interval time.Duration
+
+	// Timeout is the number of seconds after which the watch gives up. If
+	// zero or negative, the watch never times out.
+	Timeout int
+
+	// Buffer is the capacity of the Events channel.
+	Buffer int
+}
+
+// Watcher follows the events of a stack until its current operation
+// finishes. The events are delivered in order on the Events channel, which is
+// closed once the operation finishes; the channel must be drained, or the
+// Watcher stopped, for the watch to make progress.
+type Watcher struct {
+	events   chan Event
+	stop     chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+
+	stack *stacks.RetrievedStack
+	err   error
+}
+
+// Watch starts watching the stack with the provided stackName and stackID.
+// It polls the stack and its events until the stack reaches a *_COMPLETE or
+// *_FAILED status.
+func Watch(client *gophercloud.ServiceClient, stackName, stackID string, opts WatchOpts) *Watcher {
+	if opts.Interval <= 0 {
+		opts.Interval = time.Second
+	}
+	w := &Watcher{
+		events: make(chan Event, opts.Buffer),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	go w.run(client, stackName, stackID, opts)
+	return w
+}
+
+// Events returns the channel on which the new events are emitted.
+func (w *Watcher) Events() <-chan Event {
+	return w.events
+}
+
+// Stop stops the watch. Wait then returns ErrWatchStopped.
+func (w *Watcher) Stop() {
+	w.stopOnce.Do(func() { close(w.stop) })
+}
+
+// Wait blocks until the watch finishes and returns the last retrieved state
+// of the stack. An ErrStackFailed is returned if the operation failed or was
+// rolled back; it carries the ResourceStatusReason of the first resource
+// which failed.
+func (w *Watcher) Wait() (*stacks.RetrievedStack, error) {
+	<-w.done
+	return w.stack, w.err
+}
+
+func (w *Watcher) run(client *gophercloud.ServiceClient, stackName, stackID string, opts WatchOpts) {
+	defer close(w.done)
+	defer close(w.events)
+
+	var deadline time.Time
+	if opts.Timeout > 0 {
+		deadline = time.Now().Add(time.Duration(opts.Timeout) * time.Second)
+	}
+
+	marker := opts.Marker
+	seen := make(map[string]bool)
+	var failed *Event
+
+	for {
+		stack, err := stacks.Get(client, stackName, stackID).Extract()
+		if err != nil {
+			w.err = err
+			return
+		}
+		w.stack = stack
+
+		// Events are listed after the stack has been retrieved, so that all
+		// the events of a finished operation have been emitted once the
+		// watch ends.
+		events, err := listNewEvents(client, stackName, stackID, marker, opts.NestedDepth)
+		if err != nil {
+			w.err = err
+			return
+		}
+		for i := range events {
+			event := events[i]
+			marker = event.ID
+			if seen[event.ID] {
+				continue
+			}
+			seen[event.ID] = true
+
+			if failed == nil && isResourceFailure(event, stackName, stackID) {
+				failed = &event
+			}
+
+			select {
+			case w.events <- event:
+			case <-w.stop:
+				w.err = ErrWatchStopped{}
+				return
+			}
+		}
+
+		if finished(stack.Status, opts.Action) {
+			w.err = stackError(stack, failed)
+			return
+		}
+
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			w.err = gophercloud.ErrTimeOut{}
+			return
+		}
+
+		select {
+		case <-time.After(opts.Interval):
+		case <-w.stop:
+			w.err = ErrWatchStopped{}
+			return
+		}
+	}
+}
+
+// listNewEvents lists the events of a stack after marker, oldest first.
+func listNewEvents(client *gophercloud.ServiceClient, stackName, stackID, marker string, nestedDepth int) ([]Event, error) {
+	listOpts := ListOpts{
+		Marker:      marker,
+		SortKey:     SortCreatedAt,
+		SortDir:     SortAsc,
+		NestedDepth: nestedDepth,
+	}
+	allPages, err := List(client, stackName, stackID, listOpts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractEvents(allPages)
+}
+
+// finished determines whether a stack status is final for the watched action.
+func finished(status, action string) bool {
+	if !strings.HasSuffix(status, "_COMPLETE") && !strings.HasSuffix(status, "_FAILED") {
+		return false
+	}
+	if action == "" {
+		return true
+	}
+	return strings.HasPrefix(status, action+"_") || strings.HasPrefix(status, "ROLLBACK_")
+}
+
+// isResourceFailure determines whether an event reports the failure of a
+// resource, as opposed to the failure of the stack itself.
+func isResourceFailure(event Event, stackName, stackID string) bool {
+	if !strings.HasSuffix(event.ResourceStatus, "_FAILED") {
+		return false
+	}
+	return event.PhysicalResourceID != stackID && event.ResourceName != stackName
+}
+
+// stackError returns the error describing a finished stack operation, if it
+// did not succeed.
+func stackError(stack *stacks.RetrievedStack, failed *Event) error {
+	if !strings.HasSuffix(stack.Status, "_FAILED") && !strings.HasPrefix(stack.Status, "ROLLBACK_") {
+		return nil
+	}
+	err := ErrStackFailed{
+		StackName:    stack.Name,
+		Status:       stack.Status,
+		StatusReason: stack.StatusReason,
+	}
+	if failed != nil {
+		err.ResourceName = failed.ResourceName
+		err.ResourceStatusReason = failed.ResourceStatusReason
+	}
+	return err
+}
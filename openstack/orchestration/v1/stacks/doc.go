@@ -101,6 +101,33 @@ Example to Create an Stack
 	}
 	fmt.Printf("Created Stack: %v", created_stack.ID)
 
+Example to Lint a Template Before Creating a Stack
+
+	template := new(stacks.Template)
+	template.URL = "file:///home/user/stack.yaml"
+
+	environment := new(stacks.Environment)
+	environment.URL = "file:///home/user/env.yaml"
+
+	result, err := stacks.Lint(template, stacks.LintOpts{
+		Environment: environment,
+		Parameters: map[string]interface{}{
+			"flavor": "m1.small",
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+	for _, issue := range result.Issues {
+		fmt.Println(issue)
+	}
+
+	order, err := result.Order()
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("Resources will be created in order:", order)
+
 Example for Get Stack
 
 	get_result := stacks.Get(client, stackName, created_stack.ID)
@@ -133,6 +160,35 @@ Example for Delete Stack
 	}
 	fmt.Println("Deleted Stack: ", stackName)
 
+Example to Retrieve a Stack Output
+
+	output, err := stacks.GetOutput(client, stackName, stackID, "server_ip").Extract()
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("Server IP:", output.Value)
+
+Example to Snapshot and Restore a Stack
+
+	snapshot, err := stacks.CreateSnapshot(client, stackName, stackID, stacks.CreateSnapshotOpts{
+		Name: "before-upgrade",
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = stacks.RestoreSnapshot(client, stackName, stackID, snapshot.ID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Cancel a Stack Update
+
+	err := stacks.CancelUpdate(client, stackName, stackID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
 Summary of  Behavior Between Stack Update and UpdatePatch Methods :
 
 # Function | Test Case | Result
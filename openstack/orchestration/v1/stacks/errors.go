@@ -2,6 +2,7 @@ package stacks
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/gophercloud/gophercloud"
 )
@@ -39,3 +40,29 @@ type ErrTemplateRequired struct {
 func (e ErrTemplateRequired) Error() string {
 	return fmt.Sprintf("Template required for this function.")
 }
+
+// ErrLintFailed is returned by LintResult.Err when Lint found problems in a
+// template.
+type ErrLintFailed struct {
+	gophercloud.BaseError
+	Issues []LintIssue
+}
+
+func (e ErrLintFailed) Error() string {
+	msgs := make([]string, 0, len(e.Issues))
+	for _, i := range e.Issues {
+		msgs = append(msgs, i.String())
+	}
+	return fmt.Sprintf("Template has %d issue(s): %s", len(e.Issues), strings.Join(msgs, "; "))
+}
+
+// ErrDependencyCycle is returned when the resources of a template depend on
+// each other in a cycle.
+type ErrDependencyCycle struct {
+	gophercloud.BaseError
+	Resources []string
+}
+
+func (e ErrDependencyCycle) Error() string {
+	return fmt.Sprintf("Resources have circular dependencies: %s", strings.Join(e.Resources, ", "))
+}
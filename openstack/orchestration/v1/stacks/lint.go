@@ -0,0 +1,631 @@
+package stacks
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HOTVersions is a map containing the heat_template_version values known to
+// Lint. Both the date and the release name variants are accepted.
+var HOTVersions = map[string]bool{
+	"2013-05-23": true,
+	"2014-10-16": true,
+	"2015-04-30": true,
+	"2015-10-15": true,
+	"2016-04-08": true,
+	"2016-10-14": true,
+	"2017-02-24": true,
+	"2017-09-01": true,
+	"2018-03-02": true,
+	"2018-08-31": true,
+	"2021-04-16": true,
+	"newton":     true,
+	"ocata":      true,
+	"pike":       true,
+	"queens":     true,
+	"rocky":      true,
+	"wallaby":    true,
+}
+
+// hotSections is a map containing allowed top level sections in a HOT
+// template.
+var hotSections = map[string]bool{
+	"heat_template_version": true,
+	"description":           true,
+	"parameter_groups":      true,
+	"parameters":            true,
+	"resources":             true,
+	"outputs":               true,
+	"conditions":            true,
+}
+
+// parameterTypes is a map containing allowed HOT parameter types.
+var parameterTypes = map[string]bool{
+	"string":               true,
+	"number":               true,
+	"json":                 true,
+	"comma_delimited_list": true,
+	"boolean":              true,
+}
+
+// pseudoParameters are the parameters Heat provides for every stack.
+var pseudoParameters = map[string]bool{
+	"OS::stack_name": true,
+	"OS::stack_id":   true,
+	"OS::project_id": true,
+}
+
+// LintOpts contains the values used to lint a template locally.
+type LintOpts struct {
+	// Environment is an optional environment whose parameters and
+	// parameter_defaults sections are used to resolve parameter values.
+	Environment *Environment
+
+	// Parameters are the values which will be passed as the parameters of
+	// the stack. They take precedence over the environment.
+	Parameters map[string]interface{}
+}
+
+// LintIssue describes a single problem found in a template.
+type LintIssue struct {
+	// Path is the location of the problem in the template, for example
+	// "resources.server.properties.image".
+	Path string
+
+	// Message describes the problem.
+	Message string
+}
+
+func (i LintIssue) String() string {
+	return fmt.Sprintf("%s: %s", i.Path, i.Message)
+}
+
+// LintResult contains the outcome of Lint.
+type LintResult struct {
+	// Issues are the problems found in the template.
+	Issues []LintIssue
+
+	// Parameters are the resolved parameter values. The value supplied in
+	// LintOpts.Parameters wins over the environment parameters, which win
+	// over parameter_defaults, which win over the template default.
+	Parameters map[string]interface{}
+
+	// Dependencies maps every resource to the sorted list of resources it
+	// depends on, either explicitly through depends_on or implicitly through
+	// get_resource and get_attr.
+	Dependencies map[string][]string
+}
+
+// Err returns an ErrLintFailed if any issue was found, or nil otherwise.
+func (r *LintResult) Err() error {
+	if len(r.Issues) == 0 {
+		return nil
+	}
+	return ErrLintFailed{Issues: r.Issues}
+}
+
+// Order returns the resources in an order in which they can be created, that
+// is every resource comes after the resources it depends on. Resources which
+// are independent of each other are sorted by name. An ErrDependencyCycle is
+// returned if the dependencies can not be satisfied.
+func (r *LintResult) Order() ([]string, error) {
+	pending := make(map[string]int, len(r.Dependencies))
+	dependents := make(map[string][]string)
+	for name, deps := range r.Dependencies {
+		if _, ok := pending[name]; !ok {
+			pending[name] = 0
+		}
+		for _, dep := range deps {
+			if _, ok := r.Dependencies[dep]; !ok {
+				continue
+			}
+			pending[name]++
+			dependents[dep] = append(dependents[dep], name)
+		}
+	}
+
+	var ready []string
+	for name, n := range pending {
+		if n == 0 {
+			ready = append(ready, name)
+		}
+	}
+	sort.Strings(ready)
+
+	order := make([]string, 0, len(pending))
+	for len(ready) > 0 {
+		name := ready[0]
+		ready = ready[1:]
+		order = append(order, name)
+		var next []string
+		for _, d := range dependents[name] {
+			pending[d]--
+			if pending[d] == 0 {
+				next = append(next, d)
+			}
+		}
+		ready = append(ready, next...)
+		sort.Strings(ready)
+	}
+
+	if len(order) != len(pending) {
+		var cycle []string
+		for name, n := range pending {
+			if n > 0 {
+				cycle = append(cycle, name)
+			}
+		}
+		sort.Strings(cycle)
+		return nil, ErrDependencyCycle{Resources: cycle}
+	}
+	return order, nil
+}
+
+// Lint validates a template offline, without contacting the Orchestration
+// service. It checks the heat_template_version, the template sections, the
+// usage of the get_param, get_resource and get_attr intrinsic functions and
+// of depends_on, and the parameter constraints against the supplied values.
+// It also computes the dependency graph of the resources.
+//
+// Only HOT templates are inspected; for other formats only the template
+// version is validated. An error is returned only if the template or the
+// environment can not be parsed or is invalid as a whole; problems in the
+// template are reported in LintResult.Issues.
+func Lint(t *Template, opts LintOpts) (*LintResult, error) {
+	if t.Parsed == nil {
+		if err := t.Parse(); err != nil {
+			return nil, err
+		}
+	}
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+
+	l := &linter{
+		result: &LintResult{
+			Parameters:   make(map[string]interface{}),
+			Dependencies: make(map[string][]string),
+		},
+	}
+
+	rawVersion, ok := t.Parsed["heat_template_version"]
+	if !ok {
+		return l.result, nil
+	}
+	version := versionString(rawVersion)
+	if !HOTVersions[version] {
+		l.report("heat_template_version", "unknown template version %q", version)
+	}
+
+	for _, key := range sortedKeys(t.Parsed) {
+		if !hotSections[key] {
+			l.report(key, "unknown section")
+		}
+	}
+
+	l.params = l.section(t.Parsed, "parameters")
+	l.resources = l.section(t.Parsed, "resources")
+
+	var envParams, envDefaults map[string]interface{}
+	if opts.Environment != nil {
+		if opts.Environment.Parsed == nil {
+			if err := opts.Environment.Parse(); err != nil {
+				return nil, err
+			}
+		}
+		if err := opts.Environment.Validate(); err != nil {
+			return nil, err
+		}
+		envParams = l.section(opts.Environment.Parsed, "parameters")
+		envDefaults = l.section(opts.Environment.Parsed, "parameter_defaults")
+	}
+
+	for _, name := range sortedKeys(opts.Parameters) {
+		if _, ok := l.params[name]; !ok {
+			l.report("parameters", "value supplied for unknown parameter %q", name)
+		}
+	}
+	for _, name := range sortedKeys(envParams) {
+		if _, ok := l.params[name]; !ok {
+			l.report("parameters", "environment sets unknown parameter %q", name)
+		}
+	}
+
+	for _, name := range sortedKeys(l.params) {
+		l.lintParameter(name, opts.Parameters, envParams, envDefaults)
+	}
+
+	for _, name := range sortedKeys(l.resources) {
+		l.lintResource(name)
+	}
+
+	outputs := l.section(t.Parsed, "outputs")
+	for _, name := range sortedKeys(outputs) {
+		l.walk("outputs."+name, outputs[name], "")
+	}
+
+	conditions := l.section(t.Parsed, "conditions")
+	for _, name := range sortedKeys(conditions) {
+		l.walk("conditions."+name, conditions[name], "")
+	}
+
+	if _, err := l.result.Order(); err != nil {
+		l.report("resources", "%s", err)
+	}
+
+	return l.result, nil
+}
+
+type linter struct {
+	result    *LintResult
+	params    map[string]interface{}
+	resources map[string]interface{}
+}
+
+func (l *linter) report(path, format string, args ...interface{}) {
+	l.result.Issues = append(l.result.Issues, LintIssue{
+		Path:    path,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// section returns the named section of a parsed template or environment.
+func (l *linter) section(parsed map[string]interface{}, name string) map[string]interface{} {
+	v, ok := parsed[name]
+	if !ok || v == nil {
+		return nil
+	}
+	m, err := toStringKeys(v)
+	if err != nil {
+		l.report(name, "section must be a map")
+		return nil
+	}
+	return m
+}
+
+func (l *linter) lintParameter(name string, supplied, envParams, envDefaults map[string]interface{}) {
+	path := "parameters." + name
+	def, err := toStringKeys(l.params[name])
+	if err != nil {
+		l.report(path, "parameter definition must be a map")
+		return
+	}
+
+	typ, _ := def["type"].(string)
+	if !parameterTypes[typ] {
+		l.report(path+".type", "unknown parameter type %q", typ)
+		return
+	}
+
+	value, found := supplied[name]
+	if !found {
+		value, found = envParams[name]
+	}
+	if !found {
+		value, found = envDefaults[name]
+	}
+	if !found {
+		value, found = def["default"]
+	}
+	if !found || value == nil {
+		l.report(path, "no value supplied and no default set")
+		return
+	}
+	l.result.Parameters[name] = value
+
+	switch typ {
+	case "number":
+		if _, ok := toNumber(value); !ok {
+			l.report(path, "value %v is not a number", value)
+			return
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			if _, err := strconv.ParseBool(fmt.Sprint(value)); err != nil {
+				l.report(path, "value %v is not a boolean", value)
+				return
+			}
+		}
+	}
+
+	constraints, ok := def["constraints"].([]interface{})
+	if !ok {
+		if def["constraints"] != nil {
+			l.report(path+".constraints", "constraints must be a list")
+		}
+		return
+	}
+	for i, c := range constraints {
+		cm, err := toStringKeys(c)
+		if err != nil {
+			l.report(fmt.Sprintf("%s.constraints[%d]", path, i), "constraint must be a map")
+			continue
+		}
+		if msg := checkConstraint(typ, value, cm); msg != "" {
+			if desc, ok := cm["description"].(string); ok && desc != "" {
+				msg = desc
+			}
+			l.report(path, "value %v violates constraint: %s", value, msg)
+		}
+	}
+}
+
+// checkConstraint checks a parameter value against a single HOT constraint
+// and returns a description of the violation, if any. Custom constraints need
+// the Orchestration service and are not checked.
+func checkConstraint(typ string, value interface{}, c map[string]interface{}) string {
+	switch {
+	case c["length"] != nil:
+		r, err := toStringKeys(c["length"])
+		if err != nil {
+			return "length must be a map"
+		}
+		var n int
+		switch typ {
+		case "string":
+			n = len(fmt.Sprint(value))
+		case "comma_delimited_list":
+			n = len(toList(value))
+		case "json":
+			if m, err := toStringKeys(value); err == nil {
+				n = len(m)
+			} else if s, ok := value.([]interface{}); ok {
+				n = len(s)
+			}
+		default:
+			return fmt.Sprintf("length constraint not allowed for %s parameters", typ)
+		}
+		return checkRange(float64(n), r, "length")
+	case c["range"] != nil:
+		r, err := toStringKeys(c["range"])
+		if err != nil {
+			return "range must be a map"
+		}
+		if typ != "number" {
+			return fmt.Sprintf("range constraint not allowed for %s parameters", typ)
+		}
+		f, ok := toNumber(value)
+		if !ok {
+			return "value is not a number"
+		}
+		return checkRange(f, r, "range")
+	case c["allowed_values"] != nil:
+		allowed, ok := c["allowed_values"].([]interface{})
+		if !ok {
+			return "allowed_values must be a list"
+		}
+		values := []interface{}{value}
+		if typ == "comma_delimited_list" {
+			values = toList(value)
+		}
+		for _, v := range values {
+			match := false
+			for _, a := range allowed {
+				if fmt.Sprint(a) == fmt.Sprint(v) {
+					match = true
+					break
+				}
+			}
+			if !match {
+				return fmt.Sprintf("%v is not one of %v", v, allowed)
+			}
+		}
+	case c["allowed_pattern"] != nil:
+		pattern, ok := c["allowed_pattern"].(string)
+		if !ok {
+			return "allowed_pattern must be a string"
+		}
+		re, err := regexp.Compile("^(?:" + pattern + ")$")
+		if err != nil {
+			return fmt.Sprintf("invalid allowed_pattern: %s", err)
+		}
+		if !re.MatchString(fmt.Sprint(value)) {
+			return fmt.Sprintf("does not match pattern %q", pattern)
+		}
+	}
+	return ""
+}
+
+func checkRange(v float64, r map[string]interface{}, name string) string {
+	if min, ok := toNumber(r["min"]); ok && v < min {
+		return fmt.Sprintf("%s is less than %v", name, r["min"])
+	}
+	if max, ok := toNumber(r["max"]); ok && v > max {
+		return fmt.Sprintf("%s is greater than %v", name, r["max"])
+	}
+	return ""
+}
+
+func (l *linter) lintResource(name string) {
+	path := "resources." + name
+	def, err := toStringKeys(l.resources[name])
+	if err != nil {
+		l.report(path, "resource definition must be a map")
+		return
+	}
+	if typ, ok := def["type"].(string); !ok || typ == "" {
+		l.report(path+".type", "resource type is required")
+	}
+
+	deps := make(map[string]interface{})
+	switch d := def["depends_on"].(type) {
+	case nil:
+	case string:
+		l.dependsOn(path+".depends_on", name, d, deps)
+	case []interface{}:
+		for i, v := range d {
+			s, ok := v.(string)
+			if !ok {
+				l.report(fmt.Sprintf("%s.depends_on[%d]", path, i), "depends_on entries must be resource names")
+				continue
+			}
+			l.dependsOn(fmt.Sprintf("%s.depends_on[%d]", path, i), name, s, deps)
+		}
+	default:
+		l.report(path+".depends_on", "depends_on must be a resource name or a list of resource names")
+	}
+
+	for _, key := range sortedKeys(def) {
+		if key == "type" || key == "depends_on" {
+			continue
+		}
+		for _, dep := range l.walk(path+"."+key, def[key], name) {
+			deps[dep] = true
+		}
+	}
+
+	l.result.Dependencies[name] = sortedKeys(deps)
+}
+
+func (l *linter) dependsOn(path, self, target string, deps map[string]interface{}) {
+	if target == self {
+		l.report(path, "resource can not depend on itself")
+		return
+	}
+	if _, ok := l.resources[target]; !ok {
+		l.report(path, "depends on unknown resource %q", target)
+		return
+	}
+	deps[target] = true
+}
+
+// walk recursively checks the intrinsic functions used in v and returns the
+// resources referenced through get_resource and get_attr. self is the name of
+// the resource being walked, if any.
+func (l *linter) walk(path string, v interface{}, self string) []string {
+	var refs []string
+	switch tv := v.(type) {
+	case map[string]interface{}, map[interface{}]interface{}:
+		m, err := toStringKeys(tv)
+		if err != nil {
+			l.report(path, "map keys must be strings")
+			return nil
+		}
+		if len(m) == 1 {
+			for fn, arg := range m {
+				switch fn {
+				case "get_param":
+					l.getParam(path, arg)
+				case "get_resource":
+					refs = append(refs, l.getResource(path, arg, self)...)
+				case "get_attr":
+					refs = append(refs, l.getAttr(path, arg, self)...)
+				}
+			}
+		}
+		for _, k := range sortedKeys(m) {
+			refs = append(refs, l.walk(path+"."+k, m[k], self)...)
+		}
+	case []interface{}:
+		for i, e := range tv {
+			refs = append(refs, l.walk(fmt.Sprintf("%s[%d]", path, i), e, self)...)
+		}
+	}
+	return refs
+}
+
+func (l *linter) getParam(path string, arg interface{}) {
+	name, ok := arg.(string)
+	if !ok {
+		if args, isList := arg.([]interface{}); isList && len(args) > 0 {
+			name, ok = args[0].(string)
+		}
+	}
+	if !ok {
+		// the parameter name may itself be computed by a function
+		return
+	}
+	if pseudoParameters[name] {
+		return
+	}
+	if _, ok := l.params[name]; !ok {
+		l.report(path, "get_param references unknown parameter %q", name)
+	}
+}
+
+func (l *linter) getResource(path string, arg interface{}, self string) []string {
+	name, ok := arg.(string)
+	if !ok {
+		l.report(path, "get_resource argument must be a resource name")
+		return nil
+	}
+	return l.resourceRef(path, "get_resource", name, self)
+}
+
+func (l *linter) getAttr(path string, arg interface{}, self string) []string {
+	args, ok := arg.([]interface{})
+	if !ok || len(args) < 2 {
+		l.report(path, "get_attr argument must be a list of a resource name and an attribute")
+		return nil
+	}
+	name, ok := args[0].(string)
+	if !ok {
+		return nil
+	}
+	return l.resourceRef(path, "get_attr", name, self)
+}
+
+func (l *linter) resourceRef(path, fn, name, self string) []string {
+	if name == self {
+		l.report(path, "%s references the resource itself", fn)
+		return nil
+	}
+	if _, ok := l.resources[name]; !ok {
+		l.report(path, "%s references unknown resource %q", fn, name)
+		return nil
+	}
+	return []string{name}
+}
+
+// versionString converts a parsed heat_template_version to a string. YAML
+// parsers may decode the date versions as timestamps.
+func versionString(v interface{}) string {
+	if t, ok := v.(time.Time); ok {
+		return t.Format("2006-01-02")
+	}
+	return fmt.Sprint(v)
+}
+
+func toNumber(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case float64:
+		return n, true
+	case string:
+		f, err := strconv.ParseFloat(n, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func toList(v interface{}) []interface{} {
+	switch l := v.(type) {
+	case []interface{}:
+		return l
+	case string:
+		var list []interface{}
+		for _, s := range strings.Split(l, ",") {
+			list = append(list, strings.TrimSpace(s))
+		}
+		return list
+	}
+	return []interface{}{v}
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,182 @@
+package stacks
+
+import (
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+const lintTemplate = `
+heat_template_version: 2018-08-31
+parameters:
+  flavor:
+    type: string
+    constraints:
+      - allowed_values: [m1.small, m1.medium]
+  count:
+    type: number
+    default: 2
+    constraints:
+      - range: {min: 1, max: 5}
+  name:
+    type: string
+    default: web
+    constraints:
+      - allowed_pattern: "[a-z]+"
+        description: name must be lowercase
+resources:
+  network:
+    type: OS::Neutron::Net
+  subnet:
+    type: OS::Neutron::Subnet
+    properties:
+      network: {get_resource: network}
+      cidr: 10.0.0.0/24
+  server:
+    type: OS::Nova::Server
+    depends_on: subnet
+    properties:
+      name: {get_param: name}
+      flavor: {get_param: flavor}
+      networks:
+        - network: {get_resource: network}
+      metadata:
+        stack: {get_param: OS::stack_name}
+outputs:
+  server_ip:
+    value: {get_attr: [server, first_address]}
+`
+
+func TestLintValid(t *testing.T) {
+	template := new(Template)
+	template.Bin = []byte(lintTemplate)
+
+	env := new(Environment)
+	env.Bin = []byte(`
+parameter_defaults:
+  flavor: m1.medium
+`)
+
+	res, err := Lint(template, LintOpts{
+		Environment: env,
+		Parameters:  map[string]interface{}{"count": 3},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, res.Err())
+
+	th.AssertDeepEquals(t, map[string]interface{}{
+		"flavor": "m1.medium",
+		"count":  3,
+		"name":   "web",
+	}, res.Parameters)
+
+	th.AssertDeepEquals(t, map[string][]string{
+		"network": {},
+		"subnet":  {"network"},
+		"server":  {"network", "subnet"},
+	}, res.Dependencies)
+
+	order, err := res.Order()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{"network", "subnet", "server"}, order)
+}
+
+func TestLintIssues(t *testing.T) {
+	template := new(Template)
+	template.Bin = []byte(`
+heat_template_version: 2099-01-01
+parameters:
+  count:
+    type: number
+    constraints:
+      - range: {min: 1, max: 5}
+  name:
+    type: string
+    default: Web
+    constraints:
+      - allowed_pattern: "[a-z]+"
+        description: name must be lowercase
+  image:
+    type: string
+resources:
+  server:
+    type: OS::Nova::Server
+    depends_on: [volume]
+    properties:
+      name: {get_param: hostname}
+      port: {get_resource: port}
+outputs:
+  ip:
+    value: {get_attr: [srv, first_address]}
+`)
+
+	res, err := Lint(template, LintOpts{
+		Parameters: map[string]interface{}{"count": 7, "extra": "x"},
+	})
+	th.AssertNoErr(t, err)
+
+	expected := []LintIssue{
+		{Path: "heat_template_version", Message: `unknown template version "2099-01-01"`},
+		{Path: "parameters", Message: `value supplied for unknown parameter "extra"`},
+		{Path: "parameters.count", Message: "value 7 violates constraint: range is greater than 5"},
+		{Path: "parameters.image", Message: "no value supplied and no default set"},
+		{Path: "parameters.name", Message: "value Web violates constraint: name must be lowercase"},
+		{Path: "resources.server.depends_on[0]", Message: `depends on unknown resource "volume"`},
+		{Path: "resources.server.properties.name", Message: `get_param references unknown parameter "hostname"`},
+		{Path: "resources.server.properties.port", Message: `get_resource references unknown resource "port"`},
+		{Path: "outputs.ip.value", Message: `get_attr references unknown resource "srv"`},
+	}
+	th.AssertDeepEquals(t, expected, res.Issues)
+
+	var lintErr ErrLintFailed
+	th.CheckErr(t, res.Err(), &lintErr)
+	th.AssertEquals(t, len(expected), len(lintErr.Issues))
+}
+
+func TestLintDependencyCycle(t *testing.T) {
+	template := new(Template)
+	template.Bin = []byte(`
+heat_template_version: wallaby
+resources:
+  a:
+    type: OS::Heat::None
+    properties:
+      value: {get_attr: [b, value]}
+  b:
+    type: OS::Heat::None
+    depends_on: a
+  c:
+    type: OS::Heat::None
+`)
+
+	res, err := Lint(template, LintOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []LintIssue{
+		{Path: "resources", Message: "Resources have circular dependencies: a, b"},
+	}, res.Issues)
+
+	_, err = res.Order()
+	var cycleErr ErrDependencyCycle
+	th.CheckErr(t, err, &cycleErr)
+	th.AssertDeepEquals(t, []string{"a", "b"}, cycleErr.Resources)
+}
+
+func TestLintDependencyCyclePercentNames(t *testing.T) {
+	template := new(Template)
+	template.Bin = []byte(`
+heat_template_version: wallaby
+resources:
+  web%d:
+    type: OS::Heat::None
+    depends_on: db%s
+  db%s:
+    type: OS::Heat::None
+    depends_on: web%d
+`)
+
+	res, err := Lint(template, LintOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []LintIssue{
+		{Path: "resources", Message: "Resources have circular dependencies: db%s, web%d"},
+	}, res.Issues)
+}
@@ -533,3 +533,151 @@ func Abandon(c *gophercloud.ServiceClient, stackName, stackID string) (r Abandon
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// Export returns the data describing the stack with the provided stackName
+// and stackID and its resources, without deleting it. The data has the same
+// format as the data returned by an Abandon operation.
+func Export(c *gophercloud.ServiceClient, stackName, stackID string) (r ExportResult) {
+	resp, err := c.Get(exportURL(c, stackName, stackID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetEnvironment retrieves the effective environment of a stack.
+func GetEnvironment(c *gophercloud.ServiceClient, stackName, stackID string) (r GetEnvironmentResult) {
+	resp, err := c.Get(environmentURL(c, stackName, stackID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetFiles retrieves the files which were passed along with the template of
+// a stack.
+func GetFiles(c *gophercloud.ServiceClient, stackName, stackID string) (r GetFilesResult) {
+	resp, err := c.Get(filesURL(c, stackName, stackID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListOutputs lists the outputs of a stack. The values of the outputs are not
+// included; use GetOutput to retrieve them.
+func ListOutputs(c *gophercloud.ServiceClient, stackName, stackID string) pagination.Pager {
+	return pagination.NewPager(c, listOutputsURL(c, stackName, stackID), func(r pagination.PageResult) pagination.Page {
+		return OutputPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// GetOutput retrieves a single output, including its value, of a stack.
+func GetOutput(c *gophercloud.ServiceClient, stackName, stackID, outputKey string) (r GetOutputResult) {
+	resp, err := c.Get(getOutputURL(c, stackName, stackID, outputKey), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateSnapshotOptsBuilder is the interface options structs have to satisfy
+// in order to be used in the CreateSnapshot operation in this package.
+type CreateSnapshotOptsBuilder interface {
+	ToStackSnapshotCreateMap() (map[string]interface{}, error)
+}
+
+// CreateSnapshotOpts contains the options used to create a stack snapshot.
+type CreateSnapshotOpts struct {
+	// Name is the name of the snapshot.
+	Name string `json:"name,omitempty"`
+}
+
+// ToStackSnapshotCreateMap casts a CreateSnapshotOpts struct to a map.
+func (opts CreateSnapshotOpts) ToStackSnapshotCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// CreateSnapshot takes a snapshot of a stack and all of its resources.
+func CreateSnapshot(c *gophercloud.ServiceClient, stackName, stackID string, opts CreateSnapshotOptsBuilder) (r CreateSnapshotResult) {
+	b, err := opts.ToStackSnapshotCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(snapshotsURL(c, stackName, stackID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListSnapshots lists the snapshots of a stack.
+func ListSnapshots(c *gophercloud.ServiceClient, stackName, stackID string) pagination.Pager {
+	return pagination.NewPager(c, snapshotsURL(c, stackName, stackID), func(r pagination.PageResult) pagination.Page {
+		return SnapshotPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// GetSnapshot retrieves a snapshot of a stack, including its data.
+func GetSnapshot(c *gophercloud.ServiceClient, stackName, stackID, snapshotID string) (r GetSnapshotResult) {
+	resp, err := c.Get(snapshotURL(c, stackName, stackID, snapshotID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// RestoreSnapshot restores a stack to the state captured by a snapshot.
+func RestoreSnapshot(c *gophercloud.ServiceClient, stackName, stackID, snapshotID string) (r RestoreSnapshotResult) {
+	resp, err := c.Post(restoreSnapshotURL(c, stackName, stackID, snapshotID), nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteSnapshot deletes a snapshot of a stack.
+func DeleteSnapshot(c *gophercloud.ServiceClient, stackName, stackID, snapshotID string) (r DeleteSnapshotResult) {
+	resp, err := c.Delete(snapshotURL(c, stackName, stackID, snapshotID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Action represents an action which can be performed on a stack.
+type Action string
+
+const (
+	ActionSuspend               Action = "suspend"
+	ActionResume                Action = "resume"
+	ActionCheck                 Action = "check"
+	ActionCancelUpdate          Action = "cancel_update"
+	ActionCancelWithoutRollback Action = "cancel_without_rollback"
+)
+
+// doAction performs an action on a stack.
+func doAction(c *gophercloud.ServiceClient, stackName, stackID string, action Action) (r ActionResult) {
+	b := map[string]interface{}{string(action): nil}
+	resp, err := c.Post(actionsURL(c, stackName, stackID), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Suspend suspends a stack and all of its resources.
+func Suspend(c *gophercloud.ServiceClient, stackName, stackID string) (r ActionResult) {
+	return doAction(c, stackName, stackID, ActionSuspend)
+}
+
+// Resume resumes a suspended stack.
+func Resume(c *gophercloud.ServiceClient, stackName, stackID string) (r ActionResult) {
+	return doAction(c, stackName, stackID, ActionResume)
+}
+
+// Check checks whether the resources of a stack are in the expected state.
+func Check(c *gophercloud.ServiceClient, stackName, stackID string) (r ActionResult) {
+	return doAction(c, stackName, stackID, ActionCheck)
+}
+
+// CancelUpdate cancels an in progress update of a stack and rolls it back to
+// its previous state.
+func CancelUpdate(c *gophercloud.ServiceClient, stackName, stackID string) (r ActionResult) {
+	return doAction(c, stackName, stackID, ActionCancelUpdate)
+}
+
+// CancelWithoutRollback cancels an in progress create or update of a stack
+// without rolling it back.
+func CancelWithoutRollback(c *gophercloud.ServiceClient, stackName, stackID string) (r ActionResult) {
+	return doAction(c, stackName, stackID, ActionCancelWithoutRollback)
+}
@@ -303,3 +303,207 @@ func (r AbandonResult) String() (string, error) {
 	out, err := json.Marshal(r)
 	return string(out), err
 }
+
+// ExportResult represents the result of an Export operation.
+type ExportResult struct {
+	gophercloud.Result
+}
+
+// Extract returns a pointer to an AbandonedStack object and is called after
+// an Export operation.
+func (r ExportResult) Extract() (*AbandonedStack, error) {
+	var s *AbandonedStack
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// GetEnvironmentResult represents the result of a GetEnvironment operation.
+type GetEnvironmentResult struct {
+	gophercloud.Result
+}
+
+// Extract returns the environment of a stack and is called after a
+// GetEnvironment operation.
+func (r GetEnvironmentResult) Extract() (map[string]interface{}, error) {
+	var s map[string]interface{}
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// GetFilesResult represents the result of a GetFiles operation.
+type GetFilesResult struct {
+	gophercloud.Result
+}
+
+// Extract returns the files of a stack, keyed by their URL, and is called
+// after a GetFiles operation.
+func (r GetFilesResult) Extract() (map[string]string, error) {
+	var s map[string]string
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// Output represents an output of a stack.
+type Output struct {
+	// Key is the name of the output.
+	Key string `json:"output_key"`
+
+	// Value is the value of the output. It is only set by GetOutput.
+	Value interface{} `json:"output_value"`
+
+	// Description is the description of the output.
+	Description string `json:"description"`
+
+	// Error is set if the output could not be resolved.
+	Error string `json:"output_error"`
+}
+
+// OutputPage contains a single page of all outputs from a ListOutputs call.
+type OutputPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines if an OutputPage contains any results.
+func (r OutputPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	outputs, err := ExtractOutputs(r)
+	return len(outputs) == 0, err
+}
+
+// ExtractOutputs extracts and returns a slice of Output. It is used while
+// iterating over a stacks.ListOutputs call.
+func ExtractOutputs(r pagination.Page) ([]Output, error) {
+	var s struct {
+		Outputs []Output `json:"outputs"`
+	}
+	err := (r.(OutputPage)).ExtractInto(&s)
+	return s.Outputs, err
+}
+
+// GetOutputResult represents the result of a GetOutput operation.
+type GetOutputResult struct {
+	gophercloud.Result
+}
+
+// Extract returns a pointer to an Output object and is called after a
+// GetOutput operation.
+func (r GetOutputResult) Extract() (*Output, error) {
+	var s struct {
+		Output *Output `json:"output"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Output, err
+}
+
+// Snapshot represents a snapshot of a stack.
+type Snapshot struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Status       string    `json:"status"`
+	StatusReason string    `json:"status_reason"`
+	CreationTime time.Time `json:"-"`
+	// Data contains the stack data captured by the snapshot. It has the same
+	// format as the data returned by an Abandon operation.
+	Data *AbandonedStack `json:"data"`
+}
+
+func (r *Snapshot) UnmarshalJSON(b []byte) error {
+	type tmp Snapshot
+	var s struct {
+		tmp
+		CreationTime string `json:"creation_time"`
+	}
+
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+
+	*r = Snapshot(s.tmp)
+
+	if s.CreationTime != "" {
+		t, err := time.Parse(time.RFC3339, s.CreationTime)
+		if err != nil {
+			t, err = time.Parse(gophercloud.RFC3339NoZ, s.CreationTime)
+			if err != nil {
+				return err
+			}
+		}
+		r.CreationTime = t
+	}
+
+	return nil
+}
+
+// SnapshotPage contains a single page of all snapshots from a ListSnapshots
+// call.
+type SnapshotPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines if a SnapshotPage contains any results.
+func (r SnapshotPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	snapshots, err := ExtractSnapshots(r)
+	return len(snapshots) == 0, err
+}
+
+// ExtractSnapshots extracts and returns a slice of Snapshot. It is used while
+// iterating over a stacks.ListSnapshots call.
+func ExtractSnapshots(r pagination.Page) ([]Snapshot, error) {
+	var s struct {
+		Snapshots []Snapshot `json:"snapshots"`
+	}
+	err := (r.(SnapshotPage)).ExtractInto(&s)
+	return s.Snapshots, err
+}
+
+// CreateSnapshotResult represents the result of a CreateSnapshot operation.
+type CreateSnapshotResult struct {
+	gophercloud.Result
+}
+
+// Extract returns a pointer to a Snapshot object and is called after a
+// CreateSnapshot operation.
+func (r CreateSnapshotResult) Extract() (*Snapshot, error) {
+	var s *Snapshot
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// GetSnapshotResult represents the result of a GetSnapshot operation.
+type GetSnapshotResult struct {
+	gophercloud.Result
+}
+
+// Extract returns a pointer to a Snapshot object and is called after a
+// GetSnapshot operation.
+func (r GetSnapshotResult) Extract() (*Snapshot, error) {
+	var s struct {
+		Snapshot *Snapshot `json:"snapshot"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Snapshot, err
+}
+
+// RestoreSnapshotResult represents the result of a RestoreSnapshot operation.
+type RestoreSnapshotResult struct {
+	gophercloud.ErrResult
+}
+
+// DeleteSnapshotResult represents the result of a DeleteSnapshot operation.
+type DeleteSnapshotResult struct {
+	gophercloud.ErrResult
+}
+
+// ActionResult represents the result of a Suspend, Resume, Check,
+// CancelUpdate or CancelWithoutRollback operation.
+type ActionResult struct {
+	gophercloud.ErrResult
+}
@@ -433,3 +433,317 @@ func HandleAbandonSuccessfully(t *testing.T, output string) {
 		fmt.Fprintf(w, output)
 	})
 }
+
+// HandleExportSuccessfully creates an HTTP handler at `/stacks/postman_stack/16ef0584-4458-41eb-87c8-0dc8d5f66c87/export`
+// on the test handler mux that responds with an `Export` response.
+func HandleExportSuccessfully(t *testing.T, output string) {
+	th.Mux.HandleFunc("/stacks/postman_stack/16ef0584-4458-41eb-87c8-0dc8d5f66c87/export", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, output)
+	})
+}
+
+// EnvironmentOutput represents the response body from a GetEnvironment request.
+const EnvironmentOutput = `
+{
+  "encrypted_param_names": [],
+  "parameter_defaults": {},
+  "parameters": {
+    "flavor": "m1.small"
+  },
+  "resource_registry": {
+    "resources": {}
+  }
+}`
+
+// EnvironmentExpected represents the expected object from a GetEnvironment request.
+var EnvironmentExpected = map[string]interface{}{
+	"encrypted_param_names": []interface{}{},
+	"parameter_defaults":    map[string]interface{}{},
+	"parameters": map[string]interface{}{
+		"flavor": "m1.small",
+	},
+	"resource_registry": map[string]interface{}{
+		"resources": map[string]interface{}{},
+	},
+}
+
+// HandleGetEnvironmentSuccessfully creates an HTTP handler at `/stacks/postman_stack/16ef0584-4458-41eb-87c8-0dc8d5f66c87/environment`
+// on the test handler mux that responds with a `GetEnvironment` response.
+func HandleGetEnvironmentSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/stacks/postman_stack/16ef0584-4458-41eb-87c8-0dc8d5f66c87/environment", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, EnvironmentOutput)
+	})
+}
+
+// FilesOutput represents the response body from a GetFiles request.
+const FilesOutput = `
+{
+  "file:///home/user/my_nova.yaml": "heat_template_version: 2014-10-16\n"
+}`
+
+// HandleGetFilesSuccessfully creates an HTTP handler at `/stacks/postman_stack/16ef0584-4458-41eb-87c8-0dc8d5f66c87/files`
+// on the test handler mux that responds with a `GetFiles` response.
+func HandleGetFilesSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/stacks/postman_stack/16ef0584-4458-41eb-87c8-0dc8d5f66c87/files", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, FilesOutput)
+	})
+}
+
+// ListOutputsOutput represents the response body from a ListOutputs request.
+const ListOutputsOutput = `
+{
+  "outputs": [
+    {
+      "output_key": "server_ip",
+      "description": "IP address of the server"
+    },
+    {
+      "output_key": "server_name",
+      "description": "Name of the server"
+    }
+  ]
+}`
+
+// ListOutputsExpected represents the expected objects from a ListOutputs request.
+var ListOutputsExpected = []stacks.Output{
+	{
+		Key:         "server_ip",
+		Description: "IP address of the server",
+	},
+	{
+		Key:         "server_name",
+		Description: "Name of the server",
+	},
+}
+
+// HandleListOutputsSuccessfully creates an HTTP handler at `/stacks/postman_stack/16ef0584-4458-41eb-87c8-0dc8d5f66c87/outputs`
+// on the test handler mux that responds with a `ListOutputs` response.
+func HandleListOutputsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/stacks/postman_stack/16ef0584-4458-41eb-87c8-0dc8d5f66c87/outputs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListOutputsOutput)
+	})
+}
+
+// GetOutputOutput represents the response body from a GetOutput request.
+const GetOutputOutput = `
+{
+  "output": {
+    "output_key": "server_ip",
+    "output_value": "10.0.0.5",
+    "description": "IP address of the server"
+  }
+}`
+
+// GetOutputExpected represents the expected object from a GetOutput request.
+var GetOutputExpected = &stacks.Output{
+	Key:         "server_ip",
+	Value:       "10.0.0.5",
+	Description: "IP address of the server",
+}
+
+// HandleGetOutputSuccessfully creates an HTTP handler at `/stacks/postman_stack/16ef0584-4458-41eb-87c8-0dc8d5f66c87/outputs/server_ip`
+// on the test handler mux that responds with a `GetOutput` response.
+func HandleGetOutputSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/stacks/postman_stack/16ef0584-4458-41eb-87c8-0dc8d5f66c87/outputs/server_ip", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetOutputOutput)
+	})
+}
+
+// SnapshotCreateRequest represents the request body of a CreateSnapshot request.
+const SnapshotCreateRequest = `
+{
+  "name": "before-upgrade"
+}`
+
+// SnapshotCreateOutput represents the response body from a CreateSnapshot request.
+const SnapshotCreateOutput = `
+{
+  "id": "3bd2b1c2-8b2a-4f4c-b0c5-2e1b2a7c0f11",
+  "name": "before-upgrade",
+  "status": "IN_PROGRESS",
+  "status_reason": "",
+  "data": null,
+  "creation_time": "2018-06-26T07:58:17"
+}`
+
+// SnapshotCreateExpected represents the expected object from a CreateSnapshot request.
+var SnapshotCreateExpected = &stacks.Snapshot{
+	ID:           "3bd2b1c2-8b2a-4f4c-b0c5-2e1b2a7c0f11",
+	Name:         "before-upgrade",
+	Status:       "IN_PROGRESS",
+	CreationTime: Create_time,
+}
+
+// HandleCreateSnapshotSuccessfully creates an HTTP handler at `/stacks/postman_stack/16ef0584-4458-41eb-87c8-0dc8d5f66c87/snapshots`
+// on the test handler mux that responds with a `CreateSnapshot` response.
+func HandleCreateSnapshotSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/stacks/postman_stack/16ef0584-4458-41eb-87c8-0dc8d5f66c87/snapshots", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, SnapshotCreateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, SnapshotCreateOutput)
+	})
+}
+
+// ListSnapshotsOutput represents the response body from a ListSnapshots request.
+const ListSnapshotsOutput = `
+{
+  "snapshots": [
+    {
+      "id": "3bd2b1c2-8b2a-4f4c-b0c5-2e1b2a7c0f11",
+      "name": "before-upgrade",
+      "status": "COMPLETE",
+      "status_reason": "Stack SNAPSHOT completed successfully",
+      "data": null,
+      "creation_time": "2018-06-26T07:58:17"
+    }
+  ]
+}`
+
+// ListSnapshotsExpected represents the expected objects from a ListSnapshots request.
+var ListSnapshotsExpected = []stacks.Snapshot{
+	{
+		ID:           "3bd2b1c2-8b2a-4f4c-b0c5-2e1b2a7c0f11",
+		Name:         "before-upgrade",
+		Status:       "COMPLETE",
+		StatusReason: "Stack SNAPSHOT completed successfully",
+		CreationTime: Create_time,
+	},
+}
+
+// HandleListSnapshotsSuccessfully creates an HTTP handler at `/stacks/postman_stack/16ef0584-4458-41eb-87c8-0dc8d5f66c87/snapshots`
+// on the test handler mux that responds with a `ListSnapshots` response.
+func HandleListSnapshotsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/stacks/postman_stack/16ef0584-4458-41eb-87c8-0dc8d5f66c87/snapshots", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListSnapshotsOutput)
+	})
+}
+
+// GetSnapshotOutput represents the response body from a GetSnapshot request.
+const GetSnapshotOutput = `
+{
+  "snapshot": {
+    "id": "3bd2b1c2-8b2a-4f4c-b0c5-2e1b2a7c0f11",
+    "name": "before-upgrade",
+    "status": "COMPLETE",
+    "status_reason": "Stack SNAPSHOT completed successfully",
+    "creation_time": "2018-06-26T07:58:17",
+    "data": {
+      "status": "COMPLETE",
+      "name": "postman_stack",
+      "action": "SNAPSHOT",
+      "id": "16ef0584-4458-41eb-87c8-0dc8d5f66c87",
+      "project_id": "897686",
+      "resources": {
+        "hello_world": {
+          "status": "COMPLETE",
+          "name": "hello_world",
+          "resource_id": "8a310d36-46fc-436f-8be4-37a696b8ac63",
+          "action": "SNAPSHOT",
+          "type": "OS::Nova::Server"
+        }
+      }
+    }
+  }
+}`
+
+// GetSnapshotExpected represents the expected object from a GetSnapshot request.
+var GetSnapshotExpected = &stacks.Snapshot{
+	ID:           "3bd2b1c2-8b2a-4f4c-b0c5-2e1b2a7c0f11",
+	Name:         "before-upgrade",
+	Status:       "COMPLETE",
+	StatusReason: "Stack SNAPSHOT completed successfully",
+	CreationTime: Create_time,
+	Data: &stacks.AbandonedStack{
+		Status:    "COMPLETE",
+		Name:      "postman_stack",
+		Action:    "SNAPSHOT",
+		ID:        "16ef0584-4458-41eb-87c8-0dc8d5f66c87",
+		ProjectID: "897686",
+		Resources: map[string]interface{}{
+			"hello_world": map[string]interface{}{
+				"status":      "COMPLETE",
+				"name":        "hello_world",
+				"resource_id": "8a310d36-46fc-436f-8be4-37a696b8ac63",
+				"action":      "SNAPSHOT",
+				"type":        "OS::Nova::Server",
+			},
+		},
+	},
+}
+
+// HandleSnapshotSuccessfully creates an HTTP handler at `/stacks/postman_stack/16ef0584-4458-41eb-87c8-0dc8d5f66c87/snapshots/3bd2b1c2-8b2a-4f4c-b0c5-2e1b2a7c0f11`
+// on the test handler mux that responds to `GetSnapshot` and `DeleteSnapshot` requests.
+func HandleSnapshotSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/stacks/postman_stack/16ef0584-4458-41eb-87c8-0dc8d5f66c87/snapshots/3bd2b1c2-8b2a-4f4c-b0c5-2e1b2a7c0f11", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		switch r.Method {
+		case "GET":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, GetSnapshotOutput)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleRestoreSnapshotSuccessfully creates an HTTP handler at `/stacks/postman_stack/16ef0584-4458-41eb-87c8-0dc8d5f66c87/snapshots/3bd2b1c2-8b2a-4f4c-b0c5-2e1b2a7c0f11/restore`
+// on the test handler mux that responds with a `RestoreSnapshot` response.
+func HandleRestoreSnapshotSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/stacks/postman_stack/16ef0584-4458-41eb-87c8-0dc8d5f66c87/snapshots/3bd2b1c2-8b2a-4f4c-b0c5-2e1b2a7c0f11/restore", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+// HandleActionSuccessfully creates an HTTP handler at `/stacks/postman_stack/16ef0584-4458-41eb-87c8-0dc8d5f66c87/actions`
+// on the test handler mux that expects the given action request body.
+func HandleActionSuccessfully(t *testing.T, request string) {
+	th.Mux.HandleFunc("/stacks/postman_stack/16ef0584-4458-41eb-87c8-0dc8d5f66c87/actions", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, request)
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
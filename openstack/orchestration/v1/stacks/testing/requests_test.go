@@ -257,3 +257,130 @@ func TestAbandonStack(t *testing.T) {
 	expected := AbandonExpected
 	th.AssertDeepEquals(t, expected, actual)
 }
+
+func TestExportStack(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleExportSuccessfully(t, AbandonOutput)
+
+	actual, err := stacks.Export(fake.ServiceClient(), "postman_stack", "16ef0584-4458-41eb-87c8-0dc8d5f66c87").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, AbandonExpected, actual)
+}
+
+func TestGetStackEnvironment(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetEnvironmentSuccessfully(t)
+
+	actual, err := stacks.GetEnvironment(fake.ServiceClient(), "postman_stack", "16ef0584-4458-41eb-87c8-0dc8d5f66c87").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, EnvironmentExpected, actual)
+}
+
+func TestGetStackFiles(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetFilesSuccessfully(t)
+
+	actual, err := stacks.GetFiles(fake.ServiceClient(), "postman_stack", "16ef0584-4458-41eb-87c8-0dc8d5f66c87").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, map[string]string{
+		"file:///home/user/my_nova.yaml": "heat_template_version: 2014-10-16\n",
+	}, actual)
+}
+
+func TestListStackOutputs(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListOutputsSuccessfully(t)
+
+	allPages, err := stacks.ListOutputs(fake.ServiceClient(), "postman_stack", "16ef0584-4458-41eb-87c8-0dc8d5f66c87").AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := stacks.ExtractOutputs(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, ListOutputsExpected, actual)
+}
+
+func TestGetStackOutput(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetOutputSuccessfully(t)
+
+	actual, err := stacks.GetOutput(fake.ServiceClient(), "postman_stack", "16ef0584-4458-41eb-87c8-0dc8d5f66c87", "server_ip").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, GetOutputExpected, actual)
+}
+
+func TestCreateStackSnapshot(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSnapshotSuccessfully(t)
+
+	opts := stacks.CreateSnapshotOpts{Name: "before-upgrade"}
+	actual, err := stacks.CreateSnapshot(fake.ServiceClient(), "postman_stack", "16ef0584-4458-41eb-87c8-0dc8d5f66c87", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, SnapshotCreateExpected, actual)
+}
+
+func TestListStackSnapshots(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSnapshotsSuccessfully(t)
+
+	allPages, err := stacks.ListSnapshots(fake.ServiceClient(), "postman_stack", "16ef0584-4458-41eb-87c8-0dc8d5f66c87").AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := stacks.ExtractSnapshots(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, ListSnapshotsExpected, actual)
+}
+
+func TestGetStackSnapshot(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleSnapshotSuccessfully(t)
+
+	actual, err := stacks.GetSnapshot(fake.ServiceClient(), "postman_stack", "16ef0584-4458-41eb-87c8-0dc8d5f66c87", "3bd2b1c2-8b2a-4f4c-b0c5-2e1b2a7c0f11").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, GetSnapshotExpected, actual)
+}
+
+func TestDeleteStackSnapshot(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleSnapshotSuccessfully(t)
+
+	err := stacks.DeleteSnapshot(fake.ServiceClient(), "postman_stack", "16ef0584-4458-41eb-87c8-0dc8d5f66c87", "3bd2b1c2-8b2a-4f4c-b0c5-2e1b2a7c0f11").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestRestoreStackSnapshot(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleRestoreSnapshotSuccessfully(t)
+
+	err := stacks.RestoreSnapshot(fake.ServiceClient(), "postman_stack", "16ef0584-4458-41eb-87c8-0dc8d5f66c87", "3bd2b1c2-8b2a-4f4c-b0c5-2e1b2a7c0f11").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestStackActions(t *testing.T) {
+	actions := []struct {
+		request string
+		call    func(*gophercloud.ServiceClient, string, string) stacks.ActionResult
+	}{
+		{`{"suspend": null}`, stacks.Suspend},
+		{`{"resume": null}`, stacks.Resume},
+		{`{"check": null}`, stacks.Check},
+		{`{"cancel_update": null}`, stacks.CancelUpdate},
+		{`{"cancel_without_rollback": null}`, stacks.CancelWithoutRollback},
+	}
+
+	for _, action := range actions {
+		th.SetupHTTP()
+		HandleActionSuccessfully(t, action.request)
+
+		err := action.call(fake.ServiceClient(), "postman_stack", "16ef0584-4458-41eb-87c8-0dc8d5f66c87").ExtractErr()
+		th.AssertNoErr(t, err)
+		th.TeardownHTTP()
+	}
+}
@@ -37,3 +37,39 @@ func previewURL(c *gophercloud.ServiceClient) string {
 func abandonURL(c *gophercloud.ServiceClient, name, id string) string {
 	return c.ServiceURL("stacks", name, id, "abandon")
 }
+
+func exportURL(c *gophercloud.ServiceClient, name, id string) string {
+	return c.ServiceURL("stacks", name, id, "export")
+}
+
+func environmentURL(c *gophercloud.ServiceClient, name, id string) string {
+	return c.ServiceURL("stacks", name, id, "environment")
+}
+
+func filesURL(c *gophercloud.ServiceClient, name, id string) string {
+	return c.ServiceURL("stacks", name, id, "files")
+}
+
+func listOutputsURL(c *gophercloud.ServiceClient, name, id string) string {
+	return c.ServiceURL("stacks", name, id, "outputs")
+}
+
+func getOutputURL(c *gophercloud.ServiceClient, name, id, key string) string {
+	return c.ServiceURL("stacks", name, id, "outputs", key)
+}
+
+func snapshotsURL(c *gophercloud.ServiceClient, name, id string) string {
+	return c.ServiceURL("stacks", name, id, "snapshots")
+}
+
+func snapshotURL(c *gophercloud.ServiceClient, name, id, snapshotID string) string {
+	return c.ServiceURL("stacks", name, id, "snapshots", snapshotID)
+}
+
+func restoreSnapshotURL(c *gophercloud.ServiceClient, name, id, snapshotID string) string {
+	return c.ServiceURL("stacks", name, id, "snapshots", snapshotID, "restore")
+}
+
+func actionsURL(c *gophercloud.ServiceClient, name, id string) string {
+	return c.ServiceURL("stacks", name, id, "actions")
+}